@@ -4,12 +4,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"html/template"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gu-io/gu/notifications"
 	"github.com/gu-io/gu/router"
 	"github.com/gu-io/gu/shell"
 	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/css"
 	"github.com/gu-io/gu/trees/elems"
 	"github.com/influx6/faux/reflection"
 )
@@ -48,6 +51,18 @@ type Driver interface {
 	Services(cacheName string, interceptRequests bool) (shell.Fetch, shell.Cache)
 }
 
+// ServerDriver defines a Driver which renders apps on the server, where renders
+// never reach a browser, hence AfterRender hooks are not called for them and
+// requests are not held up by those hooks. Each render of a ServerDriver
+// serves a request of it's own, hence no route is left by it and the
+// BeforeLeave hooks of the route of a former request are never called.
+type ServerDriver interface {
+	Driver
+
+	// ServerRendering returns true if the driver renders apps on the server.
+	ServerRendering() bool
+}
+
 // Resource defines any set of rendering links, scripts, styles needed by a view.
 type Resource struct {
 	Manifest shell.AppManifest
@@ -77,6 +92,36 @@ type AppAttr struct {
 	EnableManifest    bool   `json:"ignore_manifest"`
 	InterceptRequests bool   `json:"intercept_requests"`
 	Driver            Driver `json:"-"`
+
+	// BasePath sets the path prefix the app is served under, such as "/admin".
+	// It is stripped from locations before routes are matched and added to
+	// the paths navigated to, allowing routes be declared relative to it.
+	BasePath string `json:"base_path"`
+
+	// NotFound sets the base of the view rendered when no view matches the
+	// current route. It accepts the same types as ComponentAttr.Base.
+	NotFound interface{} `json:"-"`
+
+	// Failure sets the base of the view rendered when a view fails to render
+	// or a failure is reported through Services.Fail. It accepts the same types
+	// as ComponentAttr.Base and additionally a func(error) *trees.Markup which
+	// receives the failure.
+	Failure interface{} `json:"-"`
+
+	// HookTimeout sets the duration each navigation lifecycle hook is given
+	// to complete, defaulting to DefaultHookTimeout.
+	HookTimeout time.Duration `json:"-"`
+
+	// Theme sets the design tokens of the app, written as css custom
+	// properties within the head. See NApp.SetTheme.
+	Theme *css.Theme `json:"-"`
+
+	// RadixRouting matches the routes of views with a router.RadixTree rather
+	// than testing the route of every view, where only the views of the most
+	// specific route matching the location are active, such as "/users/new"
+	// over "/users/:id", along with views of catch-all routes such as "/*".
+	// Routes are limited to static, ":param" and trailing "*" segments.
+	RadixRouting bool `json:"radix_routing"`
 }
 
 // NApp defines a struct which encapsulates all the core view management functions
@@ -94,7 +139,26 @@ type NApp struct {
 	views       []*NView
 	activeViews []*NView
 
+	notFound *NView
+	failure  *NView
+	failed   error
+	status   int
+
+	location   router.PushEvent
+	lifecycles []*Lifecycle
+	transition Transition
+	rendering  []*Lifecycle
+
 	globalResources []Resource
+	nonce           string
+
+	theme     *css.Theme
+	themeView *NView
+
+	routes     *router.RadixTree
+	everyRoute []*NView
+	routed     []*NView
+	pushed     bool
 
 	tree *trees.Markup
 }
@@ -106,6 +170,10 @@ func App(attr AppAttr) *NApp {
 	app.uuid = NewKey()
 	app.driver = attr.Driver
 
+	if attr.RadixRouting {
+		app.routes = router.NewRadixTree()
+	}
+
 	// Add local notification channel for this giving app.
 	app.notifications = notifications.New(app.uuid)
 
@@ -114,7 +182,7 @@ func App(attr AppAttr) *NApp {
 	app.fetch = fetch
 
 	// If we are in development mode empty the cache and reset for new use.
-	if attr.Mode == DevelopmentMode {
+	if attr.Mode == DevelopmentMode && app.cache != nil {
 		if err := app.cache.Empty(); err != nil {
 			fmt.Printf("Failed to clear internal cache for %q in development mode: %q\n", app.attr.Name, err.Error())
 		}
@@ -152,12 +220,22 @@ func App(attr AppAttr) *NApp {
 		}
 	}
 
+	app.status = http.StatusOK
+	app.notFound = app.fallbackView("NotFound", attr.NotFound, defaultNotFound)
+	app.failure = app.fallbackView("Failure", attr.Failure, defaultFailure)
+
+	if attr.Theme != nil {
+		app.SetTheme(attr.Theme)
+	}
+
 	app.driver.OnReady(func() {
 		fmt.Printf("Running App: %q\n", app.attr.Name)
 		fmt.Printf("Running App Title: %q\n", app.attr.Name)
 
 		app.active = false
-		app.ActivateRoute(app.driver.Location())
+		if err := app.ActivateRoute(app.driver.Location()); err != nil {
+			fmt.Printf("Failed to activate route for %q: %q\n", app.attr.Name, err.Error())
+		}
 
 		app.driver.Render(&app)
 		app.active = true
@@ -170,6 +248,7 @@ func App(attr AppAttr) *NApp {
 			return
 		}
 
+		directive.To = app.Link(directive.To)
 		app.driver.Navigate(directive)
 	})
 
@@ -192,8 +271,20 @@ func (app *NApp) Mounted() {
 	}
 }
 
-// ActivateRoute actives the views which are to be rendered.
-func (app *NApp) ActivateRoute(es interface{}) {
+// ActivateRoute actives the views which are to be rendered, returning the error
+// of the hook cancelling the navigation.
+//
+// The BeforeLeave hooks of the views and components which no longer match
+// the route are called first, in reverse order, where the first hook returning
+// an error cancels the navigation, keeping the current route active and
+// returning the error. The AfterEnter hooks of the newly matching views and
+// components are called once the route is activated.
+//
+// Apps rendered on the server by a ServerDriver activate each route afresh,
+// calling the AfterEnter hooks of all matching views and components and no
+// BeforeLeave hooks, as the route of a former request is never left by the
+// user.
+func (app *NApp) ActivateRoute(es interface{}) error {
 	var pe router.PushEvent
 
 	switch esm := es.(type) {
@@ -208,7 +299,177 @@ func (app *NApp) ActivateRoute(es interface{}) {
 		pe = esm
 	}
 
-	app.activeViews = app.PushViews(pe)
+	pe, within := router.StripBase(app.attr.BasePath, pe)
+
+	transition := Transition{From: app.location, To: pe}
+	next := app.matchLifecycles(pe, within)
+	timeout := app.hookTimeout()
+
+	current := app.lifecycles
+	if app.serverRendering() {
+		current = nil
+	}
+
+	leaving := lifecyclesDiff(current, next)
+	for i := len(leaving) - 1; i >= 0; i-- {
+		if err := runHooks(leaving[i].leave, transition, timeout); err != nil {
+			return err
+		}
+	}
+
+	entering := lifecyclesDiff(next, current)
+
+	app.failed = nil
+	app.status = http.StatusOK
+	if within {
+		app.activeViews = app.PushViews(pe)
+	} else {
+		app.activeViews = app.outsideViews(pe)
+	}
+
+	if len(app.activeViews) == 1 && app.activeViews[0] == app.notFound {
+		app.status = http.StatusNotFound
+	}
+
+	app.location = pe
+	app.lifecycles = next
+	app.transition = transition
+	app.rendering = append(app.rendering, entering...)
+
+	for _, lifecycle := range entering {
+		if err := runHooks(lifecycle.enter, transition, timeout); err != nil {
+			app.Fail(err)
+			break
+		}
+	}
+
+	return nil
+}
+
+// Location returns the PushEvent of the route last activated for the app,
+// relative to the app's base path.
+func (app *NApp) Location() router.PushEvent {
+	return app.location
+}
+
+// BasePath returns the path prefix the app is served under.
+func (app *NApp) BasePath() string {
+	return router.CleanBase(app.attr.BasePath)
+}
+
+// Link returns the provided route path prefixed with the app's base path, for
+// use in links and navigation.
+func (app *NApp) Link(path string) string {
+	return router.JoinBase(app.attr.BasePath, path)
+}
+
+// hookTimeout returns the duration given to each navigation lifecycle hook.
+func (app *NApp) hookTimeout() time.Duration {
+	if app.attr.HookTimeout <= 0 {
+		return DefaultHookTimeout
+	}
+
+	return app.attr.HookTimeout
+}
+
+// matchLifecycles returns the lifecycles of the views and components which
+// match the provided event, without resolving their routers. No view matches
+// events outside the app's base path.
+func (app *NApp) matchLifecycles(pe router.PushEvent, within bool) []*Lifecycle {
+	var matched []*Lifecycle
+
+	if within {
+		for _, view := range app.matchViews(pe.Rem) {
+			_, rem, _ := view.router.Test(pe.Rem)
+			matched = append(matched, view.matchLifecycles(rem)...)
+		}
+	}
+
+	if len(matched) == 0 && app.notFound != nil {
+		_, rem, _ := app.notFound.router.Test(pe.Rem)
+		matched = app.notFound.matchLifecycles(rem)
+	}
+
+	return matched
+}
+
+// afterRender calls the AfterRender hooks of the views and components which
+// where activated since the last render of the app, unless the app is rendered
+// on the server by a ServerDriver.
+func (app *NApp) afterRender() {
+	rendering := app.rendering
+	app.rendering = nil
+
+	// The Failure view was rendered in place of the activated views.
+	if app.failed != nil {
+		return
+	}
+
+	if app.serverRendering() {
+		return
+	}
+
+	timeout := app.hookTimeout()
+
+	for _, lifecycle := range rendering {
+		if err := runHooks(lifecycle.render, app.transition, timeout); err != nil {
+			fmt.Printf("AfterRender hook failed for %q: %q\n", app.attr.Name, err.Error())
+		}
+	}
+}
+
+// serverRendering returns true if the app is rendered on the server by a
+// ServerDriver.
+func (app *NApp) serverRendering() bool {
+	server, ok := app.driver.(ServerDriver)
+	return ok && server.ServerRendering()
+}
+
+// lifecyclesDiff returns the lifecycles in the first list which are not
+// contained in the second.
+func lifecyclesDiff(list []*Lifecycle, others []*Lifecycle) []*Lifecycle {
+	var diff []*Lifecycle
+
+	for _, lifecycle := range list {
+		var found bool
+
+		for _, other := range others {
+			if other == lifecycle {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			diff = append(diff, lifecycle)
+		}
+	}
+
+	return diff
+}
+
+// Status returns the http status code matching the state of the app for the
+// last activated route: http.StatusOK when views matched, http.StatusNotFound
+// when the NotFound view was used and http.StatusInternalServerError when a
+// failure occured.
+func (app *NApp) Status() int {
+	return app.status
+}
+
+// Fail records the provided error as a failure of the current route, which
+// switches rendering to the Failure view until a new route is activated.
+func (app *NApp) Fail(err error) {
+	if err == nil {
+		return
+	}
+
+	app.failed = err
+	app.status = http.StatusInternalServerError
+}
+
+// Failed returns the failure recorded for the current route if any.
+func (app *NApp) Failed() error {
+	return app.failed
 }
 
 // AppJSON defines a struct which holds the giving sets of tree changes to be
@@ -224,16 +485,33 @@ type AppJSON struct {
 }
 
 // RenderJSON returns the giving rendered tree of the app respective of the path
-// found as jons structure with markup content.
+// found as jons structure with markup content. If the navigation to the path
+// is cancelled, the current route is rendered.
 func (app *NApp) RenderJSON(es interface{}) AppJSON {
 	if es != nil {
-		app.ActivateRoute(es)
+		if err := app.ActivateRoute(es); err != nil {
+			fmt.Printf("Navigation to route cancelled for %q: %q\n", app.attr.Name, err.Error())
+		}
 	}
 
+	tjson := app.renderJSON(app.activeViews)
+	if app.failed != nil {
+		tjson = app.renderJSON([]*NView{app.failure})
+	}
+
+	app.afterRender()
+
+	return tjson
+}
+
+// renderJSON returns the AppJSON for the app using the provided views.
+func (app *NApp) renderJSON(views []*NView) AppJSON {
 	var tjson AppJSON
 	tjson.Name = app.attr.Name
 	tjson.Title = app.attr.Title
 
+	views = app.withTheme(views)
+
 	toHead, toBody := app.Resources()
 
 	for _, item := range toHead {
@@ -246,14 +524,14 @@ func (app *NApp) RenderJSON(es interface{}) AppJSON {
 
 	var afterBody []ViewJSON
 
-	for _, view := range app.activeViews {
+	for _, view := range views {
 		switch view.attr.Target {
 		case HeadTarget:
-			tjson.Head = append(tjson.Head, view.RenderJSON())
+			tjson.Head = append(tjson.Head, app.renderViewJSON(view))
 		case BodyTarget:
-			tjson.Body = append(tjson.Body, view.RenderJSON())
+			tjson.Body = append(tjson.Body, app.renderViewJSON(view))
 		case AfterBodyTarget:
-			afterBody = append(afterBody, view.RenderJSON())
+			afterBody = append(afterBody, app.renderViewJSON(view))
 		}
 
 		viewHead, viewBody := view.Resources()
@@ -272,12 +550,29 @@ func (app *NApp) RenderJSON(es interface{}) AppJSON {
 }
 
 // Render returns the giving rendered tree of the app respective of the path
-// found.
+// found. If the navigation to the path is cancelled, the current route is
+// rendered.
 func (app *NApp) Render(es interface{}) *trees.Markup {
 	if es != nil {
-		app.ActivateRoute(es)
+		if err := app.ActivateRoute(es); err != nil {
+			fmt.Printf("Navigation to route cancelled for %q: %q\n", app.attr.Name, err.Error())
+		}
+	}
+
+	html := app.render(app.activeViews)
+	if app.failed != nil {
+		html = app.render([]*NView{app.failure})
 	}
 
+	app.afterRender()
+
+	return html
+}
+
+// render returns the rendered tree of the app using the provided views.
+func (app *NApp) render(views []*NView) *trees.Markup {
+	views = app.withTheme(views)
+
 	var html = trees.NewMarkup("html", false)
 	var head = trees.NewMarkup("head", false)
 
@@ -296,14 +591,14 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 
 	var last = elems.Div()
 
-	for _, view := range app.activeViews {
+	for _, view := range views {
 		switch view.attr.Target {
 		case HeadTarget:
-			view.Render().Apply(head)
+			app.renderView(view).Apply(head)
 		case BodyTarget:
-			view.Render().Apply(body)
+			app.renderView(view).Apply(body)
 		case AfterBodyTarget:
-			view.Render().Apply(last)
+			app.renderView(view).Apply(last)
 		}
 
 		viewHead, viewBody := view.Resources()
@@ -325,8 +620,38 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 	return html
 }
 
+// renderView returns the rendered markup of the view, recording any panic
+// which occurs during rendering as a failure of the app.
+func (app *NApp) renderView(view *NView) (markup *trees.Markup) {
+	defer func() {
+		if err := recover(); err != nil {
+			app.Fail(fmt.Errorf("View %q failed to render: %+v", view.attr.Name, err))
+			markup = nil
+		}
+	}()
+
+	return view.Render()
+}
+
+// renderViewJSON returns the ViewJSON of the view, recording any panic
+// which occurs during rendering as a failure of the app.
+func (app *NApp) renderViewJSON(view *NView) (vjson ViewJSON) {
+	defer func() {
+		if err := recover(); err != nil {
+			app.Fail(fmt.Errorf("View %q failed to render: %+v", view.attr.Name, err))
+			vjson = ViewJSON{AppID: app.uuid, ViewID: view.uuid}
+		}
+	}()
+
+	return view.RenderJSON()
+}
+
 // PushViews returns a slice of  views that match and pass the provided path.
 func (app *NApp) PushViews(event router.PushEvent) []*NView {
+	if app.routes != nil {
+		return app.pushRadixViews(event)
+	}
+
 	// fmt.Printf("Routing Path: %s\n", event.Rem)
 	var active []*NView
 
@@ -344,9 +669,140 @@ func (app *NApp) PushViews(event router.PushEvent) []*NView {
 		active = append(active, view)
 	}
 
+	// If no view matched, use the NotFound view instead.
+	if len(active) == 0 && app.notFound != nil {
+		app.notFound.propagateRoute(event)
+		active = append(active, app.notFound)
+	}
+
 	return active
 }
 
+// pushRadixViews returns the views matching the provided path using the
+// app's RadixTree, where only the views matched by the last push which no
+// longer match are unmounted, rather than every view.
+func (app *NApp) pushRadixViews(event router.PushEvent) []*NView {
+	active := app.matchViews(event.Rem)
+
+	matched := make(map[*NView]bool, len(active))
+	for _, view := range active {
+		matched[view] = true
+	}
+
+	// Every view is unmounted on the first push, as done by PushViews.
+	leaving := app.routed
+	if !app.pushed {
+		leaving = app.views
+	}
+
+	for _, view := range leaving {
+		if !matched[view] {
+			view.leaveRoute(event)
+		}
+	}
+
+	app.routed = active
+	app.pushed = true
+
+	for _, view := range active {
+		view.propagateRoute(event)
+	}
+
+	// If no view matched, use the NotFound view instead.
+	if len(active) == 0 && app.notFound != nil {
+		app.notFound.propagateRoute(event)
+		active = append(active, app.notFound)
+	}
+
+	return active
+}
+
+// matchViews returns the views whose routes match the provided path, in the
+// order they were added to the app.
+func (app *NApp) matchViews(path string) []*NView {
+	if app.routes == nil {
+		var matched []*NView
+		for _, view := range app.views {
+			if _, _, ok := view.router.Test(path); ok {
+				matched = append(matched, view)
+			}
+		}
+
+		return matched
+	}
+
+	var group []*NView
+	if match, ok := app.routes.Match(path); ok {
+		group = *match.Value.(*[]*NView)
+	}
+
+	matched := make([]*NView, 0, len(group)+len(app.everyRoute))
+	every := app.everyRoute
+
+	for len(group) != 0 || len(every) != 0 {
+		if len(every) == 0 || (len(group) != 0 && group[0].order < every[0].order) {
+			matched = append(matched, group[0])
+			group = group[1:]
+			continue
+		}
+
+		matched = append(matched, every[0])
+		every = every[1:]
+	}
+
+	return matched
+}
+
+// addRoute adds the route of the view into the app's RadixTree, where views
+// of routes matching the same paths share their entry and views of catch-all
+// routes are kept apart as they match every path.
+func (app *NApp) addRoute(view *NView) {
+	if app.routes == nil {
+		return
+	}
+
+	route := view.attr.Route
+	if strings.Trim(strings.TrimPrefix(route, "#"), "/") == "*" {
+		app.everyRoute = append(app.everyRoute, view)
+		return
+	}
+
+	if group, ok := app.routes.Get(route); ok {
+		views := group.(*[]*NView)
+		*views = append(*views, view)
+		return
+	}
+
+	if err := app.routes.Add(route, &[]*NView{view}); err != nil {
+		panic(err)
+	}
+}
+
+// outsideViews returns the NotFound view for events outside the app's base
+// path, disabling all views.
+func (app *NApp) outsideViews(event router.PushEvent) []*NView {
+	for _, view := range app.views {
+		view.disableView()
+		view.Unmounted()
+	}
+
+	app.notFound.propagateRoute(event)
+
+	return []*NView{app.notFound}
+}
+
+// Routes returns the route table of the app, containing the routes of each
+// view and the routes of the components registered below them.
+func (app *NApp) Routes() []router.Route {
+	var routes []router.Route
+
+	for _, view := range app.views {
+		routes = append(routes, view.router.Routes())
+	}
+
+	return routes
+}
+
 // Resources return the giving resource headers which relate with the
 // view.
 func (app *NApp) Resources() ([]*trees.Markup, []*trees.Markup) {
@@ -403,9 +859,34 @@ func (app *NApp) Resources() ([]*trees.Markup, []*trees.Markup) {
 		}
 	}
 
+	app.applyNonce(head, body)
+
 	return head, body
 }
 
+// SetNonce sets the Content-Security-Policy nonce given to the inline scripts
+// and styles of the resources of the app and it's views, such as those of the
+// CSSEmbed and JavascriptEmbed hooks, for apps rendered outside of the ssr
+// Handler, which sets a nonce of it's own for every request. Browser drivers
+// should use the nonce of the page which loaded the app.
+func (app *NApp) SetNonce(nonce string) {
+	app.nonce = nonce
+}
+
+// applyNonce sets the nonce of the app on the inline scripts and styles of
+// the provided resources.
+func (app *NApp) applyNonce(resources ...[]*trees.Markup) {
+	if app.nonce == "" {
+		return
+	}
+
+	for _, list := range resources {
+		for _, item := range list {
+			trees.ApplyNonce(item, app.nonce)
+		}
+	}
+}
+
 // UUID returns the uuid specific to the giving view.
 func (app *NApp) UUID() string {
 	return app.uuid
@@ -440,6 +921,17 @@ type ViewAttr struct {
 
 // View returns a new instance of the view object.
 func (app *NApp) View(attr ViewAttr) *NView {
+	vw := app.newView(attr)
+	vw.order = len(app.views)
+	app.views = append(app.views, vw)
+	app.addRoute(vw)
+
+	return vw
+}
+
+// newView returns a new instance of the view object which is not registered
+// into the app's routable views.
+func (app *NApp) newView(attr ViewAttr) *NView {
 	if attr.Base == nil {
 		attr.Base = trees.NewMarkup("view", false)
 		trees.NewCSSStyle("display", "block").Apply(attr.Base)
@@ -453,8 +945,13 @@ func (app *NApp) View(attr ViewAttr) *NView {
 	vw.notifications = app.notifications
 	vw.Reactive = NewReactive()
 	vw.appUUID = app.uuid
+	vw.lifecycle = NewLifecycle()
 
-	vw.router = router.New(attr.Route)
+	if app.attr.RadixRouting {
+		vw.router = router.NewRadix(attr.Route).SetOwner(attr.Name)
+	} else {
+		vw.router = router.New(attr.Route).SetOwner(attr.Name)
+	}
 	vw.cache = app.cache
 	vw.fetch = app.fetch
 	vw.local = app.local
@@ -469,18 +966,130 @@ func (app *NApp) View(attr ViewAttr) *NView {
 
 	// Register to listen for failure of route to match and
 	// notify unmount call.
-	vw.router.Failed(func(push router.PushEvent) {
-		vw.disableView()
-		vw.Unmounted()
-	})
+	vw.router.Failed(vw.leaveRoute)
 
 	vw.attr.Base.SwapUID(vw.uuid)
 
-	app.views = append(app.views, &vw)
-
 	return &vw
 }
 
+// fallbackView returns a new view which is rendered outside of the app's
+// routing, using the provided base or the default if the base is nil.
+func (app *NApp) fallbackView(name string, base interface{}, def func(*NApp) interface{}) *NView {
+	if base == nil {
+		base = def(app)
+	}
+
+	if fn, ok := base.(func(error) *trees.Markup); ok {
+		base = &failureView{app: app, render: fn}
+	}
+
+	view := app.newView(ViewAttr{
+		Name:  fmt.Sprintf("%s.%s", app.attr.Name, name),
+		Route: "*",
+	})
+
+	view.newComponent(ComponentAttr{Base: base})
+
+	return view
+}
+
+// defaultNotFound returns the base used for the NotFound view when none
+// is set in the AppAttr.
+func defaultNotFound(app *NApp) interface{} {
+	return func() *trees.Markup {
+		return elems.Div(
+			trees.NewAttr("class", "gu-not-found"),
+			elems.Paragraph(elems.Strong(elems.Text("Page Not Found"))),
+			elems.Paragraph(elems.Text("No view matches the requested route.")),
+		)
+	}
+}
+
+// defaultFailure returns the base used for the Failure view when none
+// is set in the AppAttr.
+func defaultFailure(app *NApp) interface{} {
+	return func(err error) *trees.Markup {
+		return elems.Div(
+			trees.NewAttr("class", "gu-failure"),
+			elems.Paragraph(elems.Strong(elems.Text("Something Went Wrong"))),
+			elems.Paragraph(elems.Text(err.Error())),
+		)
+	}
+}
+
+// failureView defines a Renderable which renders the current failure of
+// a app using a provided function.
+type failureView struct {
+	app    *NApp
+	render func(error) *trees.Markup
+}
+
+// Render returns the markup for the app's current failure.
+func (f *failureView) Render() *trees.Markup {
+	if f.app.failed == nil {
+		return f.render(fmt.Errorf("Unknown failure"))
+	}
+
+	return f.render(f.app.failed)
+}
+
+// Theme returns the active theme of the app, or nil without one.
+func (app *NApp) Theme() *css.Theme {
+	return app.theme
+}
+
+// SetTheme sets the active theme of the app, whose tokens are written as css
+// custom properties within a stylesheet in the head. Stylesheets of
+// components refer to the tokens with css.Var or the token template
+// function, hence switching the theme of a rendered app only updates the
+// stylesheet of the theme.
+func (app *NApp) SetTheme(theme *css.Theme) {
+	app.theme = theme
+
+	if app.themeView == nil {
+		app.themeView = app.newView(ViewAttr{
+			Name:   fmt.Sprintf("%s.Theme", app.attr.Name),
+			Route:  "*",
+			Target: HeadTarget,
+		})
+
+		app.themeView.newComponent(ComponentAttr{Base: &themeStyle{app: app}})
+	}
+
+	if app.active {
+		app.driver.Update(app, app.themeView)
+	}
+}
+
+// withTheme returns the views with the view of the theme first when the app
+// has one.
+func (app *NApp) withTheme(views []*NView) []*NView {
+	if app.themeView == nil {
+		return views
+	}
+
+	return append([]*NView{app.themeView}, views...)
+}
+
+// themeStyle defines a Renderable which renders the stylesheet of the active
+// theme of a app.
+type themeStyle struct {
+	app *NApp
+}
+
+// Render returns the stylesheet of the app's active theme.
+func (t *themeStyle) Render() *trees.Markup {
+	style := trees.NewMarkup("style", false)
+
+	if theme := t.app.theme; theme != nil {
+		trees.NewAttr("gu-theme", theme.Name).Apply(style)
+		trees.NewText(theme.Stylesheet().String()).Apply(style)
+	}
+
+	return style
+}
+
 // RenderableData defines a struct which contains the name of a giving renderable
 // and it's package.
 type RenderableData struct {
@@ -502,6 +1111,8 @@ type NView struct {
 	driver        Driver
 	attr          ViewAttr
 	notifications *notifications.AppNotification
+	lifecycle     *Lifecycle
+	order         int
 
 	renderingData []RenderableData
 	local         []shell.AppManifest
@@ -598,6 +1209,40 @@ func (v *NView) Render() *trees.Markup {
 	return base
 }
 
+// BeforeLeave adds a hook called before the view is left for a route it does
+// not match. See Lifecycle.BeforeLeave.
+func (v *NView) BeforeLeave(hook interface{}) {
+	v.lifecycle.BeforeLeave(hook)
+}
+
+// AfterEnter adds a hook called once the view is activated for a route.
+// See Lifecycle.AfterEnter.
+func (v *NView) AfterEnter(hook interface{}) {
+	v.lifecycle.AfterEnter(hook)
+}
+
+// AfterRender adds a hook called after the view is first rendered for a
+// route. See Lifecycle.AfterRender.
+func (v *NView) AfterRender(hook interface{}) {
+	v.lifecycle.AfterRender(hook)
+}
+
+// matchLifecycles returns the lifecycle of the view and those of the components
+// which match the provided remaining path of the route.
+func (v *NView) matchLifecycles(rem string) []*Lifecycle {
+	matched := []*Lifecycle{v.lifecycle}
+
+	for _, components := range [][]*Component{v.beginComponents, v.anyComponents, v.lastComponents} {
+		for _, component := range components {
+			if _, _, ok := component.Router.Test(rem); ok {
+				matched = append(matched, component.Lifecycle)
+			}
+		}
+	}
+
+	return matched
+}
+
 // Attr returns the views ViewAttr.
 func (v *NView) Attr() ViewAttr {
 	return v.attr
@@ -608,6 +1253,13 @@ func (v *NView) propagateRoute(pe router.PushEvent) {
 	v.router.Resolve(pe)
 }
 
+// leaveRoute disables and unmounts the view once it's route no longer
+// matches.
+func (v *NView) leaveRoute(pe router.PushEvent) {
+	v.disableView()
+	v.Unmounted()
+}
+
 // Resources return the giving resource headers which relate with the
 // view.
 func (v *NView) Resources() ([]*trees.Markup, []*trees.Markup) {
@@ -658,6 +1310,10 @@ func (v *NView) Resources() ([]*trees.Markup, []*trees.Markup) {
 		}
 	}
 
+	if v.root != nil {
+		v.root.applyNonce(head, body)
+	}
+
 	return head, body
 }
 
@@ -722,10 +1378,23 @@ type ComponentAttr struct {
 	Route     string         `json:"route"`
 	Base      interface{}    `json:"base"`
 	Relations []string       `json:"relations"`
+
+	// Policy sanitizes the markup of a string base when set, which should be
+	// done for markup written by users.
+	Policy *trees.Policy `json:"-"`
 }
 
 // Component adds the provided component into the selected view.
 func (v *NView) Component(attr ComponentAttr) {
+	v.newComponent(attr)
+
+	// Send call for view update.
+	v.driver.Update(v.root, v)
+}
+
+// newComponent creates and adds the provided component into the view
+// returning it.
+func (v *NView) newComponent(attr ComponentAttr) *Component {
 	if strings.TrimSpace(attr.Route) == "" {
 		attr.Route = "*"
 	}
@@ -738,6 +1407,7 @@ func (v *NView) Component(attr ComponentAttr) {
 	c.Unmounted = NewSubscriptions()
 	c.Rendered = NewSubscriptions()
 	c.Updated = NewSubscriptions()
+	c.Lifecycle = NewLifecycle()
 	c.Router = router.New(attr.Route)
 
 	if attr.Tag == "" {
@@ -759,7 +1429,9 @@ func (v *NView) Component(attr ComponentAttr) {
 				Updated:       c.Updated,
 				Unmounted:     c.Unmounted,
 				Rendered:      c.Rendered,
+				Lifecycle:     c.Lifecycle,
 				Notifications: v.notifications,
+				Fail:          v.root.Fail,
 			}))
 
 			static.Morph = true
@@ -778,6 +1450,9 @@ func (v *NView) Component(attr ComponentAttr) {
 
 		case string:
 			parseTree := trees.ParseTree(mo)
+			if attr.Policy != nil {
+				parseTree = attr.Policy.SanitizeTree(parseTree)
+			}
 			if len(parseTree) != 1 {
 				section := elems.CustomElement(attr.Tag)
 				section.AddChild(parseTree...)
@@ -805,7 +1480,9 @@ func (v *NView) Component(attr ComponentAttr) {
 					Updated:       c.Updated,
 					Unmounted:     c.Unmounted,
 					Rendered:      c.Rendered,
+					Lifecycle:     c.Lifecycle,
 					Notifications: v.notifications,
+					Fail:          v.root.Fail,
 				})
 			}
 
@@ -830,7 +1507,9 @@ func (v *NView) Component(attr ComponentAttr) {
 				Updated:       c.Updated,
 				Unmounted:     c.Unmounted,
 				Rendered:      c.Rendered,
+				Lifecycle:     c.Lifecycle,
 				Notifications: v.notifications,
+				Fail:          v.root.Fail,
 			})
 
 			if renderField, _, err := reflection.StructAndEmbeddedTypeNames(rc); err == nil {
@@ -857,7 +1536,9 @@ func (v *NView) Component(attr ComponentAttr) {
 					Updated:       c.Updated,
 					Unmounted:     c.Unmounted,
 					Rendered:      c.Rendered,
+					Lifecycle:     c.Lifecycle,
 					Notifications: v.notifications,
+					Fail:          v.root.Fail,
 				})
 			}
 
@@ -910,6 +1591,7 @@ func (v *NView) Component(attr ComponentAttr) {
 	c.React(v.Publish)
 
 	// Register the component router into the views router.
+	c.Router.SetOwner(fmt.Sprintf("%T", c.Rendering))
 	v.router.Register(c.Router)
 
 	// Collect necessary app manifest that connect with rendering.
@@ -929,8 +1611,7 @@ func (v *NView) Component(attr ComponentAttr) {
 		}
 	}
 
-	// Send call for view update.
-	v.driver.Update(v.root, v)
+	return &c
 }
 
 // Component defines a struct which
@@ -945,6 +1626,7 @@ type Component struct {
 	Unmounted Subscriptions
 	Rendered  Subscriptions
 	Updated   Subscriptions
+	Lifecycle *Lifecycle
 	live      *trees.Markup
 }
 
@@ -958,7 +1640,9 @@ func (c *Component) Render() *trees.Markup {
 	newTree := c.Rendering.Render()
 	newTree.SwapUID(c.uuid)
 
-	if c.live != nil {
+	// static renderings return the same markup on every render, which must
+	// not be emptied has it is also the new tree.
+	if c.live != nil && c.live != newTree {
 		live := c.live
 		live.EachEvent(func(e *trees.Event, _ *trees.Markup) {
 			if e.Handle != nil {
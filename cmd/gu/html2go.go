@@ -0,0 +1,464 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/property"
+)
+
+// rawTextElements contains the elements whose text is written as is.
+var rawTextElements = map[string]bool{
+	"pre": true, "textarea": true, "script": true, "style": true,
+}
+
+var (
+	templateActions = regexp.MustCompile(`{{-?\s*(.*?)\s*-?}}`)
+	templateField   = regexp.MustCompile(`^\.([A-Z][A-Za-z0-9_]*)$`)
+	spaces          = regexp.MustCompile(`\s+`)
+)
+
+// html2go converts html markup into Go source declaring a component whose
+// Render method builds the markup with the elems, property and events
+// packages.
+type html2go struct {
+	pkg       string
+	component string
+	receiver  string
+	source    string
+
+	body     bytes.Buffer
+	imports  map[string]bool
+	fields   []string
+	handlers []eventHandler
+	names    map[string]int
+}
+
+// eventHandler defines a handler method generated for a on* attribute.
+type eventHandler struct {
+	method string
+	attr   string
+	script string
+}
+
+// newHTML2Go returns a new html2go for the component of the provided package.
+func newHTML2Go(pkg string, component string, source string) *html2go {
+	return &html2go{
+		pkg:       pkg,
+		component: component,
+		source:    source,
+		receiver:  strings.ToLower(component[:1]),
+		imports:   map[string]bool{"github.com/gu-io/gu": true, "github.com/gu-io/gu/trees": true},
+		names:     make(map[string]int),
+	}
+}
+
+// Convert returns the formatted Go source of the component for the markup.
+// Markup errors, such as unclosed elements, are returned with the source.
+func (h *html2go) Convert(markup string) ([]byte, error) {
+	tree, perr := trees.ParseTreeErr(markup)
+
+	var roots []*trees.Markup
+	for _, node := range tree {
+		if node.IsDoctype() {
+			continue
+		}
+
+		if node.Name() == "text" && !node.IsComment() && strings.TrimSpace(node.TextContent()) == "" {
+			continue
+		}
+
+		roots = append(roots, node)
+	}
+
+	var elements int
+	for _, node := range roots {
+		if node.Name() != "text" {
+			elements++
+		}
+	}
+
+	switch {
+	case elements == 1 && len(roots) == 1:
+		h.writeMarkup(roots[0], 1, false)
+	default:
+		h.imports["github.com/gu-io/gu/trees/elems"] = true
+		h.body.WriteString("elems.Div(\n")
+		for _, node := range roots {
+			h.writeArg(node, 2, false)
+		}
+		h.body.WriteString("\t)")
+	}
+
+	var out bytes.Buffer
+	h.writeFile(&out)
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		return out.Bytes(), err
+	}
+
+	if perr != nil {
+		return source, perr
+	}
+
+	return source, nil
+}
+
+// writeFile writes the package, component type and methods into the buffer.
+func (h *html2go) writeFile(out *bytes.Buffer) {
+	fmt.Fprintf(out, "// This file was generated by gu html2go from %s.\n\n", h.source)
+	fmt.Fprintf(out, "package %s\n\n", h.pkg)
+
+	var imports []string
+	for path := range h.imports {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+
+	out.WriteString("import (\n")
+	for index, path := range imports {
+		if index > 0 && !strings.Contains(imports[index-1], ".") && strings.Contains(path, ".") {
+			out.WriteString("\n")
+		}
+
+		fmt.Fprintf(out, "%q\n", path)
+	}
+	out.WriteString(")\n\n")
+
+	fmt.Fprintf(out, "// %s defines a component generated from %s.\n", h.component, h.source)
+	fmt.Fprintf(out, "type %s struct {\n\tgu.Reactive\n", h.component)
+	for _, field := range h.fields {
+		fmt.Fprintf(out, "\t%s string\n", field)
+	}
+	out.WriteString("}\n\n")
+
+	fmt.Fprintf(out, "// New%s returns a new instance of a %s.\n", h.component, h.component)
+	fmt.Fprintf(out, "func New%s() *%s {\n\treturn &%s{\n\t\tReactive: gu.NewReactive(),\n\t}\n}\n\n", h.component, h.component, h.component)
+
+	fmt.Fprintf(out, "// Render returns the markup for the %s.\n", h.component)
+	fmt.Fprintf(out, "func (%s *%s) Render() *trees.Markup {\n\treturn ", h.receiver, h.component)
+	out.Write(h.body.Bytes())
+	out.WriteString("\n}\n")
+
+	for _, handler := range h.handlers {
+		fmt.Fprintf(out, "\n// %s handles the event of the %s attribute.\n", handler.method, handler.attr)
+		fmt.Fprintf(out, "func (%s *%s) %s(event trees.EventObject, root *trees.Markup) {\n", h.receiver, h.component, handler.method)
+		fmt.Fprintf(out, "\t// TODO: port %q.\n}\n", handler.script)
+	}
+}
+
+// writeArg writes the markup as a argument of it's parent's function.
+func (h *html2go) writeArg(node *trees.Markup, depth int, raw bool) {
+	h.body.WriteString(strings.Repeat("\t", depth))
+	h.writeMarkup(node, depth, raw)
+	h.body.WriteString(",\n")
+}
+
+// writeMarkup writes the expression creating the markup.
+func (h *html2go) writeMarkup(node *trees.Markup, depth int, raw bool) {
+	if node.Name() == "text" {
+		h.writeText(node, depth, raw)
+		return
+	}
+
+	tag := node.Name()
+	lower := strings.ToLower(tag)
+
+	h.imports["github.com/gu-io/gu/trees/elems"] = true
+
+	if fn, ok := svgElems[lower]; ok && (insideSVG(node) || lower == "svg") {
+		fmt.Fprintf(&h.body, "elems.%s(", fn)
+	} else if fn, ok := htmlElems[lower]; ok {
+		fmt.Fprintf(&h.body, "elems.%s(", fn)
+	} else if strings.Contains(tag, "-") {
+		fmt.Fprintf(&h.body, "elems.CustomElement(%q,", tag)
+	} else {
+		fmt.Fprintf(&h.body, "elems.Element(%q,", tag)
+	}
+
+	var args int
+	for _, attr := range node.Attributes() {
+		name, value := attr.Render()
+		if name == "data-gen" {
+			continue
+		}
+
+		if args == 0 {
+			h.body.WriteString("\n")
+		}
+
+		args++
+		h.body.WriteString(strings.Repeat("\t", depth+1))
+		h.writeAttr(name, value, depth+1)
+		h.body.WriteString(",\n")
+	}
+
+	raw = raw || rawTextElements[lower]
+
+	for _, child := range node.Children() {
+		if child.Name() == "text" && !raw && !child.IsComment() && strings.TrimSpace(child.TextContent()) == "" {
+			continue
+		}
+
+		if args == 0 {
+			h.body.WriteString("\n")
+		}
+
+		args++
+		h.writeArg(child, depth+1, raw)
+	}
+
+	if args != 0 {
+		h.body.WriteString(strings.Repeat("\t", depth))
+	}
+
+	h.body.WriteString(")")
+}
+
+// writeText writes the expression creating the text markup.
+func (h *html2go) writeText(node *trees.Markup, depth int, raw bool) {
+	if node.IsComment() {
+		content := strings.TrimSuffix(strings.TrimPrefix(node.TextContent(), "<!--"), "-->")
+		fmt.Fprintf(&h.body, "trees.NewComment(%q)", content)
+		return
+	}
+
+	text := node.TextContent()
+	if !raw {
+		text = spaces.ReplaceAllString(text, " ")
+	}
+
+	h.imports["github.com/gu-io/gu/trees/elems"] = true
+
+	layout, args := h.templateText(text, depth)
+	if len(args) == 0 {
+		fmt.Fprintf(&h.body, "elems.Text(%q)", text)
+		return
+	}
+
+	fmt.Fprintf(&h.body, "elems.Text(%q, %s)", layout, strings.Join(args, ", "))
+}
+
+// writeAttr writes the expression setting the attribute.
+func (h *html2go) writeAttr(name string, value string, depth int) {
+	lower := strings.ToLower(name)
+
+	if strings.HasPrefix(lower, "on") {
+		if fn, ok := eventFuncs[lower[2:]]; ok {
+			method := h.uniqueName("handle" + exportName(lower[2:]))
+			h.handlers = append(h.handlers, eventHandler{method: method, attr: lower, script: value})
+			h.imports["github.com/gu-io/gu/trees/events"] = true
+			fmt.Fprintf(&h.body, "events.%s(%s.%s, \"\")", fn, h.receiver, method)
+			return
+		}
+	}
+
+	switch lower {
+	case "class":
+		classes := strings.Fields(value)
+		quoted := make([]string, 0, len(classes))
+		for _, class := range classes {
+			quoted = append(quoted, fmt.Sprintf("%q", class))
+		}
+
+		h.imports["github.com/gu-io/gu/trees/property"] = true
+		fmt.Fprintf(&h.body, "property.ClassAttr(%s)", strings.Join(quoted, ", "))
+		return
+
+	case "style":
+		h.writeStyles(value, depth)
+		return
+	}
+
+	expr := h.templateString(value, depth)
+
+	// Boolean attributes are set by their presence, hence their functions
+	// are only used when the value does not come from the template.
+	if fn, ok := property.AttrFuncs[lower]; ok {
+		switch {
+		case !property.BooleanAttrs[lower]:
+			h.imports["github.com/gu-io/gu/trees/property"] = true
+			fmt.Fprintf(&h.body, "property.%s(%s)", fn, expr)
+			return
+
+		case !templateActions.MatchString(value):
+			h.imports["github.com/gu-io/gu/trees/property"] = true
+			fmt.Fprintf(&h.body, "property.%s(true)", fn)
+			return
+		}
+	}
+
+	if strings.HasPrefix(lower, "data-") && len(lower) > len("data-") {
+		h.imports["github.com/gu-io/gu/trees/property"] = true
+		fmt.Fprintf(&h.body, "property.DataAttr(%q, %s)", lower[len("data-"):], expr)
+		return
+	}
+
+	fmt.Fprintf(&h.body, "trees.NewAttr(%q, %s)", name, expr)
+}
+
+// writeStyles writes the expressions setting the declarations of a style
+// attribute.
+func (h *html2go) writeStyles(value string, depth int) {
+	var written int
+	for _, declaration := range strings.Split(value, ";") {
+		parts := strings.SplitN(declaration, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			continue
+		}
+
+		if written != 0 {
+			h.body.WriteString(",\n" + strings.Repeat("\t", depth))
+		}
+
+		written++
+
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+		expr := h.templateString(strings.TrimSpace(parts[1]), depth)
+
+		if fn, ok := property.StyleFuncs[name]; ok {
+			h.imports["github.com/gu-io/gu/trees/property"] = true
+			fmt.Fprintf(&h.body, "property.%s(%s)", fn, expr)
+			continue
+		}
+
+		fmt.Fprintf(&h.body, "trees.NewCSSStyle(%q, %s)", name, expr)
+	}
+
+	if written == 0 {
+		h.body.WriteString("nil")
+	}
+}
+
+// templateString returns the Go expression for a attribute value, where
+// template fields are formatted from fields of the component.
+func (h *html2go) templateString(value string, depth int) string {
+	layout, args := h.templateText(value, depth)
+	if len(args) == 0 {
+		return fmt.Sprintf("%q", value)
+	}
+
+	if layout == "%s" {
+		return args[0]
+	}
+
+	h.imports["fmt"] = true
+	return fmt.Sprintf("fmt.Sprintf(%q, %s)", layout, strings.Join(args, ", "))
+}
+
+// templateText returns the format layout and arguments for the template
+// fields of the text, such as {{.Name}}, which become string fields of the
+// component. Other template actions are kept as text with a TODO comment.
+func (h *html2go) templateText(text string, depth int) (string, []string) {
+	matches := templateActions.FindAllStringSubmatchIndex(text, -1)
+	if matches == nil {
+		return text, nil
+	}
+
+	var layout bytes.Buffer
+	var args []string
+	var last int
+
+	for _, match := range matches {
+		action := text[match[2]:match[3]]
+
+		field := templateField.FindStringSubmatch(action)
+		if field == nil {
+			fmt.Fprintf(&h.body, "// TODO: convert template action %q.\n%s", text[match[0]:match[1]], strings.Repeat("\t", depth))
+			continue
+		}
+
+		layout.WriteString(strings.Replace(text[last:match[0]], "%", "%%", -1))
+		layout.WriteString("%s")
+		last = match[1]
+
+		h.addField(field[1])
+		args = append(args, h.receiver+"."+field[1])
+	}
+
+	if args == nil {
+		return text, nil
+	}
+
+	layout.WriteString(strings.Replace(text[last:], "%", "%%", -1))
+	return layout.String(), args
+}
+
+// addField adds a string field to the component if not yet added.
+func (h *html2go) addField(name string) {
+	for _, field := range h.fields {
+		if field == name {
+			return
+		}
+	}
+
+	h.fields = append(h.fields, name)
+}
+
+// uniqueName returns the name suffixed with a number if already used.
+func (h *html2go) uniqueName(name string) string {
+	h.names[name]++
+	if count := h.names[name]; count > 1 {
+		return fmt.Sprintf("%s%d", name, count)
+	}
+
+	return name
+}
+
+// insideSVG returns true/false if the markup is within a svg element.
+func insideSVG(node *trees.Markup) bool {
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		if strings.EqualFold(parent.Name(), "svg") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exportName returns the name in camel case, starting with a uppercase
+// letter, with non letter or digit characters removed.
+func exportName(name string) string {
+	var out []rune
+	upper := true
+
+	for _, char := range name {
+		if !unicode.IsLetter(char) && !unicode.IsDigit(char) {
+			upper = true
+			continue
+		}
+
+		if len(out) == 0 && unicode.IsDigit(char) {
+			out = append(out, 'X')
+		}
+
+		if upper {
+			char = unicode.ToUpper(char)
+			upper = false
+		}
+
+		out = append(out, char)
+	}
+
+	return string(out)
+}
+
+// componentName returns the component name for a html file, such as
+// UserCard for user-card.html.
+func componentName(file string) string {
+	base := filepath.Base(file)
+	name := exportName(strings.TrimSuffix(base, filepath.Ext(base)))
+	if name == "" {
+		return "Component"
+	}
+
+	return name
+}
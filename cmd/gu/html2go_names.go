@@ -0,0 +1,508 @@
+package main
+
+// The tables below are taken from the generated elems and events packages and
+// should be updated when those are regenerated, which TestNameTables checks.
+
+// htmlElems maps html tag names to the elems functions creating them.
+var htmlElems = map[string]string{
+	"a":          "Anchor",
+	"abbr":       "Abbreviation",
+	"address":    "Address",
+	"area":       "Area",
+	"article":    "Article",
+	"aside":      "Aside",
+	"audio":      "Audio",
+	"b":          "Bold",
+	"base":       "Base",
+	"bdi":        "BidirectionalIsolation",
+	"bdo":        "BidirectionalOverride",
+	"blockquote": "BlockQuote",
+	"br":         "Break",
+	"button":     "Button",
+	"canvas":     "Canvas",
+	"caption":    "Caption",
+	"cite":       "Citation",
+	"code":       "Code",
+	"col":        "Column",
+	"colgroup":   "ColumnGroup",
+	"data":       "Data",
+	"datalist":   "DataList",
+	"dd":         "Description",
+	"del":        "DeletedText",
+	"details":    "Details",
+	"dfn":        "Definition",
+	"dialog":     "Dialog",
+	"div":        "Div",
+	"dl":         "DescriptionList",
+	"dt":         "DefinitionTerm",
+	"em":         "Emphasis",
+	"embed":      "Embed",
+	"fieldset":   "FieldSet",
+	"figcaption": "FigureCaption",
+	"figure":     "Figure",
+	"footer":     "Footer",
+	"form":       "Form",
+	"header":     "Header",
+	"hgroup":     "HeadingsGroup",
+	"hr":         "HorizontalRule",
+	"i":          "Italic",
+	"iframe":     "InlineFrame",
+	"img":        "Image",
+	"input":      "Input",
+	"ins":        "InsertedText",
+	"kbd":        "KeyboardInput",
+	"label":      "Label",
+	"legend":     "Legend",
+	"li":         "ListItem",
+	"link":       "Link",
+	"main":       "Main",
+	"map":        "Map",
+	"mark":       "Mark",
+	"menu":       "Menu",
+	"menuitem":   "MenuItem",
+	"meta":       "Meta",
+	"meter":      "Meter",
+	"nav":        "Navigation",
+	"noframes":   "NoFrames",
+	"noscript":   "NoScript",
+	"object":     "Object",
+	"ol":         "OrderedList",
+	"optgroup":   "OptionsGroup",
+	"option":     "Option",
+	"output":     "Output",
+	"p":          "Paragraph",
+	"param":      "Parameter",
+	"picture":    "Picture",
+	"pre":        "Preformatted",
+	"progress":   "Progress",
+	"q":          "Quote",
+	"rp":         "RubyParenthesis",
+	"rt":         "RubyText",
+	"rtc":        "Rtc",
+	"ruby":       "Ruby",
+	"s":          "Strikethrough",
+	"samp":       "Sample",
+	"script":     "Script",
+	"section":    "Section",
+	"select":     "Select",
+	"slot":       "Slot",
+	"small":      "Small",
+	"source":     "Source",
+	"span":       "Span",
+	"strong":     "Strong",
+	"style":      "Style",
+	"sub":        "Subscript",
+	"summary":    "Summary",
+	"sup":        "Superscript",
+	"table":      "Table",
+	"tbody":      "TableBody",
+	"td":         "TableData",
+	"template":   "Template",
+	"textarea":   "TextArea",
+	"tfoot":      "TableFoot",
+	"th":         "TableHeader",
+	"thead":      "TableHead",
+	"time":       "Time",
+	"title":      "Title",
+	"tr":         "TableRow",
+	"track":      "Track",
+	"u":          "Underline",
+	"ul":         "UnorderedList",
+	"var":        "Variable",
+	"video":      "Video",
+	"wbr":        "WordBreakOpportunity",
+}
+
+// svgElems maps svg tag names to the elems functions creating them.
+var svgElems = map[string]string{
+	"a":                   "SvgAnchor",
+	"altGlyph":            "SvgAltGlyph",
+	"altGlyphDef":         "SvgAltGlyphDef",
+	"altGlyphItem":        "SvgAltGlyphItem",
+	"animate":             "SvgAnimate",
+	"animateColor":        "SvgAnimateColor",
+	"animateMotion":       "SvgAnimateMotion",
+	"animateTransform":    "SvgAnimateTransform",
+	"circle":              "SvgCircle",
+	"clipPath":            "SvgClipPath",
+	"color-profile":       "SvgColorProfile",
+	"cursor":              "SvgCursor",
+	"defs":                "SvgDefs",
+	"desc":                "SvgDesc",
+	"discard":             "SvgDiscard",
+	"ellipse":             "SvgEllipse",
+	"feBlend":             "SvgFeBlend",
+	"feColorMatrix":       "SvgFeColorMatrix",
+	"feComponentTransfer": "SvgFeComponentTransfer",
+	"feComposite":         "SvgFeComposite",
+	"feConvolveMatrix":    "SvgFeConvolveMatrix",
+	"feDiffuseLighting":   "SvgFeDiffuseLighting",
+	"feDisplacementMap":   "SvgFeDisplacementMap",
+	"feDistantLight":      "SvgFeDistantLight",
+	"feDropShadow":        "SvgFeDropShadow",
+	"feFlood":             "SvgFeFlood",
+	"feFuncA":             "SvgFeFuncA",
+	"feFuncB":             "SvgFeFuncB",
+	"feFuncG":             "SvgFeFuncG",
+	"feFuncR":             "SvgFeFuncR",
+	"feGaussianBlur":      "SvgFeGaussianBlur",
+	"feImage":             "SvgFeImage",
+	"feMerge":             "SvgFeMerge",
+	"feMergeNode":         "SvgFeMergeNode",
+	"feMorphology":        "SvgFeMorphology",
+	"feOffset":            "SvgFeOffset",
+	"fePointLight":        "SvgFePointLight",
+	"feSpecularLighting":  "SvgFeSpecularLighting",
+	"feSpotLight":         "SvgFeSpotLight",
+	"feTile":              "SvgFeTile",
+	"feTurbulence":        "SvgFeTurbulence",
+	"filter":              "SvgFilter",
+	"font":                "SvgFont",
+	"font-face":           "SvgFontFace",
+	"font-face-format":    "SvgFontFaceFormat",
+	"font-face-name":      "SvgFontfaceName",
+	"font-face-src":       "SvgFontFaceSrc",
+	"font-face-uri":       "SvgFontfaceURI",
+	"foreignObject":       "SvgForeignObject",
+	"g":                   "SvgGroup",
+	"glyph":               "SvgGlyph",
+	"glyphRef":            "SvgGlyphRef",
+	"hatch":               "SvgHatch",
+	"hatchpath":           "SvgHatchpath",
+	"hkern":               "SvgHkern",
+	"image":               "SvgImage",
+	"line":                "SvgLine",
+	"linearGradient":      "SvgLinearGradient",
+	"marker":              "SvgMarker",
+	"mask":                "SvgMask",
+	"mesh":                "SvgMesh",
+	"meshgradient":        "SvgMeshgradient",
+	"meshpatch":           "SvgMeshpatch",
+	"meshrow":             "SvgMeshrow",
+	"metadata":            "SvgMetadata",
+	"missing-glyph":       "SvgMissingGlyph",
+	"mpath":               "SvgMpath",
+	"path":                "SvgPath",
+	"pattern":             "SvgPattern",
+	"polygon":             "SvgPolygon",
+	"polyline":            "SvgPolyline",
+	"radialGradient":      "SvgRadialGradient",
+	"rect":                "SvgRect",
+	"script":              "SvgScript",
+	"set":                 "SvgSet",
+	"solidcolor":          "SvgSolidcolor",
+	"stop":                "SvgStop",
+	"style":               "SvgStyle",
+	"svg":                 "Svg",
+	"switch":              "SvgSwitch",
+	"symbol":              "SvgSymbol",
+	"text":                "SvgText",
+	"textPath":            "SvgTextPath",
+	"title":               "SvgTitle",
+	"tref":                "SvgTref",
+	"tspan":               "SvgTspan",
+	"unknown":             "SvgUnknown",
+	"use":                 "SvgUse",
+	"view":                "SvgView",
+	"vkern":               "SvgVkern",
+}
+
+// eventFuncs maps lowercased event names to the events functions binding them.
+var eventFuncs = map[string]string{
+	"abort":                                 "AbortEvent",
+	"afterprint":                            "AfterPrintEvent",
+	"afterscriptexecute":                    "AfterScriptExecuteEvent",
+	"alertactive":                           "AlertActiveEvent",
+	"alertclose":                            "AlertCloseEvent",
+	"alerting":                              "AlertingEvent",
+	"animationend":                          "AnimationEndEvent",
+	"animationiteration":                    "AnimationIterationEvent",
+	"animationstart":                        "AnimationStartEvent",
+	"appinstalled":                          "AppinstalledEvent",
+	"audioend":                              "AudioendEvent",
+	"audioprocess":                          "AudioProcessEvent",
+	"audiostart":                            "AudiostartEvent",
+	"beforeinstallprompt":                   "BeforeInstallPromptEvent",
+	"beforeprint":                           "BeforePrintEvent",
+	"beforescriptexecute":                   "BeforeScriptExecuteEvent",
+	"beforeunload":                          "BeforeUnloadEvent",
+	"beginevent":                            "BeginEventEvent",
+	"blocked":                               "BlockedEvent",
+	"blur":                                  "BlurEvent",
+	"boundary":                              "BoundaryEvent",
+	"broadcast":                             "BroadcastEvent",
+	"busy":                                  "BusyEvent",
+	"cached":                                "CachedEvent",
+	"callschanged":                          "CallschangedEvent",
+	"canplay":                               "CanPlayEvent",
+	"canplaythrough":                        "CanPlayThroughEvent",
+	"cardstatechange":                       "CardstatechangeEvent",
+	"cfstatechange":                         "CfstatechangeEvent",
+	"change":                                "ChangeEvent",
+	"chargingchange":                        "ChargingChangeEvent",
+	"chargingtimechange":                    "ChargingTimeChangeEvent",
+	"checkboxstatechange":                   "CheckboxStateChangeEvent",
+	"checking":                              "CheckingEvent",
+	"click":                                 "ClickEvent",
+	"close":                                 "CloseEvent",
+	"command":                               "CommandEvent",
+	"commandupdate":                         "CommandupdateEvent",
+	"complete":                              "CompleteEvent",
+	"compositionend":                        "CompositionEndEvent",
+	"compositionstart":                      "CompositionStartEvent",
+	"compositionupdate":                     "CompositionUpdateEvent",
+	"connecting":                            "ConnectingEvent",
+	"connectioninfoupdate":                  "ConnectionInfoUpdateEvent",
+	"contextmenu":                           "ContextMenuEvent",
+	"copy":                                  "CopyEvent",
+	"cssruleviewchange":                     "CSSRuleViewChangeEvent",
+	"cssruleviewcsslinkclicked":             "CSSRuleViewCSSLinkClickedEvent",
+	"cssruleviewrefreshed":                  "CSSRuleViewRefreshedEvent",
+	"cut":                                   "CutEvent",
+	"datachange":                            "DatachangeEvent",
+	"dataerror":                             "DataerrorEvent",
+	"dblclick":                              "DblClickEvent",
+	"delivered":                             "DeliveredEvent",
+	"devicechange":                          "DevicechangeEvent",
+	"devicelight":                           "DeviceLightEvent",
+	"devicemotion":                          "DeviceMotionEvent",
+	"deviceorientation":                     "DeviceOrientationEvent",
+	"deviceproximity":                       "DeviceProximityEvent",
+	"dialing":                               "DialingEvent",
+	"disabled":                              "DisabledEvent",
+	"dischargingtimechange":                 "DischargingTimeChangeEvent",
+	"disconnected":                          "DisconnectedEvent",
+	"disconnecting":                         "DisconnectingEvent",
+	"domautocomplete":                       "DOMAutoCompleteEvent",
+	"domcontentloaded":                      "DOMContentLoadedEvent",
+	"domframecontentloaded":                 "DOMFrameContentLoadedEvent",
+	"domlinkadded":                          "DOMLinkAddedEvent",
+	"domlinkremoved":                        "DOMLinkRemovedEvent",
+	"dommenuitemactive":                     "DOMMenuItemActiveEvent",
+	"dommenuiteminactive":                   "DOMMenuItemInactiveEvent",
+	"dommetaadded":                          "DOMMetaAddedEvent",
+	"dommetaremoved":                        "DOMMetaRemovedEvent",
+	"dommodaldialogclosed":                  "DOMModalDialogClosedEvent",
+	"dompopupblocked":                       "DOMPopupBlockedEvent",
+	"domtitlechanged":                       "DOMTitleChangedEvent",
+	"domwillopenmodaldialog":                "DOMWillOpenModalDialogEvent",
+	"domwindowclose":                        "DOMWindowCloseEvent",
+	"domwindowcreated":                      "DOMWindowCreatedEvent",
+	"downloading":                           "DownloadingEvent",
+	"drag":                                  "DragEvent",
+	"dragend":                               "DragEndEvent",
+	"dragenter":                             "DragEnterEvent",
+	"dragleave":                             "DragLeaveEvent",
+	"dragover":                              "DragOverEvent",
+	"dragstart":                             "DragStartEvent",
+	"drop":                                  "DropEvent",
+	"durationchange":                        "DurationChangeEvent",
+	"emptied":                               "EmptiedEvent",
+	"enabled":                               "EnabledEvent",
+	"end":                                   "EndEvent",
+	"ended":                                 "EndedEvent",
+	"endevent":                              "EndEventEvent",
+	"focus":                                 "FocusEvent",
+	"focusin":                               "FocusInEvent",
+	"focusout":                              "FocusOutEvent",
+	"fullscreen":                            "FullscreenEvent",
+	"fullscreenchange":                      "FullScreenChangeEvent",
+	"fullscreenerror":                       "FullScreenErrorEvent",
+	"gamepadconnected":                      "GamepadConnectedEvent",
+	"gamepaddisconnected":                   "GamepadDisconnectedEvent",
+	"gotpointercapture":                     "GotpointercaptureEvent",
+	"hashchange":                            "HashChangeEvent",
+	"held":                                  "HeldEvent",
+	"holding":                               "HoldingEvent",
+	"icccardlockerror":                      "IcccardlockerrorEvent",
+	"iccinfochange":                         "IccinfochangeEvent",
+	"incoming":                              "IncomingEvent",
+	"input":                                 "InputEvent",
+	"invalid":                               "InvalidEvent",
+	"keydown":                               "KeyDownEvent",
+	"keypress":                              "KeyPressEvent",
+	"keyup":                                 "KeyUpEvent",
+	"languagechange":                        "LanguageChangeEvent",
+	"levelchange":                           "LevelChangeEvent",
+	"load":                                  "LoadEvent",
+	"loadeddata":                            "LoadedDataEvent",
+	"loadedmetadata":                        "LoadedMetadataEvent",
+	"loadend":                               "LoadEndEvent",
+	"loadstart":                             "LoadStartEvent",
+	"localized":                             "LocalizedEvent",
+	"lostpointercapture":                    "LostpointercaptureEvent",
+	"mark":                                  "MarkEvent",
+	"message":                               "MessageEvent",
+	"mousedown":                             "MouseDownEvent",
+	"mouseenter":                            "MouseEnterEvent",
+	"mouseleave":                            "MouseLeaveEvent",
+	"mousemove":                             "MouseMoveEvent",
+	"mouseout":                              "MouseOutEvent",
+	"mouseover":                             "MouseOverEvent",
+	"mouseup":                               "MouseUpEvent",
+	"mozafterpaint":                         "MozAfterPaintEvent",
+	"mozaudioavailable":                     "MozAudioAvailableEvent",
+	"mozbeforeresize":                       "MozBeforeResizeEvent",
+	"mozbrowseractivitydone":                "MozbrowseractivitydoneEvent",
+	"mozbrowserasyncscroll":                 "MozbrowserasyncscrollEvent",
+	"mozbrowseraudioplaybackchange":         "MozbrowseraudioplaybackchangeEvent",
+	"mozbrowsercaretstatechanged":           "MozbrowsercaretstatechangedEvent",
+	"mozbrowserclose":                       "MozbrowsercloseEvent",
+	"mozbrowsercontextmenu":                 "MozbrowsercontextmenuEvent",
+	"mozbrowserdocumentfirstpaint":          "MozbrowserdocumentfirstpaintEvent",
+	"mozbrowsererror":                       "MozbrowsererrorEvent",
+	"mozbrowserfindchange":                  "MozbrowserfindchangeEvent",
+	"mozbrowserfirstpaint":                  "MozbrowserfirstpaintEvent",
+	"mozbrowsericonchange":                  "MozbrowsericonchangeEvent",
+	"mozbrowserloadend":                     "MozbrowserloadendEvent",
+	"mozbrowserloadstart":                   "MozbrowserloadstartEvent",
+	"mozbrowserlocationchange":              "MozbrowserlocationchangeEvent",
+	"mozbrowsermanifestchange":              "MozbrowsermanifestchangeEvent",
+	"mozbrowsermetachange":                  "MozbrowsermetachangeEvent",
+	"mozbrowseropensearch":                  "MozbrowseropensearchEvent",
+	"mozbrowseropentab":                     "MozbrowseropentabEvent",
+	"mozbrowseropenwindow":                  "MozbrowseropenwindowEvent",
+	"mozbrowserresize":                      "MozbrowserresizeEvent",
+	"mozbrowserscroll":                      "MozbrowserscrollEvent",
+	"mozbrowserscrollareachanged":           "MozbrowserscrollareachangedEvent",
+	"mozbrowserscrollviewchange":            "MozbrowserscrollviewchangeEvent",
+	"mozbrowsersecuritychange":              "MozbrowsersecuritychangeEvent",
+	"mozbrowserselectionstatechanged":       "MozbrowserselectionstatechangedEvent",
+	"mozbrowsershowmodalprompt":             "MozbrowsershowmodalpromptEvent",
+	"mozbrowsertitlechange":                 "MozbrowsertitlechangeEvent",
+	"mozbrowserusernameandpasswordrequired": "MozbrowserusernameandpasswordrequiredEvent",
+	"mozbrowservisibilitychange":            "MozbrowservisibilitychangeEvent",
+	"mozedgeuigesture":                      "MozEdgeUIGestureEvent",
+	"mozentereddomfullscreen":               "MozEnteredDomFullscreenEvent",
+	"mozgamepadbuttondown":                  "MozGamepadButtonDownEvent",
+	"mozgamepadbuttonup":                    "MozGamepadButtonUpEvent",
+	"mozmagnifygesture":                     "MozMagnifyGestureEvent",
+	"mozmagnifygesturestart":                "MozMagnifyGestureStartEvent",
+	"mozmagnifygestureupdate":               "MozMagnifyGestureUpdateEvent",
+	"mozpresstapgesture":                    "MozPressTapGestureEvent",
+	"mozrotategesture":                      "MozRotateGestureEvent",
+	"mozrotategesturestart":                 "MozRotateGestureStartEvent",
+	"mozrotategestureupdate":                "MozRotateGestureUpdateEvent",
+	"mozscrolledareachanged":                "MozScrolledAreaChangedEvent",
+	"mozswipegesture":                       "MozSwipeGestureEvent",
+	"moztapgesture":                         "MozTapGestureEvent",
+	"moztimechange":                         "MoztimechangeEvent",
+	"nomatch":                               "NomatchEvent",
+	"notificationclick":                     "NotificationclickEvent",
+	"noupdate":                              "NoUpdateEvent",
+	"obsolete":                              "ObsoleteEvent",
+	"offline":                               "OfflineEvent",
+	"onconnected":                           "OnconnectedEvent",
+	"online":                                "OnlineEvent",
+	"open":                                  "OpenEvent",
+	"orientationchange":                     "OrientationChangeEvent",
+	"overflow":                              "OverflowEvent",
+	"pagehide":                              "PageHideEvent",
+	"pageshow":                              "PageShowEvent",
+	"paste":                                 "PasteEvent",
+	"pause":                                 "PauseEvent",
+	"play":                                  "PlayEvent",
+	"playing":                               "PlayingEvent",
+	"pointercancel":                         "PointercancelEvent",
+	"pointerdown":                           "PointerdownEvent",
+	"pointerenter":                          "PointerenterEvent",
+	"pointerleave":                          "PointerleaveEvent",
+	"pointerlockchange":                     "PointerLockChangeEvent",
+	"pointerlockerror":                      "PointerLockErrorEvent",
+	"pointermove":                           "PointermoveEvent",
+	"pointerout":                            "PointeroutEvent",
+	"pointerover":                           "PointeroverEvent",
+	"pointerup":                             "PointerupEvent",
+	"popstate":                              "PopStateEvent",
+	"popuphidden":                           "PopuphiddenEvent",
+	"popuphiding":                           "PopuphidingEvent",
+	"popupshowing":                          "PopupshowingEvent",
+	"popupshown":                            "PopupshownEvent",
+	"progress":                              "ProgressEvent",
+	"push":                                  "PushEvent",
+	"pushsubscriptionchange":                "PushsubscriptionchangeEvent",
+	"radiostatechange":                      "RadioStateChangeEvent",
+	"ratechange":                            "RateChangeEvent",
+	"readystatechange":                      "ReadystateChangeEvent",
+	"received":                              "ReceivedEvent",
+	"repeatevent":                           "RepeatEventEvent",
+	"reset":                                 "ResetEvent",
+	"resize":                                "ResizeEvent",
+	"resourcetimingbufferfull":              "ResourcetimingbufferfullEvent",
+	"result":                                "ResultEvent",
+	"resume":                                "ResumeEvent",
+	"resuming":                              "ResumingEvent",
+	"scroll":                                "ScrollEvent",
+	"seeked":                                "SeekedEvent",
+	"seeking":                               "SeekingEvent",
+	"select":                                "SelectEvent",
+	"selectionchange":                       "SelectionchangeEvent",
+	"selectstart":                           "SelectstartEvent",
+	"sent":                                  "SentEvent",
+	"show":                                  "ShowEvent",
+	"sizemodechange":                        "SizemodechangeEvent",
+	"smartcardinsert":                       "SmartCardInsertEvent",
+	"smartcardremove":                       "SmartCardRemoveEvent",
+	"soundend":                              "SoundendEvent",
+	"soundstart":                            "SoundstartEvent",
+	"speechend":                             "SpeechendEvent",
+	"speechstart":                           "SpeechstartEvent",
+	"sstabclosing":                          "SSTabClosingEvent",
+	"sstabrestored":                         "SSTabRestoredEvent",
+	"sstabrestoring":                        "SSTabRestoringEvent",
+	"sswindowclosing":                       "SSWindowClosingEvent",
+	"sswindowstatebusy":                     "SSWindowStateBusyEvent",
+	"sswindowstateready":                    "SSWindowStateReadyEvent",
+	"stalled":                               "StalledEvent",
+	"start":                                 "StartEvent",
+	"statechange":                           "StatechangeEvent",
+	"statuschange":                          "StatuschangeEvent",
+	"stkcommand":                            "StkcommandEvent",
+	"stksessionend":                         "StksessionendEvent",
+	"storage":                               "StorageEvent",
+	"submit":                                "SubmitEvent",
+	"success":                               "SuccessEvent",
+	"suspend":                               "SuspendEvent",
+	"svgabort":                              "SVGAbortEvent",
+	"svgerror":                              "SVGErrorEvent",
+	"svgload":                               "SVGLoadEvent",
+	"svgresize":                             "SVGResizeEvent",
+	"svgscroll":                             "SVGScrollEvent",
+	"svgunload":                             "SVGUnloadEvent",
+	"svgzoom":                               "SVGZoomEvent",
+	"tabclose":                              "TabCloseEvent",
+	"tabhide":                               "TabHideEvent",
+	"tabopen":                               "TabOpenEvent",
+	"tabpinned":                             "TabPinnedEvent",
+	"tabselect":                             "TabSelectEvent",
+	"tabshow":                               "TabShowEvent",
+	"tabunpinned":                           "TabUnpinnedEvent",
+	"timeout":                               "TimeoutEvent",
+	"timeupdate":                            "TimeUpdateEvent",
+	"touchcancel":                           "TouchCancelEvent",
+	"touchend":                              "TouchEndEvent",
+	"touchenter":                            "TouchEnterEvent",
+	"touchleave":                            "TouchLeaveEvent",
+	"touchmove":                             "TouchMoveEvent",
+	"touchstart":                            "TouchStartEvent",
+	"transitionend":                         "TransitionEndEvent",
+	"transitionrun":                         "TransitionrunEvent",
+	"transitionstart":                       "TransitionstartEvent",
+	"underflow":                             "UnderflowEvent",
+	"unload":                                "UnloadEvent",
+	"updateready":                           "UpdateReadyEvent",
+	"upgradeneeded":                         "UpgradeNeededEvent",
+	"userproximity":                         "UserProximityEvent",
+	"ussdreceived":                          "UssdreceivedEvent",
+	"valuechange":                           "ValueChangeEvent",
+	"versionchange":                         "VersionChangeEvent",
+	"visibilitychange":                      "VisibilityChangeEvent",
+	"voicechange":                           "VoicechangeEvent",
+	"voiceschanged":                         "VoiceschangedEvent",
+	"volumechange":                          "VolumeChangeEvent",
+	"vrdisplayconnected":                    "VrdisplayconnectedEvent",
+	"vrdisplaydisconnected":                 "VrdisplaydisconnectedEvent",
+	"vrdisplaypresentchange":                "VrdisplaypresentchangeEvent",
+	"waiting":                               "WaitingEvent",
+	"wheel":                                 "WheelEvent",
+}
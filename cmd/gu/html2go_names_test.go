@@ -0,0 +1,112 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/tests"
+)
+
+// generatedNames returns the functions of the generated file mapped to the
+// names given by the first string argument of the call to the provided
+// function within their bodies, such as the tag of trees.NewMarkup. Only the
+// functions taking the parameter types of the generator template are read,
+// which leaves out helpers such as Guscript and Parse.
+func generatedNames(t *testing.T, file string, call string, params string) map[string]string {
+	parsed, err := parser.ParseFile(token.NewFileSet(), filepath.Join("..", "..", "trees", file), nil, 0)
+	if err != nil {
+		tests.Failed(t, "Should have parsed %s: %+q", file, err)
+	}
+
+	names := make(map[string]string)
+
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		var kinds []string
+		for _, field := range fn.Type.Params.List {
+			for range field.Names {
+				kinds = append(kinds, types.ExprString(field.Type))
+			}
+		}
+
+		if strings.Join(kinds, ", ") != params {
+			continue
+		}
+
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			expr, ok := node.(*ast.CallExpr)
+			if !ok || len(expr.Args) == 0 {
+				return true
+			}
+
+			selector, ok := expr.Fun.(*ast.SelectorExpr)
+			if !ok || selector.Sel.Name != call {
+				return true
+			}
+
+			lit, ok := expr.Args[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+
+			if name, err := strconv.Unquote(lit.Value); err == nil {
+				names[fn.Name.Name] = name
+			}
+
+			return false
+		})
+	}
+
+	return names
+}
+
+// compareNames fails the test when the table differs from the generated names.
+func compareNames(t *testing.T, table string, got map[string]string, expected map[string]string) {
+	for name, fn := range expected {
+		if got[name] != fn {
+			tests.Failed(t, "Should have %q in %s mapped to %q: %q", name, table, fn, got[name])
+		}
+	}
+
+	for name := range got {
+		if _, ok := expected[name]; !ok {
+			tests.Failed(t, "Should have no %q in %s without a generated function", name, table)
+		}
+	}
+}
+
+func TestNameTables(t *testing.T) {
+	htmlNames := make(map[string]string)
+	svgNames := make(map[string]string)
+
+	for fn, tag := range generatedNames(t, filepath.Join("elems", "elems.gen.go"), "NewMarkup", "...trees.Appliable") {
+		if strings.HasPrefix(fn, "Svg") {
+			svgNames[tag] = fn
+			continue
+		}
+
+		htmlNames[tag] = fn
+	}
+
+	compareNames(t, "htmlElems", htmlElems, htmlNames)
+	compareNames(t, "svgElems", svgElems, svgNames)
+	tests.Passed(t, "Should have the elements of the elems package")
+
+	eventNames := make(map[string]string)
+	for fn, event := range generatedNames(t, filepath.Join("events", "event.gen.go"), "NewEvent", "interface{}, string, ...bool") {
+		eventNames[strings.ToLower(event)] = fn
+	}
+
+	compareNames(t, "eventFuncs", eventFuncs, eventNames)
+	tests.Passed(t, "Should have the events of the events package")
+}
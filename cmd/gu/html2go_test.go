@@ -0,0 +1,105 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/tests"
+)
+
+func TestHTML2Go(t *testing.T) {
+	source, err := newHTML2Go("components", componentName("user-card.html"), "user-card.html").Convert(`
+    <div class="card  shadow" id="card" style="color: red; border: none">
+      <!-- avatar -->
+      <h1>Hello {{.Name}}, 100%</h1>
+      <img src="{{.Avatar}}" alt="avatar">
+      <button type="button" onclick="save()">Save</button>
+      <input disabled data-user-id="12" aria-label="Name">
+      <svg viewBox="0 0 10 10"><circle r="4"></circle></svg>
+      <user-badge></user-badge>
+      {{if .Admin}}<b>Admin</b>{{end}}
+    </div>
+  `)
+	if err != nil {
+		tests.Failed(t, "Should have converted markup: %+q\n%s", err, source)
+	}
+	tests.Passed(t, "Should have converted markup")
+
+	for _, part := range []string{
+		"package components",
+		"type UserCard struct {\n\tgu.Reactive\n\tName   string\n\tAvatar string\n}",
+		"func NewUserCard() *UserCard {",
+		"func (u *UserCard) Render() *trees.Markup {\n\treturn elems.Div(",
+		`property.ClassAttr("card", "shadow"),`,
+		`property.IDAttr("card"),`,
+		`property.ColorStyle("red"),`,
+		`property.BorderStyle("none"),`,
+		`trees.NewComment(" avatar "),`,
+		`elems.Element("h1",`,
+		`elems.Text("Hello %s, 100%%", u.Name),`,
+		`property.SrcAttr(u.Avatar),`,
+		`property.AltAttr("avatar"),`,
+		`events.ClickEvent(u.handleClick, ""),`,
+		`property.DisabledAttr(true),`,
+		`property.DataAttr("user-id", "12"),`,
+		`property.AriaLabelAttr("Name"),`,
+		`elems.Svg(`,
+		`trees.NewAttr("viewBox", "0 0 10 10"),`,
+		`elems.SvgCircle(`,
+		`elems.CustomElement("user-badge"),`,
+		`// TODO: convert template action "{{if .Admin}}".`,
+		"func (u *UserCard) handleClick(event trees.EventObject, root *trees.Markup) {\n\t// TODO: port \"save()\".\n}",
+	} {
+		if !strings.Contains(string(source), part) {
+			tests.Failed(t, "Should have generated %q:\n%s", part, source)
+		}
+	}
+	tests.Passed(t, "Should have generated component source")
+}
+
+func TestHTML2GoParseErrors(t *testing.T) {
+	source, err := newHTML2Go("components", "Broken", "broken.html").Convert("<div><span>Hello</div>")
+	if err == nil || !strings.Contains(err.Error(), "line 1, column 6: unclosed element <span>") {
+		tests.Failed(t, "Should have returned markup errors: %+q", err)
+	}
+	tests.Passed(t, "Should have returned markup errors")
+
+	if !strings.Contains(string(source), "elems.Span(") {
+		tests.Failed(t, "Should have generated source for broken markup:\n%s", source)
+	}
+	tests.Passed(t, "Should have generated source for broken markup")
+}
+
+func TestHTML2GoCompiles(t *testing.T) {
+	source, err := newHTML2Go("components", componentName("user-card.html"), "user-card.html").Convert(`
+    <div class="card" style="color: red" data-id="{{.ID}}">
+      <h1>Hello {{.Name}}</h1>
+      <input type="checkbox" checked onchange="toggle()">
+      <svg viewBox="0 0 10 10"><circle r="4" fill="{{.Color}}"></circle></svg>
+      <user-badge title="badge"></user-badge>
+    </div>
+  `)
+	if err != nil {
+		tests.Failed(t, "Should have converted markup: %+q\n%s", err, source)
+	}
+	tests.Passed(t, "Should have converted markup")
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "user_card.go", source, 0)
+	if err != nil {
+		tests.Failed(t, "Should have parsed generated source: %+q\n%s", err, source)
+	}
+	tests.Passed(t, "Should have parsed generated source")
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check("components", fset, []*ast.File{file}, nil); err != nil {
+		tests.Failed(t, "Should have compiled generated source: %+q\n%s", err, source)
+	}
+	tests.Passed(t, "Should have compiled generated source")
+}
@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 
 	"github.com/gu-io/gu/shell"
 	"github.com/gu-io/gu/shell/parse"
+	"github.com/gu-io/gu/trees"
 
 	"gopkg.in/urfave/cli.v2"
 )
@@ -53,6 +56,35 @@ func init(){
 %+s
 }
 
+`
+
+	routesTemplate = `// This file is auto-generated by the gu cli to print the route table of a app
+// and is removed once run.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gu-io/gu/router"
+
+	app %q
+)
+
+func main() {
+	output, err := os.Create(os.Args[1])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	defer output.Close()
+
+	if err := router.WriteRoutes(output, %q, app.%s().Routes()...); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
 `
 )
 
@@ -185,6 +217,160 @@ func initCommands() {
 		},
 	})
 
+	commands = append(commands, &cli.Command{
+		Name:        "routes",
+		Usage:       "gu routes <PackagePath>",
+		Description: "Routes prints the route table of the app returned by a function of the provided package (App by default), which must have the signature func() *gu.NApp. The table can be printed as an indented text tree, as JSON or as a graphviz DOT graph",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "func",
+				Usage: "func=name-of-function-returning-app",
+			},
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "format=text|json|dot",
+			},
+			&cli.StringFlag{
+				Name:    "output",
+				Aliases: []string{"out"},
+				Usage:   "out=path-to-store-route-table",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			pkg := ctx.Args().First()
+			if pkg == "" {
+				return errors.New("Package path of app is required")
+			}
+
+			funcName := ctx.String("func")
+			if funcName == "" {
+				funcName = "App"
+			}
+
+			format := ctx.String("format")
+			if format == "" {
+				format = "text"
+			}
+
+			cdir, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+
+			// The program is generated within the current directory to have it
+			// resolve imports the same way the app package does.
+			tmpDir, err := ioutil.TempDir(cdir, "_gu-routes")
+			if err != nil {
+				return err
+			}
+
+			defer os.RemoveAll(tmpDir)
+
+			mainFile := filepath.Join(tmpDir, "main.go")
+			tableFile := filepath.Join(tmpDir, "routes.out")
+
+			program := fmt.Sprintf(routesTemplate, pkg, format, funcName)
+			if err := ioutil.WriteFile(mainFile, []byte(program), 0644); err != nil {
+				return err
+			}
+
+			run := exec.Command("go", "run", mainFile, tableFile)
+			run.Stderr = os.Stderr
+
+			if err := run.Run(); err != nil {
+				return err
+			}
+
+			table, err := ioutil.ReadFile(tableFile)
+			if err != nil {
+				return err
+			}
+
+			if output := ctx.String("output"); output != "" {
+				return ioutil.WriteFile(output, table, 0644)
+			}
+
+			_, err = os.Stdout.Write(table)
+			return err
+		},
+	})
+
+	commands = append(commands, &cli.Command{
+		Name:        "html2go",
+		Usage:       "gu html2go <HTMLFile>...",
+		Description: "HTML2Go converts html or template files into Go source of a component whose Render method builds the markup with the elems, property and events packages, where on* attributes become event handler stubs and template fields like {{.Name}} become fields of the component",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "output-dir",
+				Aliases: []string{"outdir"},
+				Usage:   "out-dir=path-to-store-go-files",
+			},
+			&cli.StringFlag{
+				Name:    "packageName",
+				Aliases: []string{"pkg"},
+			},
+			&cli.StringFlag{
+				Name:  "component",
+				Usage: "component=name-of-component-type",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			files := ctx.Args().Slice()
+			if len(files) == 0 {
+				return errors.New("HTML file to convert is required")
+			}
+
+			packageName := ctx.String("packageName")
+			if packageName == "" {
+				packageName = "components"
+			}
+
+			outdir := ctx.String("output-dir")
+
+			for _, file := range files {
+				markup, err := ioutil.ReadFile(file)
+				if err != nil {
+					return err
+				}
+
+				component := ctx.String("component")
+				if component == "" || len(files) > 1 {
+					component = componentName(file)
+				}
+
+				source, err := newHTML2Go(packageName, component, filepath.Base(file)).Convert(string(markup))
+				if err != nil {
+					if _, ok := err.(trees.ParseErrors); !ok {
+						return err
+					}
+
+					fmt.Fprintf(os.Stderr, "%s:\n%s\n", file, err)
+				}
+
+				if outdir == "" {
+					if _, err := os.Stdout.Write(source); err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				if err := os.MkdirAll(outdir, 0755); err != nil {
+					return err
+				}
+
+				base := filepath.Base(file)
+				goFile := filepath.Join(outdir, strings.TrimSuffix(base, filepath.Ext(base))+".go")
+
+				if err := ioutil.WriteFile(goFile, source, 0644); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	})
+
 	commands = append(commands, &cli.Command{
 		Name:        "generate",
 		Usage:       "gu generate",
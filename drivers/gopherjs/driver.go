@@ -79,8 +79,17 @@ func (driver *JSDriver) Navigate(route router.PushDirectiveEvent) {
 // OnRoute registers the NApp instance for route changes and re-rendering.
 func (driver *JSDriver) OnRoute(app *gu.NApp) {
 	ListenForHistory(func(ev router.PushEvent) {
-		app.ActivateRoute(ev)
-		go driver.Render(app)
+		// Navigation hooks may block, so activate outside the browser callback.
+		go func() {
+			if err := app.ActivateRoute(ev); err != nil {
+				// Restore the location of the route which was not left.
+				current := app.Location()
+				SetLocation(app.Link(current.Path), current.Hash)
+				return
+			}
+
+			driver.Render(app)
+		}()
 	})
 }
 
@@ -0,0 +1,100 @@
+package ssr_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/ssr"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/tests"
+	"github.com/gu-io/gu/trees/elems"
+)
+
+func TestBasePath(t *testing.T) {
+	app := newApp(gu.AppAttr{BasePath: "/admin/"})
+	handler := ssr.NewHandler(app)
+
+	res := serve(handler, "/admin/home")
+	if res.Code != http.StatusOK || !strings.Contains(res.Body.String(), "Welcome Home") {
+		tests.Failed(t, "Should have rendered home view under base path: %d %q", res.Code, res.Body.String())
+	}
+	tests.Passed(t, "Should have rendered home view under base path")
+
+	if loc := app.Location(); loc.Path != "/home" || loc.Rem != "/home" {
+		tests.Failed(t, "Should have stripped base path from location: %+v", loc)
+	}
+	tests.Passed(t, "Should have stripped base path from location")
+
+	if res = serve(handler, "/home"); res.Code != http.StatusNotFound {
+		tests.Failed(t, "Should have responded with status 404 outside base path: %d", res.Code)
+	}
+	tests.Passed(t, "Should have responded with status 404 outside base path")
+
+	if res = serve(handler, "/administrator/home"); res.Code != http.StatusNotFound {
+		tests.Failed(t, "Should have responded with status 404 for path sharing base prefix: %d", res.Code)
+	}
+	tests.Passed(t, "Should have responded with status 404 for path sharing base prefix")
+
+	if link := app.Link("/home"); link != "/admin/home" {
+		tests.Failed(t, "Should have added base path to link: %q", link)
+	}
+	tests.Passed(t, "Should have added base path to link")
+}
+
+func TestBaseJoinAndStrip(t *testing.T) {
+	joins := map[string]string{
+		"/users":    "/admin/users",
+		"users":     "/admin/users",
+		"/":         "/admin/",
+		"#/users":   "#/users",
+		"/a?page=1": "/admin/a?page=1",
+	}
+
+	for path, expected := range joins {
+		if joined := router.JoinBase("admin/", path); joined != expected {
+			tests.Failed(t, "Should have joined %q into %q: %q", path, expected, joined)
+		}
+	}
+	tests.Passed(t, "Should have joined paths with base")
+
+	pe, ok := router.StripBase("/admin", router.UseLocation("/admin?page=1"))
+	if !ok || pe.Path != "/" || pe.Rem != "/?page=1" {
+		tests.Failed(t, "Should have stripped base from path with query: %+v", pe)
+	}
+	tests.Passed(t, "Should have stripped base from path with query")
+
+	pe, ok = router.StripBase("/admin", router.UseLocationHash("/admin/index.html#/users"))
+	if !ok || pe.Path != "/index.html" || pe.Rem != "/users" {
+		tests.Failed(t, "Should have kept hash route when stripping base: %+v", pe)
+	}
+	tests.Passed(t, "Should have kept hash route when stripping base")
+}
+
+func TestExport(t *testing.T) {
+	dir := t.TempDir()
+
+	app := newApp(gu.AppAttr{BasePath: "/admin"})
+	app.View(gu.ViewAttr{Name: "about", Route: "/about/team"}).Component(gu.ComponentAttr{
+		Base: elems.Div(elems.Text("Our Team")),
+	})
+
+	if err := ssr.Export(app, dir, "/home", "/about/team"); err != nil {
+		tests.Failed(t, "Should have exported routes: %+q", err)
+	}
+	tests.Passed(t, "Should have exported routes")
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "about", "team", "index.html"))
+	if err != nil || !strings.HasPrefix(string(content), "<!doctype html>") || !strings.Contains(string(content), "Our Team") {
+		tests.Failed(t, "Should have written about/team/index.html: %+q %q", err, content)
+	}
+	tests.Passed(t, "Should have written about/team/index.html")
+
+	if err := ssr.Export(app, dir, "/missing"); err == nil {
+		tests.Failed(t, "Should have failed to export missing route")
+	}
+	tests.Passed(t, "Should have failed to export missing route")
+}
@@ -0,0 +1,47 @@
+package ssr_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/tests"
+)
+
+func TestRenderCommandBinary(t *testing.T) {
+	app := newApp(gu.AppAttr{Title: "Binary"})
+	app.ActivateRoute(router.PushEvent{Path: "/home", Rem: "/home"})
+
+	command := gu.AppRenderCommand(app, nil)
+
+	data, err := command.MarshalBinary()
+	if err != nil {
+		tests.Failed(t, "Should have encoded render command as binary: %q", err)
+	}
+	tests.Passed(t, "Should have encoded render command as binary")
+
+	var decoded gu.RenderCommand
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		tests.Failed(t, "Should have decoded render command from binary: %q", err)
+	}
+	tests.Passed(t, "Should have decoded render command from binary")
+
+	expected, _ := json.Marshal(command)
+	actual, _ := json.Marshal(decoded)
+	if !bytes.Equal(expected, actual) {
+		tests.Failed(t, "Should have matched JSON encoding: %s != %s", expected, actual)
+	}
+	tests.Passed(t, "Should have matched JSON encoding")
+
+	if len(data) >= len(expected) {
+		tests.Failed(t, "Should have encoded smaller than JSON: %d >= %d", len(data), len(expected))
+	}
+	tests.Passed(t, "Should have encoded smaller than JSON: %d < %d", len(data), len(expected))
+
+	if err := decoded.UnmarshalBinary(data[:len(data)/2]); err == nil {
+		tests.Failed(t, "Should have failed decoding truncated render command")
+	}
+	tests.Passed(t, "Should have failed decoding truncated render command")
+}
@@ -0,0 +1,77 @@
+// Package ssr provides a Gu driver which renders apps on the server and a
+// http.Handler which serves the rendered markup of an app for every request.
+package ssr
+
+import (
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/shell"
+)
+
+// Driver provides a concrete implementation of the gu.Driver interface for
+// rendering apps on the server.
+type Driver struct {
+	// Fetch and Cache are the services returned to the apps using the driver,
+	// they are nil unless set.
+	Fetch shell.Fetch
+	Cache shell.Cache
+
+	readyHandlers []func()
+	location      router.PushEvent
+}
+
+// NewDriver returns a new instance of a server driver.
+func NewDriver() *Driver {
+	return &Driver{
+		location: router.UseLocation("/"),
+	}
+}
+
+// Name returns the name of the driver.
+func (driver *Driver) Name() string {
+	return "Server Side Renderer"
+}
+
+// Ready is called to intialize the driver and run the ready handlers.
+func (driver *Driver) Ready() {
+	for _, ready := range driver.readyHandlers {
+		ready()
+	}
+}
+
+// OnReady registers the giving handle function to be called when the giving
+// driver is ready and loaded.
+func (driver *Driver) OnReady(handle func()) {
+	driver.readyHandlers = append(driver.readyHandlers, handle)
+}
+
+// Location returns the location last rendered by the driver.
+func (driver *Driver) Location() router.PushEvent {
+	return driver.location
+}
+
+// Navigate sets the provided route as the driver's location.
+func (driver *Driver) Navigate(route router.PushDirectiveEvent) {
+	driver.location = router.UseLocation(route.To)
+}
+
+// ServerRendering returns true as the driver renders apps on the server, where
+// AfterRender hooks are not called.
+func (driver *Driver) ServerRendering() bool {
+	return true
+}
+
+// OnRoute registers the NApp instance for route changes and re-rendering.
+// Routes are received from requests, hence this does nothing.
+func (driver *Driver) OnRoute(app *gu.NApp) {}
+
+// Render does nothing as rendering is done for each request by the Handler.
+func (driver *Driver) Render(app *gu.NApp) {}
+
+// Update does nothing as rendering is done for each request by the Handler.
+func (driver *Driver) Update(app *gu.NApp, view *gu.NView) {}
+
+// Services returns the Fetch and Cache set on the driver.
+func (driver *Driver) Services(cacheName string, intercept bool) (shell.Fetch, shell.Cache) {
+	return driver.Fetch, driver.Cache
+}
@@ -0,0 +1,90 @@
+package ssr
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/router"
+)
+
+// Export renders the app for each of the provided route paths, writing the
+// markup into a index.html file within the directory matching the route path
+// under dir. Route paths are relative to the app's base path, which is added
+// when rendering, hence the directory is to be served from the base path.
+// Export stops at the first route which fails to render with a status 200.
+func Export(app *gu.NApp, dir string, paths ...string) error {
+	return ExportWith(app, dir, StyleOptions{}, paths...)
+}
+
+// ExportWith exports the routes has Export does, writing the stylesheets of
+// components has set by the StyleOptions. Extracted stylesheets written into
+// files are stored in dir.
+func ExportWith(app *gu.NApp, dir string, styles StyleOptions, paths ...string) error {
+	for _, path := range paths {
+		tree := app.Render(router.UseLocation(app.Link(path)))
+
+		if status := app.Status(); status != http.StatusOK {
+			return fmt.Errorf("Route %q rendered with status %d", path, status)
+		}
+
+		if styles.Extract {
+			doc, content, err := extractStyles(tree, styles)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case content == "":
+			case styles.File:
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					return err
+				}
+
+				name := styleFile(content)
+				if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+					return err
+				}
+
+				addToHead(doc, linkedStyle(app.Link("/"+name)))
+			default:
+				addToHead(doc, inlineStyle(content))
+			}
+
+			tree = doc
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(strings.Trim(path, "/")), "index.html")
+
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+
+		file, err := os.Create(target)
+		if err != nil {
+			return err
+		}
+
+		writer := bufio.NewWriter(file)
+		if err := writeDocument(writer, tree, ""); err != nil {
+			file.Close()
+			return err
+		}
+
+		if err := writer.Flush(); err != nil {
+			file.Close()
+			return err
+		}
+
+		if err := file.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package ssr
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+)
+
+// Handler defines a http.Handler which renders a app for every request using
+// the request url as the route, responding with the status code of the app
+// for that route. Apps with a base path expect the full request url, hence
+// the Handler must be mounted without stripping the base path.
+type Handler struct {
+	// CSP sets the Content-Security-Policy mode of responses, where NoCSP
+	// sends no policy.
+	CSP CSPMode
+
+	// CSPDirectives contains the directives sent before the script-src and
+	// style-src directives of the policy, DefaultCSPDirectives when empty.
+	CSPDirectives string
+
+	// Styles sets how the stylesheets of components are written.
+	Styles StyleOptions
+
+	ml  sync.Mutex
+	app *gu.NApp
+}
+
+// CSPMode defines how the Handler allows the inline scripts and styles of
+// rendered documents within it's Content-Security-Policy.
+type CSPMode int
+
+// contains the CSPMode values.
+const (
+	// NoCSP sends no Content-Security-Policy header.
+	NoCSP CSPMode = iota
+
+	// NonceCSP generates a nonce for every request which is set on all
+	// inline scripts and styles and allowed by the policy.
+	NonceCSP
+
+	// HashCSP allows the sha256 hashes of the content of the inline scripts
+	// and styles within the policy, leaving the markup as is.
+	HashCSP
+)
+
+// DefaultCSPDirectives defines the directives sent by a Handler when
+// CSPDirectives is empty.
+const DefaultCSPDirectives = "default-src 'self'; object-src 'none'; base-uri 'self'"
+
+// NewHandler returns a new instance of a Handler for the provided app.
+func NewHandler(app *gu.NApp) *Handler {
+	return &Handler{app: app}
+}
+
+// ServeHTTP renders the app for the request's url.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// NApp keeps the state of the last activated route, so requests are
+	// rendered one at a time.
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	tree := h.app.Render(router.UseLocation(r.URL.String()))
+
+	if h.Styles.Extract {
+		doc, content, err := extractStyles(tree, h.Styles)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if content != "" {
+			addToHead(doc, inlineStyle(content))
+		}
+
+		tree = doc
+	}
+
+	var nonce string
+	var scripts, styles []string
+
+	switch h.CSP {
+	case NonceCSP:
+		var err error
+		if nonce, err = trees.NewNonce(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		scripts = []string{"'nonce-" + nonce + "'"}
+		styles = scripts
+	case HashCSP:
+		scripts, styles = trees.InlineHashes(tree)
+	}
+
+	if h.CSP != NoCSP {
+		w.Header().Set("Content-Security-Policy", h.policy(scripts, styles))
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(h.app.Status())
+
+	writeDocument(w, tree, nonce)
+}
+
+// policy returns the Content-Security-Policy allowing the provided sources
+// for scripts and styles. Style attributes are allowed, has they are unable
+// to run scripts once filtered by the printers.
+func (h *Handler) policy(scripts []string, styles []string) string {
+	directives := h.CSPDirectives
+	if directives == "" {
+		directives = DefaultCSPDirectives
+	}
+
+	return strings.Join([]string{
+		strings.TrimSuffix(strings.TrimSpace(directives), ";"),
+		strings.Join(append([]string{"script-src 'self'"}, scripts...), " "),
+		strings.Join(append([]string{"style-src 'self'"}, styles...), " "),
+		"style-src-attr 'unsafe-inline'",
+	}, "; ")
+}
+
+// writeDocument writes the provided tree as a html document. The tree is
+// streamed into the writer, which when flushable is flushed after each
+// view, allowing responses to start before the whole document is written.
+// Inline scripts and styles are given the nonce when not empty.
+func writeDocument(w io.Writer, tree *trees.Markup, nonce string) error {
+	if _, err := io.WriteString(w, "<!doctype html>"); err != nil {
+		return err
+	}
+
+	_, err := trees.SimpleElementWriter.WithNonce(nonce).WriteTo(w, tree)
+	return err
+}
@@ -0,0 +1,230 @@
+package ssr_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/ssr"
+	"github.com/gu-io/gu/tests"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+)
+
+type broken struct{}
+
+func (broken) Render() *trees.Markup {
+	panic("broken component")
+}
+
+func newApp(attr gu.AppAttr) *gu.NApp {
+	attr.Name = "ssr"
+	attr.Driver = ssr.NewDriver()
+
+	app := gu.App(attr)
+
+	home := app.View(gu.ViewAttr{Name: "home", Route: "/home"})
+	home.Component(gu.ComponentAttr{
+		Base: elems.Div(elems.Text("Welcome Home")),
+	})
+
+	fail := app.View(gu.ViewAttr{Name: "fail", Route: "/fail"})
+	fail.Component(gu.ComponentAttr{Base: broken{}})
+
+	return app
+}
+
+func serve(handler http.Handler, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", path, nil))
+	return recorder
+}
+
+func TestHandler(t *testing.T) {
+	handler := ssr.NewHandler(newApp(gu.AppAttr{}))
+
+	res := serve(handler, "/home")
+	if res.Code != http.StatusOK {
+		tests.Failed(t, "Should have responded with status 200: %d", res.Code)
+	}
+	tests.Passed(t, "Should have responded with status 200")
+
+	if !strings.Contains(res.Body.String(), "Welcome Home") {
+		tests.Failed(t, "Should have rendered the home view: %q", res.Body.String())
+	}
+	tests.Passed(t, "Should have rendered the home view")
+
+	res = serve(handler, "/missing")
+	if res.Code != http.StatusNotFound {
+		tests.Failed(t, "Should have responded with status 404: %d", res.Code)
+	}
+	tests.Passed(t, "Should have responded with status 404")
+
+	if !strings.Contains(res.Body.String(), "Page Not Found") {
+		tests.Failed(t, "Should have rendered the default NotFound view: %q", res.Body.String())
+	}
+	tests.Passed(t, "Should have rendered the default NotFound view")
+
+	res = serve(handler, "/fail")
+	if res.Code != http.StatusInternalServerError {
+		tests.Failed(t, "Should have responded with status 500: %d", res.Code)
+	}
+	tests.Passed(t, "Should have responded with status 500")
+
+	if !strings.Contains(res.Body.String(), "broken component") {
+		tests.Failed(t, "Should have rendered the default Failure view: %q", res.Body.String())
+	}
+	tests.Passed(t, "Should have rendered the default Failure view")
+
+	if res = serve(handler, "/home"); res.Code != http.StatusOK {
+		tests.Failed(t, "Should have recovered with status 200: %d", res.Code)
+	}
+	tests.Passed(t, "Should have recovered with status 200")
+}
+
+func TestHandlerRerender(t *testing.T) {
+	handler := ssr.NewHandler(newApp(gu.AppAttr{}))
+
+	for i := 0; i < 3; i++ {
+		if res := serve(handler, "/home"); !strings.Contains(res.Body.String(), "Welcome Home") {
+			tests.Failed(t, "Should have kept the markup of static components on render %d: %q", i+1, res.Body.String())
+		}
+	}
+	tests.Passed(t, "Should have kept the markup of static components between renders")
+}
+
+func TestHandlerHooks(t *testing.T) {
+	var entered int
+
+	app := newApp(gu.AppAttr{})
+
+	editor := app.View(gu.ViewAttr{Name: "editor", Route: "/editor"})
+	editor.BeforeLeave(func(tr gu.Transition) error { return gu.ErrNavigationCancelled })
+	editor.AfterEnter(func(tr gu.Transition) { entered++ })
+	editor.Component(gu.ComponentAttr{Base: elems.Div(elems.Text("Editing"))})
+
+	slow := app.View(gu.ViewAttr{Name: "slow", Route: "/slow"})
+	slow.BeforeLeave(func(tr gu.Transition, done func(error)) {})
+
+	handler := ssr.NewHandler(app)
+
+	serve(handler, "/editor")
+
+	res := serve(handler, "/home")
+	if res.Code != http.StatusOK || !strings.Contains(res.Body.String(), "Welcome Home") {
+		tests.Failed(t, "Should have rendered the home view after the editor: %d %q", res.Code, res.Body.String())
+	}
+	tests.Passed(t, "Should have rendered the home view after the editor")
+
+	serve(handler, "/editor")
+
+	if res = serve(handler, "/missing"); res.Code != http.StatusNotFound {
+		tests.Failed(t, "Should have responded with status 404 after the editor: %d", res.Code)
+	}
+	tests.Passed(t, "Should have responded with status 404 after the editor")
+
+	if entered != 2 {
+		tests.Failed(t, "Should have called AfterEnter hooks for each request: %d", entered)
+	}
+	tests.Passed(t, "Should have called AfterEnter hooks for each request")
+
+	serve(handler, "/slow")
+
+	start := time.Now()
+	if res = serve(handler, "/home"); res.Code != http.StatusOK || time.Since(start) >= gu.DefaultHookTimeout {
+		tests.Failed(t, "Should have responded without waiting on the slow view: %d", res.Code)
+	}
+	tests.Passed(t, "Should have responded without waiting on the slow view")
+}
+
+func TestCustomFallbacks(t *testing.T) {
+	handler := ssr.NewHandler(newApp(gu.AppAttr{
+		NotFound: elems.Div(elems.Text("Lost In Space")),
+		Failure: func(err error) *trees.Markup {
+			return elems.Div(elems.Text("Crashed: %s", err.Error()))
+		},
+	}))
+
+	res := serve(handler, "/missing")
+	if res.Code != http.StatusNotFound || !strings.Contains(res.Body.String(), "Lost In Space") {
+		tests.Failed(t, "Should have rendered the custom NotFound view: %d %q", res.Code, res.Body.String())
+	}
+	tests.Passed(t, "Should have rendered the custom NotFound view")
+
+	res = serve(handler, "/fail")
+	if res.Code != http.StatusInternalServerError || !strings.Contains(res.Body.String(), "Crashed:") {
+		tests.Failed(t, "Should have rendered the custom Failure view: %d %q", res.Code, res.Body.String())
+	}
+	tests.Passed(t, "Should have rendered the custom Failure view")
+}
+
+func TestHandlerStreams(t *testing.T) {
+	handler := ssr.NewHandler(newApp(gu.AppAttr{}))
+
+	res := serve(handler, "/home")
+	if !res.Flushed {
+		tests.Failed(t, "Should have flushed response while rendering views")
+	}
+	tests.Passed(t, "Should have flushed response while rendering views")
+
+	if !strings.HasPrefix(res.Body.String(), "<!doctype html><html") || !strings.HasSuffix(res.Body.String(), "</html>") {
+		tests.Failed(t, "Should have written complete document: %q", res.Body.String())
+	}
+	tests.Passed(t, "Should have written complete document")
+}
+
+func TestHandlerCSP(t *testing.T) {
+	app := newApp(gu.AppAttr{})
+
+	style := trees.NewMarkup("style", false)
+	trees.NewText("div { color: red; }").Apply(style)
+
+	styled := app.View(gu.ViewAttr{Name: "styled", Route: "/styled"})
+	styled.Component(gu.ComponentAttr{Base: elems.Div(style)})
+
+	handler := ssr.NewHandler(app)
+
+	if res := serve(handler, "/styled"); res.Header().Get("Content-Security-Policy") != "" {
+		tests.Failed(t, "Should have sent no policy by default: %q", res.Header().Get("Content-Security-Policy"))
+	}
+	tests.Passed(t, "Should have sent no policy by default")
+
+	handler.CSP = ssr.NonceCSP
+
+	first := serve(handler, "/styled")
+	second := serve(handler, "/styled")
+
+	policy := first.Header().Get("Content-Security-Policy")
+	start := strings.Index(policy, "'nonce-")
+	if start < 0 || !strings.HasPrefix(policy, ssr.DefaultCSPDirectives) {
+		tests.Failed(t, "Should have sent a nonce policy: %q", policy)
+	}
+	tests.Passed(t, "Should have sent a nonce policy")
+
+	nonce := policy[start+len("'nonce-"):]
+	nonce = nonce[:strings.Index(nonce, "'")]
+
+	if !strings.Contains(first.Body.String(), ` nonce="`+nonce+`">div { color: red; }</style>`) {
+		tests.Failed(t, "Should have set the policy nonce on inline styles: %q", first.Body.String())
+	}
+	tests.Passed(t, "Should have set the policy nonce on inline styles")
+
+	if second.Header().Get("Content-Security-Policy") == policy {
+		tests.Failed(t, "Should have generated a nonce per request")
+	}
+	tests.Passed(t, "Should have generated a nonce per request")
+
+	handler.CSP = ssr.HashCSP
+	handler.CSPDirectives = "default-src 'none';"
+
+	res := serve(handler, "/styled")
+	policy = res.Header().Get("Content-Security-Policy")
+
+	if !strings.HasPrefix(policy, "default-src 'none'; script-src 'self'; style-src 'self' 'sha256-") || strings.Contains(res.Body.String(), "nonce") {
+		tests.Failed(t, "Should have sent a hash policy: %q", policy)
+	}
+	tests.Passed(t, "Should have sent a hash policy")
+}
@@ -0,0 +1,174 @@
+package ssr_test
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/ssr"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/tests"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+)
+
+// browserDriver defines a server driver acting as a browser driver, which
+// calls AfterRender and BeforeLeave hooks.
+type browserDriver struct {
+	*ssr.Driver
+}
+
+// ServerRendering returns false as the driver acts as a browser driver.
+func (browserDriver) ServerRendering() bool {
+	return false
+}
+
+func TestLifecycleOrder(t *testing.T) {
+	var events []string
+
+	app := gu.App(gu.AppAttr{Name: "lifecycle", Driver: browserDriver{ssr.NewDriver()}})
+
+	home := app.View(gu.ViewAttr{Name: "home", Route: "/home"})
+	home.BeforeLeave(func() { events = append(events, "home.leave") })
+	home.AfterEnter(func() { events = append(events, "home.enter") })
+	home.AfterRender(func() { events = append(events, "home.render") })
+	home.Component(gu.ComponentAttr{
+		Base: func(svc gu.Services) *trees.Markup {
+			svc.Lifecycle.BeforeLeave(func() { events = append(events, "greeting.leave") })
+			svc.Lifecycle.AfterEnter(func(tr gu.Transition) {
+				events = append(events, "greeting.enter:"+tr.To.Rem)
+			})
+			return elems.Div(elems.Text("Welcome Home"))
+		},
+	})
+
+	about := app.View(gu.ViewAttr{Name: "about", Route: "/about"})
+	about.AfterEnter(func(tr gu.Transition, done func(error)) {
+		go func() {
+			events = append(events, "about.enter")
+			done(nil)
+		}()
+	})
+
+	app.Render(router.UseLocation("/home"))
+	app.Render(router.UseLocation("/about"))
+
+	expected := []string{
+		"home.enter",
+		"greeting.enter:/home",
+		"home.render",
+		"greeting.leave",
+		"home.leave",
+		"about.enter",
+	}
+
+	if !reflect.DeepEqual(events, expected) {
+		tests.Failed(t, "Should have called hooks in order %+q: %+q", expected, events)
+	}
+	tests.Passed(t, "Should have called hooks in order %+q", expected)
+}
+
+func TestLifecycleServerRender(t *testing.T) {
+	var rendered bool
+
+	app := gu.App(gu.AppAttr{Name: "lifecycle", Driver: ssr.NewDriver()})
+
+	home := app.View(gu.ViewAttr{Name: "home", Route: "/home"})
+	home.AfterRender(func(tr gu.Transition, done func(error)) { rendered = true })
+	home.Component(gu.ComponentAttr{Base: elems.Div(elems.Text("Welcome Home"))})
+
+	start := time.Now()
+	app.Render(router.UseLocation("/home"))
+
+	if rendered || time.Since(start) >= gu.DefaultHookTimeout {
+		tests.Failed(t, "Should have skipped AfterRender hooks for server renders")
+	}
+	tests.Passed(t, "Should have skipped AfterRender hooks for server renders")
+}
+
+func TestLifecycleCancel(t *testing.T) {
+	var unsaved = true
+
+	app := gu.App(gu.AppAttr{Name: "lifecycle", Driver: browserDriver{ssr.NewDriver()}})
+
+	editor := app.View(gu.ViewAttr{Name: "editor", Route: "/editor"})
+	editor.BeforeLeave(func(tr gu.Transition) error {
+		if unsaved {
+			return gu.ErrNavigationCancelled
+		}
+		return nil
+	})
+	editor.Component(gu.ComponentAttr{Base: elems.Div(elems.Text("Editing"))})
+
+	app.View(gu.ViewAttr{Name: "home", Route: "/home"}).Component(gu.ComponentAttr{
+		Base: elems.Div(elems.Text("Welcome Home")),
+	})
+
+	if err := app.ActivateRoute(router.UseLocation("/editor")); err != nil {
+		tests.Failed(t, "Should have activated editor route: %+q", err)
+	}
+	tests.Passed(t, "Should have activated editor route")
+
+	if err := app.ActivateRoute(router.UseLocation("/home")); err != gu.ErrNavigationCancelled {
+		tests.Failed(t, "Should have cancelled navigation away from editor: %+q", err)
+	}
+	tests.Passed(t, "Should have cancelled navigation away from editor")
+
+	if app.Location().Rem != "/editor" {
+		tests.Failed(t, "Should have kept editor route active: %q", app.Location().Rem)
+	}
+	tests.Passed(t, "Should have kept editor route active")
+
+	if html := app.Render(router.UseLocation("/home")).HTML(); !strings.Contains(html, "Editing") {
+		tests.Failed(t, "Should have rendered editor view: %q", html)
+	}
+	tests.Passed(t, "Should have rendered editor view")
+
+	unsaved = false
+
+	if html := app.Render(router.UseLocation("/home")).HTML(); !strings.Contains(html, "Welcome Home") {
+		tests.Failed(t, "Should have rendered home view: %q", html)
+	}
+	tests.Passed(t, "Should have rendered home view")
+}
+
+func TestLifecycleFailures(t *testing.T) {
+	app := gu.App(gu.AppAttr{
+		Name:        "lifecycle",
+		Driver:      browserDriver{ssr.NewDriver()},
+		HookTimeout: 20 * time.Millisecond,
+	})
+
+	slow := app.View(gu.ViewAttr{Name: "slow", Route: "/slow"})
+	slow.BeforeLeave(func(tr gu.Transition, done func(error)) {})
+
+	load := app.View(gu.ViewAttr{Name: "load", Route: "/load"})
+	load.AfterEnter(func(tr gu.Transition) error {
+		return errors.New("data failed to load")
+	})
+
+	if err := app.ActivateRoute(router.UseLocation("/slow")); err != nil {
+		tests.Failed(t, "Should have activated slow route: %+q", err)
+	}
+	tests.Passed(t, "Should have activated slow route")
+
+	if err := app.ActivateRoute(router.UseLocation("/load")); err != gu.ErrHookTimeout {
+		tests.Failed(t, "Should have timed out leaving slow route: %+q", err)
+	}
+	tests.Passed(t, "Should have timed out leaving slow route")
+
+	app = gu.App(gu.AppAttr{Name: "lifecycle", Driver: ssr.NewDriver()})
+	app.View(gu.ViewAttr{Name: "load", Route: "/load"}).AfterEnter(func(tr gu.Transition) error {
+		return errors.New("data failed to load")
+	})
+
+	html := app.Render(router.UseLocation("/load")).HTML()
+	if app.Status() != http.StatusInternalServerError || !strings.Contains(html, "data failed to load") {
+		tests.Failed(t, "Should have rendered Failure view for failed AfterEnter hook: %d %q", app.Status(), html)
+	}
+	tests.Passed(t, "Should have rendered Failure view for failed AfterEnter hook")
+}
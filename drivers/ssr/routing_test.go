@@ -0,0 +1,96 @@
+package ssr_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/ssr"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/tests"
+	"github.com/gu-io/gu/trees/elems"
+)
+
+// routedApp returns a app holding a view rendering it's name for each of the
+// routes.
+func routedApp(radix bool, routes ...string) *gu.NApp {
+	app := gu.App(gu.AppAttr{Name: "routing", Driver: ssr.NewDriver(), RadixRouting: radix})
+
+	for _, route := range routes {
+		app.View(gu.ViewAttr{Name: route, Route: route}).Component(gu.ComponentAttr{
+			Base: elems.Span(elems.Text("[" + route + "]")),
+		})
+	}
+
+	return app
+}
+
+func TestRadixRouting(t *testing.T) {
+	app := routedApp(true, "/*", "/users/:id", "/users/new", "/about")
+
+	routes := map[string]string{
+		"/users/new": "[/*][/users/new]",
+		"/users/12":  "[/*][/users/:id]",
+		"/about":     "[/*][/about]",
+		"/contact":   "[/*]",
+	}
+
+	for path, expected := range routes {
+		html := app.Render(router.UseLocation(path)).HTML()
+
+		var rendered string
+		for _, part := range strings.Split(html, "[")[1:] {
+			rendered += "[" + part[:strings.Index(part, "]")+1]
+		}
+
+		if rendered != expected {
+			tests.Failed(t, "Should have rendered %q for %q: %q", expected, path, rendered)
+		}
+	}
+	tests.Passed(t, "Should have rendered the views of the most specific route")
+
+	app = routedApp(true, "/users/:id")
+	app.Render(router.UseLocation("/contact"))
+
+	if app.Status() != http.StatusNotFound {
+		tests.Failed(t, "Should have responded with status 404: %d", app.Status())
+	}
+	tests.Passed(t, "Should have used the NotFound view without a matching view")
+}
+
+// benchRoutes returns the routes of the views used by the benchmarks.
+func benchRoutes() []string {
+	var routes []string
+	for i := 0; i < 100; i++ {
+		routes = append(routes,
+			fmt.Sprintf("/section%d/items", i),
+			fmt.Sprintf("/section%d/items/:id", i),
+			fmt.Sprintf("/section%d/items/:id/edit", i),
+		)
+	}
+
+	return routes
+}
+
+func benchmarkActivateRoute(b *testing.B, radix bool) {
+	app := routedApp(radix, benchRoutes()...)
+
+	paths := []string{"/section98/items/42", "/section3/items"}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		app.ActivateRoute(paths[i%len(paths)])
+	}
+}
+
+func BenchmarkActivateRoute(b *testing.B) {
+	benchmarkActivateRoute(b, false)
+}
+
+func BenchmarkRadixActivateRoute(b *testing.B) {
+	benchmarkActivateRoute(b, true)
+}
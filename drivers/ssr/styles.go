@@ -0,0 +1,82 @@
+package ssr
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// StyleOptions defines how the stylesheets of components, such as those of
+// elems.CSS, are written within rendered documents.
+type StyleOptions struct {
+	// Extract moves the stylesheets of components into a single style within
+	// the head of the document, writing stylesheets of equal content once.
+	Extract bool
+
+	// Critical keeps only the rules of the extracted stylesheets which match
+	// the rendered markup.
+	Critical bool
+
+	// File writes the extracted stylesheets into a css file linked from the
+	// head rather than a style. Only used by ExportWith, where routes with
+	// the same styles share the same file.
+	File bool
+}
+
+// extractStyles returns a copy of the tree without the stylesheets of it's
+// components along with their content.
+func extractStyles(tree *trees.Markup, styles StyleOptions) (*trees.Markup, string, error) {
+	doc := tree.Clone()
+	content := strings.Join(trees.ExtractStylesheets(doc), "\n")
+
+	if styles.Critical && content != "" {
+		critical, err := trees.CriticalCSS(content, doc)
+		if err != nil {
+			return nil, "", err
+		}
+
+		content = critical
+	}
+
+	return doc, content, nil
+}
+
+// styleFile returns the name of the css file holding the content.
+func styleFile(content string) string {
+	hash := fnv.New32a()
+	hash.Write([]byte(content))
+
+	return fmt.Sprintf("styles-%08x.css", hash.Sum32())
+}
+
+// addToHead adds the markup to the end of the head of the document, or to
+// the start of the document without a head.
+func addToHead(doc *trees.Markup, markup *trees.Markup) {
+	if head := trees.Query.Query(doc, "head"); head != nil {
+		head.AddChild(markup)
+		return
+	}
+
+	doc.AddChild(markup)
+
+	children := doc.Children()
+	copy(children[1:], children[:len(children)-1])
+	children[0] = markup
+}
+
+// inlineStyle returns a style holding the content.
+func inlineStyle(content string) *trees.Markup {
+	style := trees.NewMarkup("style", false)
+	trees.NewText(content).Apply(style)
+	return style
+}
+
+// linkedStyle returns a link to the stylesheet at the url.
+func linkedStyle(url string) *trees.Markup {
+	link := trees.NewMarkup("link", true)
+	trees.NewAttr("rel", "stylesheet").Apply(link)
+	trees.NewAttr("href", url).Apply(link)
+	return link
+}
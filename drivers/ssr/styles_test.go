@@ -0,0 +1,105 @@
+package ssr_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/ssr"
+	"github.com/gu-io/gu/tests"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+)
+
+type card struct{}
+
+// newCard returns a card with a scoped stylesheet holding a rule which
+// matches no element.
+func newCard() *trees.Markup {
+	return elems.Div(
+		elems.ScopedCSS(`
+			& span {
+				color: red;
+			}
+
+			& .missing {
+				color: blue;
+			}
+		`, card{}),
+		elems.Span(elems.Text("Card")),
+	)
+}
+
+// newCardsApp returns an app rendering a list of cards at /cards.
+func newCardsApp(attr gu.AppAttr) *gu.NApp {
+	app := newApp(attr)
+	app.View(gu.ViewAttr{Name: "cards", Route: "/cards"}).Component(gu.ComponentAttr{
+		Base: elems.Div(newCard(), newCard(), newCard()),
+	})
+
+	return app
+}
+
+func TestHandlerStyles(t *testing.T) {
+	handler := ssr.NewHandler(newCardsApp(gu.AppAttr{}))
+
+	body := serve(handler, "/cards").Body.String()
+	if strings.Count(body, "color: red;") != 3 {
+		tests.Failed(t, "Should have kept a stylesheet per card by default: %q", body)
+	}
+	tests.Passed(t, "Should have kept a stylesheet per card by default")
+
+	handler.Styles = ssr.StyleOptions{Extract: true}
+
+	body = serve(handler, "/cards").Body.String()
+	head := body[:strings.Index(body, "</head>")]
+
+	if strings.Count(body, "<style") != 1 || strings.Count(head, "color: red;") != 1 || !strings.Contains(head, "color: blue;") {
+		tests.Failed(t, "Should have extracted a single stylesheet into the head: %q", body)
+	}
+	tests.Passed(t, "Should have extracted a single stylesheet into the head")
+
+	handler.Styles = ssr.StyleOptions{Extract: true, Critical: true}
+
+	body = serve(handler, "/cards").Body.String()
+	if strings.Count(body, "color: red;") != 1 || strings.Contains(body, "color: blue;") {
+		tests.Failed(t, "Should have kept only the rules matching the markup: %q", body)
+	}
+	tests.Passed(t, "Should have kept only the rules matching the markup")
+
+	if body = serve(handler, "/cards").Body.String(); strings.Count(body, "color: red;") != 1 {
+		tests.Failed(t, "Should have kept the stylesheets of components between renders: %q", body)
+	}
+	tests.Passed(t, "Should have kept the stylesheets of components between renders")
+}
+
+func TestExportStyles(t *testing.T) {
+	dir := t.TempDir()
+
+	app := newCardsApp(gu.AppAttr{BasePath: "/admin"})
+	if err := ssr.ExportWith(app, dir, ssr.StyleOptions{Extract: true, File: true}, "/cards", "/home"); err != nil {
+		tests.Failed(t, "Should have exported routes: %+q", err)
+	}
+	tests.Passed(t, "Should have exported routes")
+
+	files, _ := filepath.Glob(filepath.Join(dir, "styles-*.css"))
+	if len(files) != 1 {
+		tests.Failed(t, "Should have written a single stylesheet file: %q", files)
+	}
+
+	sheet, _ := ioutil.ReadFile(files[0])
+	if strings.Count(string(sheet), "color: red;") != 1 {
+		tests.Failed(t, "Should have written the stylesheets once: %q", sheet)
+	}
+	tests.Passed(t, "Should have written a single stylesheet file")
+
+	content, _ := ioutil.ReadFile(filepath.Join(dir, "cards", "index.html"))
+	link := `href="/admin/` + filepath.Base(files[0]) + `"`
+
+	if !strings.Contains(string(content), link) || strings.Contains(string(content), "<style") {
+		tests.Failed(t, "Should have linked the stylesheet file: %q", content)
+	}
+	tests.Passed(t, "Should have linked the stylesheet file")
+}
@@ -0,0 +1,63 @@
+package ssr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/ssr"
+	"github.com/gu-io/gu/tests"
+	"github.com/gu-io/gu/trees/css"
+	"github.com/gu-io/gu/trees/elems"
+)
+
+// updateDriver defines a ssr driver recording the views it updates.
+type updateDriver struct {
+	*ssr.Driver
+	updated []*gu.NView
+}
+
+// Update records the view.
+func (u *updateDriver) Update(app *gu.NApp, view *gu.NView) {
+	u.updated = append(u.updated, view)
+}
+
+func TestTheme(t *testing.T) {
+	light := &css.Theme{Name: "light", Colors: map[string]string{"primary": "#c00"}}
+	dark := &css.Theme{Name: "dark", Colors: map[string]string{"primary": "#f66"}}
+
+	driver := &updateDriver{Driver: ssr.NewDriver()}
+	app := gu.App(gu.AppAttr{Name: "themed", Driver: driver, Theme: light})
+
+	app.View(gu.ViewAttr{Name: "home", Route: "/home"}).Component(gu.ComponentAttr{
+		Base: elems.Div(elems.CSS(`& { color: {{ token "color-primary" }}; }`, nil)),
+	})
+
+	handler := ssr.NewHandler(app)
+
+	body := serve(handler, "/home").Body.String()
+	head := body[:strings.Index(body, "</head>")]
+
+	if !strings.Contains(head, `gu-theme="light"`) || !strings.Contains(head, "--gu-color-primary: #c00;") {
+		tests.Failed(t, "Should have written the theme tokens into the head: %q", body)
+	}
+	tests.Passed(t, "Should have written the theme tokens into the head")
+
+	if !strings.Contains(body, "color: var(--gu-color-primary);") {
+		tests.Failed(t, "Should have referred to the tokens within components: %q", body)
+	}
+	tests.Passed(t, "Should have referred to the tokens within components")
+
+	updates := len(driver.updated)
+	app.SetTheme(dark)
+
+	if app.Theme() != dark || len(driver.updated) != updates+1 || driver.updated[updates].Attr().Target != gu.HeadTarget {
+		tests.Failed(t, "Should have updated only the theme view: %d", len(driver.updated)-updates)
+	}
+	tests.Passed(t, "Should have updated only the theme view")
+
+	if body = serve(handler, "/home").Body.String(); !strings.Contains(body, "--gu-color-primary: #f66;") || strings.Contains(body, "#c00") {
+		tests.Failed(t, "Should have rendered the new theme: %q", body)
+	}
+	tests.Passed(t, "Should have rendered the new theme")
+}
@@ -3,11 +3,27 @@ package main
 import (
 	"github.com/gu-io/gu"
 	"github.com/gu-io/gu/examples/greeter/components"
+	"github.com/gu-io/gu/trees/css"
 	"github.com/gu-io/gu/trees/elems"
 )
 
+// Theme defines the design tokens shared by the greeter's stylesheets.
+var Theme = &css.Theme{
+	Name: "galaxy",
+	Colors: map[string]string{
+		"text":    "#fff",
+		"surface": "rgba(0,0,0,0.2)",
+		"input":   "rgba(255,255,255,0.2)",
+		"border":  "rgba(255,255,255,0.3)",
+	},
+	Typography: map[string]string{
+		"body": `"Lato", helvetica, sans-serif`,
+	},
+}
+
 func main() {
 	app := gu.App(AppSettings)
+	app.SetTheme(Theme)
 
 	index := app.View(gu.ViewAttr{
 		Name:  "View.Greeter",
@@ -36,7 +52,7 @@ func main() {
 				body{
 					width: 100%;
 					height: 100%;
-					font-family: "Lato", helvetica, sans-serif;
+					font-family: {{ token "font-body" }};
 					background: url("assets/galaxy3.jpg") no-repeat;
 					background-size: cover;
 				}
@@ -46,8 +62,8 @@ func main() {
 					height: 100%;
 					padding: 10px;
 					margin: 0px auto;
-					color: #fff;
-					background: rgba(0,0,0,0.2);
+					color: {{ token "color-text" }};
+					background: {{ token "color-surface" }};
 				}
 
 				& h1{
@@ -69,8 +85,8 @@ func main() {
 				}
 
 				& .greeter-app .receiver input{
-					color: #fff !important;
-					background: rgba(255,255,255,0.2);
+					color: {{ token "color-text" }} !important;
+					background: {{ token "color-input" }};
 				}
 		`, nil)),
 	})
@@ -60,7 +60,7 @@ func (g *Greeter) Render() *trees.Markup {
 				border: none;
 				outline: none;
 				background: none;
-				border-bottom:5px solid rgba(255,255,255,0.3);
+				border-bottom:5px solid {{ token "color-border" }};
 			}
 
 		`, nil),
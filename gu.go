@@ -53,6 +53,13 @@ type Services struct {
 	Updated       Subscriptions
 	Unmounted     Subscriptions
 	Notifications *notifications.AppNotification
+
+	// Lifecycle holds the navigation lifecycle hooks of the component.
+	Lifecycle *Lifecycle
+
+	// Fail reports a failure, such as a failed data load, which switches the
+	// app to its Failure view for the current route.
+	Fail func(error)
 }
 
 // RegisterService provides an interface which registers the provided fetcher,
@@ -71,6 +78,20 @@ type Renderable interface {
 // Renderables defines a lists of Renderable structures.
 type Renderables []Renderable
 
+// RenderInlined returns the html of the renderable with the rules of it's
+// stylesheets inlined into the styles of the elements they match, producing
+// self-contained html for clients ignoring style elements, such as those of
+// emails. See trees.InlineStyles.
+func RenderInlined(r Renderable) (string, error) {
+	markup := r.Render().Clone()
+
+	if err := trees.InlineStyles(markup); err != nil {
+		return "", err
+	}
+
+	return trees.SimpleElementWriter.Print(markup), nil
+}
+
 // MarkupRenderer provides a interface for a types capable of rendering dom markup.
 type MarkupRenderer interface {
 	Renderable
@@ -135,6 +156,114 @@ func ViewRenderCommand(view *NView) RenderCommand {
 	}
 }
 
+// MarshalBinary returns the compact binary encoding of the RenderCommand, which
+// holds the same content as it's JSON encoding.
+func (r RenderCommand) MarshalBinary() ([]byte, error) {
+	encoder := trees.NewBinaryEncoder(trees.CommandMessage)
+	encoder.WriteString(r.Command)
+
+	encoder.WriteString(r.App.AppID)
+	encoder.WriteString(r.App.Name)
+	encoder.WriteString(r.App.Title)
+	writeViews(encoder, r.App.Head)
+	writeViews(encoder, r.App.Body)
+	writeResources(encoder, r.App.HeadResources)
+	writeResources(encoder, r.App.BodyResources)
+
+	writeView(encoder, r.View)
+
+	return encoder.Bytes(), nil
+}
+
+// UnmarshalBinary sets the RenderCommand from the binary encoding returned by
+// MarshalBinary.
+func (r *RenderCommand) UnmarshalBinary(data []byte) error {
+	decoder := trees.NewBinaryDecoder(data, trees.CommandMessage)
+
+	var command RenderCommand
+	command.Command = decoder.ReadString()
+
+	command.App.AppID = decoder.ReadString()
+	command.App.Name = decoder.ReadString()
+	command.App.Title = decoder.ReadString()
+	command.App.Head = readViews(decoder)
+	command.App.Body = readViews(decoder)
+	command.App.HeadResources = readResources(decoder)
+	command.App.BodyResources = readResources(decoder)
+
+	command.View = readView(decoder)
+
+	if err := decoder.Done(); err != nil {
+		return err
+	}
+
+	*r = command
+	return nil
+}
+
+// writeView writes the ViewJSON into the encoder.
+func writeView(encoder *trees.BinaryEncoder, view ViewJSON) {
+	encoder.WriteString(view.AppID)
+	encoder.WriteString(view.ViewID)
+	encoder.WriteMarkupJSON(view.Tree)
+}
+
+// writeViews writes the list of ViewJSON into the encoder.
+func writeViews(encoder *trees.BinaryEncoder, views []ViewJSON) {
+	encoder.WriteLength(views == nil, len(views))
+	for _, view := range views {
+		writeView(encoder, view)
+	}
+}
+
+// writeResources writes the list of MarkupJSON into the encoder.
+func writeResources(encoder *trees.BinaryEncoder, resources []trees.MarkupJSON) {
+	encoder.WriteLength(resources == nil, len(resources))
+	for _, resource := range resources {
+		encoder.WriteMarkupJSON(resource)
+	}
+}
+
+// readView reads a ViewJSON from the decoder.
+func readView(decoder *trees.BinaryDecoder) ViewJSON {
+	var view ViewJSON
+	view.AppID = decoder.ReadString()
+	view.ViewID = decoder.ReadString()
+	view.Tree = decoder.ReadMarkupJSON()
+
+	return view
+}
+
+// readViews reads a list of ViewJSON from the decoder.
+func readViews(decoder *trees.BinaryDecoder) []ViewJSON {
+	length, isNil := decoder.ReadLength()
+	if isNil {
+		return nil
+	}
+
+	views := make([]ViewJSON, 0, length)
+	for i := 0; i < length && decoder.Err() == nil; i++ {
+		views = append(views, readView(decoder))
+	}
+
+	return views
+}
+
+// readResources reads a list of MarkupJSON from the decoder.
+func readResources(decoder *trees.BinaryDecoder) []trees.MarkupJSON {
+	length, isNil := decoder.ReadLength()
+	if isNil {
+		return nil
+	}
+
+	resources := make([]trees.MarkupJSON, 0, length)
+	for i := 0; i < length && decoder.Err() == nil; i++ {
+		resources = append(resources, decoder.ReadMarkupJSON())
+	}
+
+	return resources
+}
+
 //==============================================================================
 
 // NewReactive returns an instance of a Reactive struct.
@@ -0,0 +1,71 @@
+package gu_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/tests"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/css"
+	"github.com/gu-io/gu/trees/elems"
+)
+
+var cardRule = css.New(`
+	& {
+		color: {{ .Color }};
+	}
+
+	& span {
+		font-weight: bold;
+	}
+
+	& p {
+		color: black;
+	}
+`)
+
+// card defines a component rendering it's stylesheet scoped either by the
+// uid of it's markup or by a class.
+type card struct {
+	Color  string
+	Scoped bool
+}
+
+// Render returns the markup of the card.
+func (c card) Render() *trees.Markup {
+	sheet := elems.CSS(cardRule, c)
+	if c.Scoped {
+		sheet = elems.ScopedCSS(cardRule, c)
+	}
+
+	return elems.Div(
+		sheet,
+		elems.Span(elems.Text("Hello")),
+		elems.Paragraph(trees.NewAttr("style", "color: green")),
+	)
+}
+
+func TestRenderInlined(t *testing.T) {
+	for _, c := range []card{{Color: "red"}, {Color: "blue", Scoped: true}} {
+		html, err := gu.RenderInlined(c)
+		if err != nil {
+			tests.Failed(t, "Should have rendered the card: %+q", err)
+		}
+
+		if strings.Contains(html, "<style") {
+			tests.Failed(t, "Should have removed the stylesheet: %s", html)
+		}
+
+		for _, part := range []string{"color:" + c.Color + ";", "font-weight:bold;", "color:green;"} {
+			if !strings.Contains(html, part) {
+				tests.Failed(t, "Should have inlined %q: %s", part, html)
+			}
+		}
+
+		if strings.Contains(html, "color:black;") {
+			tests.Failed(t, "Should have kept the style attribute over the stylesheet: %s", html)
+		}
+	}
+	tests.Passed(t, "Should have inlined uid and class scoped stylesheets")
+}
@@ -0,0 +1,147 @@
+package gu
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gu-io/gu/router"
+)
+
+// DefaultHookTimeout defines the duration a navigation lifecycle hook is given
+// to complete when AppAttr.HookTimeout is not set.
+const DefaultHookTimeout = 5 * time.Second
+
+var (
+	// ErrNavigationCancelled is returned by BeforeLeave hooks to cancel the
+	// navigation to a new route.
+	ErrNavigationCancelled = errors.New("Navigation cancelled")
+
+	// ErrHookTimeout is returned when a navigation lifecycle hook fails to
+	// complete within the app's hook timeout.
+	ErrHookTimeout = errors.New("Navigation hook timed out")
+)
+
+// Transition defines the change of route which a navigation lifecycle hook
+// is called for.
+type Transition struct {
+	From router.PushEvent
+	To   router.PushEvent
+}
+
+// Lifecycle defines a struct which holds the navigation lifecycle hooks of a
+// view or component. Hooks are called in the order they where added and
+// accept the following types:
+//
+//   - func()
+//   - func(Transition)
+//   - func(Transition) error
+//   - func(Transition, func(error))
+//
+// The last form allows a hook to complete asynchronously by calling the provided
+// function once done.
+type Lifecycle struct {
+	leave  []lifecycleHook
+	enter  []lifecycleHook
+	render []lifecycleHook
+}
+
+// NewLifecycle returns a new instance of a Lifecycle.
+func NewLifecycle() *Lifecycle {
+	return &Lifecycle{}
+}
+
+// BeforeLeave adds a hook called before the owner of the lifecycle is left
+// for a route which it does not match. A hook returning an error cancels the
+// navigation, leaving the current route active.
+func (l *Lifecycle) BeforeLeave(hook interface{}) {
+	l.leave = append(l.leave, newLifecycleHook(hook))
+}
+
+// AfterEnter adds a hook called once the owner of the lifecycle has been
+// activated for a route. A hook returning an error switches the app to
+// its Failure view.
+func (l *Lifecycle) AfterEnter(hook interface{}) {
+	l.enter = append(l.enter, newLifecycleHook(hook))
+}
+
+// AfterRender adds a hook called after the first render of the app following
+// the activation of the lifecycle's owner.
+func (l *Lifecycle) AfterRender(hook interface{}) {
+	l.render = append(l.render, newLifecycleHook(hook))
+}
+
+//==============================================================================
+
+// lifecycleHook defines the form all lifecycle hooks are transformed into.
+type lifecycleHook func(Transition, func(error))
+
+// newLifecycleHook returns the lifecycleHook for the provided hook.
+func newLifecycleHook(hook interface{}) lifecycleHook {
+	switch mo := hook.(type) {
+	case func():
+		return func(_ Transition, done func(error)) {
+			mo()
+			done(nil)
+		}
+	case func(Transition):
+		return func(tr Transition, done func(error)) {
+			mo(tr)
+			done(nil)
+		}
+	case func(Transition) error:
+		return func(tr Transition, done func(error)) {
+			done(mo(tr))
+		}
+	case func(Transition, func(error)):
+		return mo
+	default:
+		panic(`
+			Unknown lifecycle hook type
+
+				Accepted Hook Arguments:
+					- func()
+					- func(Transition)
+					- func(Transition) error
+					- func(Transition, func(error))
+
+			`)
+	}
+}
+
+// runHooks calls the provided hooks in order, stopping at the first hook
+// which fails or does not complete within the timeout.
+func runHooks(hooks []lifecycleHook, tr Transition, timeout time.Duration) error {
+	for _, hook := range hooks {
+		done := make(chan error, 1)
+
+		go func(hook lifecycleHook) {
+			defer func() {
+				if err := recover(); err != nil {
+					select {
+					case done <- fmt.Errorf("Navigation hook panicked: %+v", err):
+					default:
+					}
+				}
+			}()
+
+			hook(tr, func(err error) {
+				select {
+				case done <- err:
+				default:
+				}
+			})
+		}(hook)
+
+		select {
+		case err := <-done:
+			if err != nil {
+				return err
+			}
+		case <-time.After(timeout):
+			return ErrHookTimeout
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package router
+
+import "strings"
+
+// CleanBase returns the provided base path in the form used by StripBase and
+// JoinBase, with a leading slash and no trailing slash. A empty base or
+// a base of "/" returns "".
+func CleanBase(base string) string {
+	base = strings.Trim(strings.TrimSpace(base), "/")
+	if base == "" {
+		return ""
+	}
+
+	return "/" + base
+}
+
+// StripBase returns a PushEvent with the provided base path removed from the
+// path and remaining path of the provided PushEvent, which allows routes be
+// matched relative to the base. It returns false if the path of the PushEvent
+// is not under the base. Remaining paths which do not start with the base, such
+// as those from hashes, are left untouched.
+func StripBase(base string, pe PushEvent) (PushEvent, bool) {
+	base = CleanBase(base)
+	if base == "" {
+		return pe, true
+	}
+
+	path, ok := trimBase(base, pe.Path)
+	if !ok && pe.Path != "" {
+		return pe, false
+	}
+
+	if ok {
+		pe.Path = path
+	}
+
+	if rem, ok := trimBase(base, pe.Rem); ok {
+		pe.Rem = rem
+	}
+
+	return pe, true
+}
+
+// JoinBase returns the provided path prefixed with the base path, used for
+// navigating to and linking routes of a app mounted under the base. Hash
+// only paths are returned as they are, as they are relative to the current
+// document.
+func JoinBase(base string, path string) string {
+	base = CleanBase(base)
+	if base == "" || strings.HasPrefix(path, "#") {
+		return path
+	}
+
+	if path == "" || path == "/" {
+		return base + "/"
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return base + path
+}
+
+// trimBase removes the base from the provided path if the path is the base
+// or continues after it with a '/', '?' or '#'.
+func trimBase(base string, path string) (string, bool) {
+	if !strings.HasPrefix(path, base) {
+		return path, false
+	}
+
+	rest := path[len(base):]
+	if rest == "" {
+		return "/", true
+	}
+
+	switch rest[0] {
+	case '/':
+		return rest, true
+	case '?', '#':
+		return "/" + rest, true
+	}
+
+	return path, false
+}
@@ -0,0 +1,117 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Route defines a node of the resolved route table, containing the pattern
+// of a Resolver, the name of its owner and the routes registered below it.
+type Route struct {
+	Pattern string  `json:"pattern"`
+	Owner   string  `json:"owner,omitempty"`
+	Routes  []Route `json:"routes,omitempty"`
+}
+
+// Route table formats supported by WriteRoutes.
+const (
+	TextFormat = "text"
+	JSONFormat = "json"
+	DOTFormat  = "dot"
+)
+
+// WriteRoutes writes the provided routes into the writer using the format,
+// which must be one of TextFormat, JSONFormat or DOTFormat.
+func WriteRoutes(w io.Writer, format string, routes ...Route) error {
+	switch format {
+	case TextFormat, "":
+		return WriteText(w, routes...)
+	case JSONFormat:
+		return WriteJSON(w, routes...)
+	case DOTFormat:
+		return WriteDOT(w, routes...)
+	}
+
+	return fmt.Errorf("Unknown route table format %q", format)
+}
+
+// WriteText writes the routes as an indented tree where each line contains
+// the pattern of a route followed by its owner.
+func WriteText(w io.Writer, routes ...Route) error {
+	for _, route := range routes {
+		if err := writeTextRoute(w, route, 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeTextRoute(w io.Writer, route Route, depth int) error {
+	line := strings.Repeat("  ", depth) + route.Pattern
+	if route.Owner != "" {
+		line += "  (" + route.Owner + ")"
+	}
+
+	if _, err := fmt.Fprintln(w, line); err != nil {
+		return err
+	}
+
+	for _, child := range route.Routes {
+		if err := writeTextRoute(w, child, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteJSON writes the routes as an indented JSON array.
+func WriteJSON(w io.Writer, routes ...Route) error {
+	if routes == nil {
+		routes = []Route{}
+	}
+
+	data, err := json.MarshalIndent(routes, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// WriteDOT writes the routes as a graphviz digraph where each route is a node
+// linked to the routes registered below it.
+func WriteDOT(w io.Writer, routes ...Route) error {
+	var lines []string
+	var count int
+
+	var walk func(Route) string
+	walk = func(route Route) string {
+		id := fmt.Sprintf("route%d", count)
+		count++
+
+		label := route.Pattern
+		if route.Owner != "" {
+			label += "\n" + route.Owner
+		}
+
+		lines = append(lines, fmt.Sprintf("\t%s [label=%q];", id, label))
+
+		for _, child := range route.Routes {
+			lines = append(lines, fmt.Sprintf("\t%s -> %s;", id, walk(child)))
+		}
+
+		return id
+	}
+
+	for _, route := range routes {
+		walk(route)
+	}
+
+	_, err := fmt.Fprintf(w, "digraph routes {\n%s\n}\n", strings.Join(lines, "\n"))
+	return err
+}
@@ -0,0 +1,105 @@
+package router_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/tests"
+)
+
+func TestRoutes(t *testing.T) {
+	home := router.New("/home/*").SetOwner("View.Home")
+	home.Register(router.New("/:id").SetOwner("Item"))
+	home.Only("/edit/*")
+
+	route := home.Routes()
+	if route.Pattern != "/home/*" || route.Owner != "View.Home" {
+		tests.Failed(t, "Should have root route with pattern and owner: %#v", route)
+	}
+	tests.Passed(t, "Should have root route with pattern and owner")
+
+	if len(route.Routes) != 2 {
+		tests.Failed(t, "Should have two nested routes: %d", len(route.Routes))
+	}
+	tests.Passed(t, "Should have two nested routes")
+
+	if route.Routes[0].Pattern != "/:id" || route.Routes[0].Owner != "Item" {
+		tests.Failed(t, "Should have nested route with pattern and owner: %#v", route.Routes[0])
+	}
+	tests.Passed(t, "Should have nested route with pattern and owner")
+
+	if route.Routes[1].Pattern != "/edit/*" {
+		tests.Failed(t, "Should have nested route from Only: %#v", route.Routes[1])
+	}
+	tests.Passed(t, "Should have nested route from Only")
+}
+
+func TestRouteManagerRoutes(t *testing.T) {
+	rm := router.NewRouteManager()
+	rm.L("/index/*")
+	rm.L("/home/*").N("/models/*").N("/:id")
+
+	routes := rm.Routes()
+	if len(routes) != 2 {
+		tests.Failed(t, "Should have two levels: %d", len(routes))
+	}
+	tests.Passed(t, "Should have two levels")
+
+	if routes[0].Pattern != "/home/*" || routes[1].Pattern != "/index/*" {
+		tests.Failed(t, "Should have levels ordered by path: %#v", routes)
+	}
+	tests.Passed(t, "Should have levels ordered by path")
+
+	if len(routes[0].Routes) != 1 || len(routes[0].Routes[0].Routes) != 1 {
+		tests.Failed(t, "Should have nested levels: %#v", routes[0])
+	}
+	tests.Passed(t, "Should have nested levels")
+}
+
+func TestWriteRoutes(t *testing.T) {
+	home := router.New("/home/*").SetOwner("View.Home")
+	home.Register(router.New("/:id").SetOwner("Item"))
+
+	var text bytes.Buffer
+	if err := router.WriteRoutes(&text, router.TextFormat, home.Routes()); err != nil {
+		tests.Failed(t, "Should have written text table: %q", err)
+	}
+
+	if expected := "/home/*  (View.Home)\n  /:id  (Item)\n"; text.String() != expected {
+		tests.Failed(t, "Should have written text table: %q", text.String())
+	}
+	tests.Passed(t, "Should have written text table")
+
+	var data bytes.Buffer
+	if err := router.WriteRoutes(&data, router.JSONFormat, home.Routes()); err != nil {
+		tests.Failed(t, "Should have written json table: %q", err)
+	}
+
+	var routes []router.Route
+	if err := json.Unmarshal(data.Bytes(), &routes); err != nil {
+		tests.Failed(t, "Should have decoded json table: %q", err)
+	}
+
+	if len(routes) != 1 || routes[0].Routes[0].Owner != "Item" {
+		tests.Failed(t, "Should have decoded json table: %#v", routes)
+	}
+	tests.Passed(t, "Should have written json table")
+
+	var dot bytes.Buffer
+	if err := router.WriteRoutes(&dot, router.DOTFormat, home.Routes()); err != nil {
+		tests.Failed(t, "Should have written dot graph: %q", err)
+	}
+
+	if !strings.HasPrefix(dot.String(), "digraph routes {") || !strings.Contains(dot.String(), "route0 -> route1;") {
+		tests.Failed(t, "Should have written dot graph: %q", dot.String())
+	}
+	tests.Passed(t, "Should have written dot graph")
+
+	if err := router.WriteRoutes(&dot, "yaml"); err == nil {
+		tests.Failed(t, "Should have failed for unknown format")
+	}
+	tests.Passed(t, "Should have failed for unknown format")
+}
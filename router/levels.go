@@ -1,6 +1,10 @@
 package router
 
-import "github.com/gu-io/gu/trees"
+import (
+	"sort"
+
+	"github.com/gu-io/gu/trees"
+)
 
 // RouteApplier defines a interface which composes trees Applier, Morpher and
 // the Resolver to handle routing over against a tree markup.
@@ -49,6 +53,26 @@ func (r *RouteManager) Level(path string, morpher trees.SwitchMorpher) RouteAppl
 	return &root
 }
 
+// Routes returns the route table of all levels of the manager, ordered by
+// their paths.
+func (r *RouteManager) Routes() []Route {
+	var paths []string
+
+	for path := range r.Levels {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	var routes []Route
+
+	for _, path := range paths {
+		routes = append(routes, r.Levels[path].Routes())
+	}
+
+	return routes
+}
+
 //==============================================================================
 
 type rm struct {
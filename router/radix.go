@@ -0,0 +1,373 @@
+package router
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/influx6/faux/pattern"
+)
+
+// RadixMatch defines the result of matching a path against a RadixTree.
+type RadixMatch struct {
+	Pattern string
+	Value   interface{}
+	Params  map[string]string
+	Rem     string
+}
+
+// RadixTree defines a radix tree of route patterns, which matches a path against
+// all it's patterns at once without the use of regular expressions. Patterns
+// are made of static segments, parameter segments (":id") and a optional
+// wildcard as last segment ("*"), which allows more path after the pattern.
+//
+// When more than one pattern matches a path, the most specific wins: at each
+// segment static segments take precedence over parameters, which take
+// precedence over wildcards, regardless of the order patterns are added in.
+type RadixTree struct {
+	ml   sync.RWMutex
+	root radixNode
+}
+
+// NewRadixTree returns a new instance of a RadixTree.
+func NewRadixTree() *RadixTree {
+	return &RadixTree{}
+}
+
+// Add adds the provided pattern into the tree with the value returned when it
+// is matched. It returns an error if the pattern is invalid or a pattern
+// matching the same paths was already added.
+func (t *RadixTree) Add(route string, value interface{}) error {
+	segments := splitSegments(route)
+
+	r := &radixRoute{
+		pattern: route,
+		value:   value,
+	}
+
+	for index, segment := range segments {
+		switch {
+		case segment == "*":
+			if index != len(segments)-1 {
+				return fmt.Errorf("Invalid route pattern %q: wildcard must be the last segment", route)
+			}
+		case strings.HasPrefix(segment, ":"):
+			if len(segment) == 1 {
+				return fmt.Errorf("Invalid route pattern %q: parameter requires a name", route)
+			}
+
+			r.params = append(r.params, segment[1:])
+		}
+	}
+
+	t.ml.Lock()
+	defer t.ml.Unlock()
+
+	if existing := t.root.insert(segments, r); existing != nil {
+		return fmt.Errorf("Route pattern %q conflicts with %q", route, existing.pattern)
+	}
+
+	return nil
+}
+
+// Get returns the value of the pattern within the tree which matches the same
+// paths as the provided pattern, such as "/users/:id" for "/users/:name",
+// returning false if the tree holds no such pattern.
+func (t *RadixTree) Get(route string) (interface{}, bool) {
+	t.ml.RLock()
+	r := t.root.find(splitSegments(route))
+	t.ml.RUnlock()
+
+	if r == nil {
+		return nil, false
+	}
+
+	return r.value, true
+}
+
+// Match returns the match of the most specific pattern matching the provided
+// path, returning false if none matches.
+func (t *RadixTree) Match(path string) (RadixMatch, bool) {
+	segments := splitSegments(path)
+	values := make([]string, 0, len(segments))
+
+	t.ml.RLock()
+	r, rem, ok := t.root.lookup(segments, values)
+	t.ml.RUnlock()
+
+	if !ok {
+		return RadixMatch{}, false
+	}
+
+	return RadixMatch{
+		Pattern: r.pattern,
+		Value:   r.value,
+		Params:  r.values,
+		Rem:     rem,
+	}, true
+}
+
+// Validate matches the provided path returning the parameters and remaining path
+// of the most specific matching pattern, meeting the pattern.URIMatcher contract.
+func (t *RadixTree) Validate(path string) (map[string]string, string, bool) {
+	match, ok := t.Match(path)
+	if !ok {
+		return make(map[string]string), "", false
+	}
+
+	return match.Params, match.Rem, true
+}
+
+// Test evaluates the provided path, meeting the contract of Resolver.Test.
+func (t *RadixTree) Test(path string) (map[string]string, string, bool) {
+	return t.Validate(path)
+}
+
+//==============================================================================
+
+// RadixMatcher returns a pattern.URIMatcher for the provided pattern which
+// matches paths using a RadixTree. It panics if the pattern is invalid.
+func RadixMatcher(route string) pattern.URIMatcher {
+	tree := NewRadixTree()
+	if err := tree.Add(route, nil); err != nil {
+		panic(err)
+	}
+
+	return &radixMatcher{
+		RadixTree: tree,
+		pattern:   route,
+	}
+}
+
+// NewRadix returns a new Resolver which matches paths using a RadixTree.
+func NewRadix(path string) Resolver {
+	var br basicResolver
+	if path != "" {
+		br.matcher = RadixMatcher(path)
+	}
+
+	return &br
+}
+
+// radixMatcher defines a RadixTree holding a single pattern.
+type radixMatcher struct {
+	*RadixTree
+	pattern string
+}
+
+// Pattern returns the pattern matched by the matcher.
+func (r *radixMatcher) Pattern() string {
+	return r.pattern
+}
+
+//==============================================================================
+
+// radixRoute defines a pattern stored within a RadixTree.
+type radixRoute struct {
+	pattern string
+	value   interface{}
+	params  []string
+}
+
+// radixResult defines the route matched by a lookup with it's parameters.
+type radixResult struct {
+	*radixRoute
+	values map[string]string
+}
+
+// radixNode defines a node of a RadixTree. Static edges are labeled by runs
+// of static segments, which are split when patterns diverge within them.
+type radixNode struct {
+	label    []string
+	static   map[string]*radixNode
+	param    *radixNode
+	wildcard *radixRoute
+	route    *radixRoute
+}
+
+// insert adds the route for the provided segments below the node, returning
+// the existing route if one is already stored for the segments.
+func (n *radixNode) insert(segments []string, r *radixRoute) *radixRoute {
+	if len(segments) == 0 {
+		if n.route != nil {
+			return n.route
+		}
+
+		n.route = r
+		return nil
+	}
+
+	segment := segments[0]
+
+	if segment == "*" {
+		if n.wildcard != nil {
+			return n.wildcard
+		}
+
+		n.wildcard = r
+		return nil
+	}
+
+	if strings.HasPrefix(segment, ":") {
+		if n.param == nil {
+			n.param = &radixNode{}
+		}
+
+		return n.param.insert(segments[1:], r)
+	}
+
+	run := staticRun(segments)
+
+	if n.static == nil {
+		n.static = make(map[string]*radixNode)
+	}
+
+	child, ok := n.static[segment]
+	if !ok {
+		child = &radixNode{label: run}
+		n.static[segment] = child
+		return child.insert(segments[len(run):], r)
+	}
+
+	common := commonSegments(child.label, run)
+
+	// Split the child's label where the new pattern diverges.
+	if common < len(child.label) {
+		split := &radixNode{
+			label:  child.label[:common],
+			static: make(map[string]*radixNode),
+		}
+
+		child.label = child.label[common:]
+		split.static[child.label[0]] = child
+		n.static[segment] = split
+		child = split
+	}
+
+	return child.insert(segments[common:], r)
+}
+
+// find returns the route stored for the segments of a pattern below the node,
+// where parameters match regardless of their names.
+func (n *radixNode) find(segments []string) *radixRoute {
+	if len(segments) == 0 {
+		return n.route
+	}
+
+	segment := segments[0]
+
+	if segment == "*" {
+		if len(segments) != 1 {
+			return nil
+		}
+
+		return n.wildcard
+	}
+
+	if strings.HasPrefix(segment, ":") {
+		if n.param == nil {
+			return nil
+		}
+
+		return n.param.find(segments[1:])
+	}
+
+	child, ok := n.static[segment]
+	if !ok || !hasSegments(segments, child.label) {
+		return nil
+	}
+
+	return child.find(segments[len(child.label):])
+}
+
+// lookup returns the most specific route matching the segments below the node,
+// backtracking from static segments to parameters and then wildcards.
+func (n *radixNode) lookup(segments []string, values []string) (radixResult, string, bool) {
+	if len(segments) == 0 {
+		if n.route != nil {
+			return newRadixResult(n.route, values), "/", true
+		}
+
+		if n.wildcard != nil {
+			return newRadixResult(n.wildcard, values), "/", true
+		}
+
+		return radixResult{}, "", false
+	}
+
+	if child, ok := n.static[segments[0]]; ok && hasSegments(segments, child.label) {
+		if res, rem, ok := child.lookup(segments[len(child.label):], values); ok {
+			return res, rem, true
+		}
+	}
+
+	if n.param != nil {
+		if res, rem, ok := n.param.lookup(segments[1:], append(values, segments[0])); ok {
+			return res, rem, true
+		}
+	}
+
+	if n.wildcard != nil {
+		return newRadixResult(n.wildcard, values), "/" + strings.Join(segments, "/"), true
+	}
+
+	return radixResult{}, "", false
+}
+
+// newRadixResult returns the result for the route using the provided parameter
+// values.
+func newRadixResult(r *radixRoute, values []string) radixResult {
+	params := make(map[string]string, len(r.params))
+	for index, name := range r.params {
+		params[name] = values[index]
+	}
+
+	return radixResult{radixRoute: r, values: params}
+}
+
+// splitSegments returns the segments of the provided path or pattern, ignoring
+// a leading hash and surrounding slashes.
+func splitSegments(path string) []string {
+	path = strings.Trim(strings.TrimPrefix(path, "#"), "/")
+	if path == "" {
+		return nil
+	}
+
+	return strings.Split(path, "/")
+}
+
+// staticRun returns the leading static segments of the provided segments.
+func staticRun(segments []string) []string {
+	for index, segment := range segments {
+		if segment == "*" || strings.HasPrefix(segment, ":") {
+			return segments[:index]
+		}
+	}
+
+	return segments
+}
+
+// commonSegments returns the total leading segments shared by both lists.
+func commonSegments(a, b []string) int {
+	var total int
+	for total < len(a) && total < len(b) && a[total] == b[total] {
+		total++
+	}
+
+	return total
+}
+
+// hasSegments returns true/false if the segments begin with the provided label.
+func hasSegments(segments []string, label []string) bool {
+	if len(segments) < len(label) {
+		return false
+	}
+
+	for index, segment := range label {
+		if segments[index] != segment {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,202 @@
+package router_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/tests"
+)
+
+func TestRadixTree(t *testing.T) {
+	tree := router.NewRadixTree()
+
+	patterns := []string{
+		"/users/*",
+		"/users/:id",
+		"/users/:id/posts/:post",
+		"/users/new",
+		"/users/new/draft",
+		"/about",
+		"/",
+	}
+
+	for _, pattern := range patterns {
+		if err := tree.Add(pattern, pattern); err != nil {
+			tests.Failed(t, "Should have added pattern %q: %+q", pattern, err)
+		}
+	}
+	tests.Passed(t, "Should have added patterns")
+
+	matches := []struct {
+		path    string
+		pattern string
+		params  map[string]string
+		rem     string
+	}{
+		{path: "/users/new", pattern: "/users/new", rem: "/"},
+		{path: "/users/12", pattern: "/users/:id", params: map[string]string{"id": "12"}, rem: "/"},
+		{path: "/users/12/posts/4", pattern: "/users/:id/posts/:post", params: map[string]string{"id": "12", "post": "4"}, rem: "/"},
+		{path: "/users/new/posts/4", pattern: "/users/:id/posts/:post", params: map[string]string{"id": "new", "post": "4"}, rem: "/"},
+		{path: "/users/12/friends", pattern: "/users/*", rem: "/12/friends"},
+		{path: "/users", pattern: "/users/*", rem: "/"},
+		{path: "#/about/", pattern: "/about", rem: "/"},
+		{path: "/", pattern: "/", rem: "/"},
+	}
+
+	for _, expected := range matches {
+		match, ok := tree.Match(expected.path)
+		if !ok || match.Pattern != expected.pattern || match.Rem != expected.rem {
+			tests.Failed(t, "Should have matched %q with %q: %+v", expected.path, expected.pattern, match)
+		}
+
+		for name, value := range expected.params {
+			if match.Params[name] != value {
+				tests.Failed(t, "Should have matched %q with parameter %q=%q: %+v", expected.path, name, value, match.Params)
+			}
+		}
+
+		tests.Passed(t, "Should have matched %q with %q", expected.path, expected.pattern)
+	}
+
+	if _, ok := tree.Match("/about/team"); ok {
+		tests.Failed(t, "Should have failed to match %q", "/about/team")
+	}
+	tests.Passed(t, "Should have failed to match %q", "/about/team")
+
+	gets := map[string]string{
+		"/users/:name":             "/users/:id",
+		"users/*":                  "/users/*",
+		"/users/:a/posts/:b":       "/users/:id/posts/:post",
+		"/users/new":               "/users/new",
+		"/users/new/:id":           "",
+		"/users/:id/posts/:post/*": "",
+		"/about/team":              "",
+	}
+
+	for pattern, expected := range gets {
+		value, ok := tree.Get(pattern)
+		if ok != (expected != "") || (ok && value != expected) {
+			tests.Failed(t, "Should have found %q for pattern %q: %v", expected, pattern, value)
+		}
+	}
+	tests.Passed(t, "Should have found the patterns matching the same paths")
+}
+
+func TestRadixTreeInvalid(t *testing.T) {
+	tree := router.NewRadixTree()
+
+	if err := tree.Add("/files/*/edit", nil); err == nil {
+		tests.Failed(t, "Should have rejected wildcard before last segment")
+	}
+	tests.Passed(t, "Should have rejected wildcard before last segment")
+
+	if err := tree.Add("/users/:", nil); err == nil {
+		tests.Failed(t, "Should have rejected parameter without name")
+	}
+	tests.Passed(t, "Should have rejected parameter without name")
+
+	tree.Add("/users/:id", nil)
+	if err := tree.Add("/users/:name", nil); err == nil {
+		tests.Failed(t, "Should have rejected conflicting parameter pattern")
+	}
+	tests.Passed(t, "Should have rejected conflicting parameter pattern")
+}
+
+func TestRadixResolver(t *testing.T) {
+	home := router.NewRadix("/home/*")
+	rx := router.NewRadix("/:id")
+
+	home.Register(rx)
+
+	var id string
+	rx.Done(func(px router.PushEvent) {
+		id = px.Params["id"]
+	})
+
+	home.Resolve(router.UseLocation("/home/12"))
+
+	if id != "12" {
+		tests.Failed(t, "Should have resolved parameter :id => %q", id)
+	}
+	tests.Passed(t, "Should have resolved parameter :id => %q", id)
+
+	params, rem, ok := home.Test("/home/12/edit")
+	if !ok || rem != "/12/edit" || len(params) != 0 {
+		tests.Failed(t, "Should have matched with remaining path %q: %q", "/12/edit", rem)
+	}
+	tests.Passed(t, "Should have matched with remaining path %q", "/12/edit")
+}
+
+//==============================================================================
+
+const totalBenchRoutes = 300
+
+func benchPatterns() []string {
+	patterns := make([]string, 0, totalBenchRoutes)
+	for i := 0; i < totalBenchRoutes/3; i++ {
+		patterns = append(patterns,
+			fmt.Sprintf("/section%d/items", i),
+			fmt.Sprintf("/section%d/items/:id", i),
+			fmt.Sprintf("/section%d/items/:id/*", i),
+		)
+	}
+
+	return patterns
+}
+
+func BenchmarkResolverMatch(b *testing.B) {
+	var resolvers []router.Resolver
+	for _, pattern := range benchPatterns() {
+		resolvers = append(resolvers, router.New(pattern))
+	}
+
+	path := fmt.Sprintf("/section%d/items/42", totalBenchRoutes/3-1)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for _, resolver := range resolvers {
+			if _, _, ok := resolver.Test(path); ok {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkRadixTreeMatch(b *testing.B) {
+	tree := router.NewRadixTree()
+	for _, pattern := range benchPatterns() {
+		tree.Add(pattern, nil)
+	}
+
+	path := fmt.Sprintf("/section%d/items/42", totalBenchRoutes/3-1)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		tree.Match(path)
+	}
+}
+
+func BenchmarkResolverSingle(b *testing.B) {
+	resolver := router.New("/users/:id/posts/*")
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		resolver.Test("/users/12/posts/4/comments")
+	}
+}
+
+func BenchmarkRadixResolverSingle(b *testing.B) {
+	resolver := router.NewRadix("/users/:id/posts/*")
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		resolver.Test("/users/12/posts/4/comments")
+	}
+}
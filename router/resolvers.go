@@ -19,7 +19,10 @@ type Resolver interface {
 	Resolvable
 
 	Flush()
+	Owner() string
 	Pattern() string
+	Routes() Route
+	SetOwner(string) Resolver
 	Only(string, ...trees.SwitchMorpher) ResolveMorpher
 	Register(Resolver)
 	Done(Handler) Resolver
@@ -47,6 +50,7 @@ func New(path string) Resolver {
 
 // basicResolver defines a struct that implements
 type basicResolver struct {
+	owner    string
 	children []Resolver
 	fails    []Handler
 	subs     []Handler
@@ -62,9 +66,40 @@ func (b *basicResolver) Flush() {
 
 // Pattern returns the giving path pattern used by this resolver.
 func (b *basicResolver) Pattern() string {
+	if b.matcher == nil {
+		return ""
+	}
+
 	return b.matcher.Pattern()
 }
 
+// Owner returns the name of the owner of this resolver.
+func (b *basicResolver) Owner() string {
+	return b.owner
+}
+
+// SetOwner sets the name of the owner of this resolver, which is used to
+// identify the resolver within the route table.
+func (b *basicResolver) SetOwner(owner string) Resolver {
+	b.owner = owner
+	return b
+}
+
+// Routes returns the Route for this resolver containing the routes of all
+// resolvers registered below it.
+func (b *basicResolver) Routes() Route {
+	route := Route{
+		Pattern: b.Pattern(),
+		Owner:   b.owner,
+	}
+
+	for _, child := range b.children {
+		route.Routes = append(route.Routes, child.Routes())
+	}
+
+	return route
+}
+
 // Only returns a new instance of a ResolveMorpher which can change the rendering
 // state of the tree markup.
 func (b *basicResolver) Only(pattern string, m ...trees.SwitchMorpher) ResolveMorpher {
@@ -0,0 +1,562 @@
+package trees
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// BinaryVersion defines the version of the binary format written by the
+// BinaryEncoder.
+const BinaryVersion = 2
+
+// contains the kinds of messages written in the binary format.
+const (
+	NodeMessage byte = iota + 1
+	PatchMessage
+	CommandMessage
+)
+
+// contains errors returned when decoding the binary format.
+var (
+	ErrBinaryTruncated = errors.New("Binary data is truncated")
+	ErrBinaryInvalid   = errors.New("Binary data is invalid")
+	ErrBinaryVersion   = errors.New("Binary data version is not supported")
+)
+
+// maxBinaryDepth defines the deepest tree decoded from the binary format.
+const maxBinaryDepth = 512
+
+//==============================================================================
+
+// BinaryEncoder defines a encoder for the compact binary format used to send
+// trees, patch operations and render commands to drivers. Numbers are written
+// as varints, and strings are interned: the first occurrence of a string is
+// written with it's length while later ones only reference it.
+type BinaryEncoder struct {
+	buf     []byte
+	strings map[string]uint64
+}
+
+// NewBinaryEncoder returns a new BinaryEncoder which writes a message of the
+// provided kind.
+func NewBinaryEncoder(kind byte) *BinaryEncoder {
+	return &BinaryEncoder{
+		buf:     []byte{'g', BinaryVersion, kind},
+		strings: make(map[string]uint64),
+	}
+}
+
+// Bytes returns the encoded message.
+func (b *BinaryEncoder) Bytes() []byte {
+	return b.buf
+}
+
+// WriteUint writes the unsigned number.
+func (b *BinaryEncoder) WriteUint(n uint64) {
+	b.buf = binary.AppendUvarint(b.buf, n)
+}
+
+// WriteInt writes the signed number.
+func (b *BinaryEncoder) WriteInt(n int64) {
+	b.buf = binary.AppendVarint(b.buf, n)
+}
+
+// WriteBool writes the boolean.
+func (b *BinaryEncoder) WriteBool(state bool) {
+	if state {
+		b.buf = append(b.buf, 1)
+		return
+	}
+
+	b.buf = append(b.buf, 0)
+}
+
+// WriteString writes the string, referencing it if already written.
+func (b *BinaryEncoder) WriteString(s string) {
+	if ref, ok := b.strings[s]; ok {
+		b.WriteUint(ref + 1)
+		return
+	}
+
+	b.strings[s] = uint64(len(b.strings))
+	b.WriteUint(0)
+	b.WriteUint(uint64(len(s)))
+	b.buf = append(b.buf, s...)
+}
+
+// WriteStrings writes the list of strings, keeping nil and empty lists apart.
+func (b *BinaryEncoder) WriteStrings(list []string) {
+	if list == nil {
+		b.WriteUint(0)
+		return
+	}
+
+	b.WriteUint(uint64(len(list)) + 1)
+	for _, item := range list {
+		b.WriteString(item)
+	}
+}
+
+// WriteEvent writes the EventJSON.
+func (b *BinaryEncoder) WriteEvent(event EventJSON) {
+	b.WriteString(event.Event)
+	b.WriteString(event.EventName)
+	b.WriteString(event.EventSelector)
+	b.WriteString(event.ParentSelector)
+	b.WriteUint(flags(event.PreventDefault, event.StopPropagation, event.UseCapture, event.StopImmediatePropagation))
+}
+
+// WriteNode writes the NodeJSON and it's children.
+func (b *BinaryEncoder) WriteNode(node NodeJSON) {
+	b.WriteString(node.Tag)
+	b.WriteString(node.ID)
+	b.WriteString(node.UID)
+	b.WriteString(node.Hash)
+	b.WriteString(node.Key)
+	b.WriteString(node.Text)
+	b.WriteString(node.ScopeClass)
+	b.WriteUint(flags(node.Removed, node.AutoClose, node.NoChildren, node.NoAttributes, node.NoStyles, node.NoEvents, node.Stylesheet, node.Comment, node.Doctype))
+
+	b.writeProperties(node.Attrs)
+	b.writeProperties(node.Styles)
+
+	b.WriteLength(node.Events == nil, len(node.Events))
+	for _, event := range node.Events {
+		b.WriteEvent(event)
+	}
+
+	b.WriteLength(node.Children == nil, len(node.Children))
+	for _, child := range node.Children {
+		b.WriteNode(child)
+	}
+}
+
+// WritePatch writes the list of patch operations.
+func (b *BinaryEncoder) WritePatch(ops []PatchOp) {
+	b.WriteLength(ops == nil, len(ops))
+
+	for _, op := range ops {
+		b.WriteString(string(op.Type))
+		b.WriteString(op.UID)
+		b.WriteString(op.Parent)
+		b.WriteInt(int64(op.Index))
+		b.WriteInt(int64(op.From))
+		b.WriteString(op.Name)
+		b.WriteString(op.Value)
+		b.WriteString(op.Markup)
+
+		b.WriteBool(op.Event != nil)
+		if op.Event != nil {
+			b.WriteEvent(*op.Event)
+		}
+	}
+}
+
+// WriteMarkupJSON writes the MarkupJSON.
+func (b *BinaryEncoder) WriteMarkupJSON(markup MarkupJSON) {
+	b.WriteString(markup.TreeID)
+	b.WriteString(markup.Markup)
+
+	b.WriteLength(markup.Events == nil, len(markup.Events))
+	for _, event := range markup.Events {
+		b.WriteEvent(event)
+	}
+}
+
+// writeProperties writes the list of PropertyJSON.
+func (b *BinaryEncoder) writeProperties(properties []PropertyJSON) {
+	b.WriteLength(properties == nil, len(properties))
+
+	for _, property := range properties {
+		b.WriteString(property.Name)
+		b.WriteString(property.Value)
+		b.WriteStrings(property.Classes)
+	}
+}
+
+// WriteLength writes the length of a list, keeping nil and empty lists apart.
+func (b *BinaryEncoder) WriteLength(isNil bool, length int) {
+	if isNil {
+		b.WriteUint(0)
+		return
+	}
+
+	b.WriteUint(uint64(length) + 1)
+}
+
+// flags returns the provided booleans as bits of a number.
+func flags(states ...bool) uint64 {
+	var bits uint64
+	for index, state := range states {
+		if state {
+			bits |= 1 << uint(index)
+		}
+	}
+
+	return bits
+}
+
+//==============================================================================
+
+// BinaryDecoder defines a decoder for the binary format written by the
+// BinaryEncoder. The first error met while decoding stops all further reads
+// and is returned by Err.
+type BinaryDecoder struct {
+	data    []byte
+	pos     int
+	depth   int
+	strings []string
+	err     error
+}
+
+// NewBinaryDecoder returns a new BinaryDecoder for the provided data, which
+// must be a message of the provided kind.
+func NewBinaryDecoder(data []byte, kind byte) *BinaryDecoder {
+	d := &BinaryDecoder{data: data}
+
+	switch {
+	case len(data) < 3:
+		d.err = ErrBinaryTruncated
+	case data[0] != 'g' || data[2] != kind:
+		d.err = ErrBinaryInvalid
+	case data[1] != BinaryVersion:
+		d.err = ErrBinaryVersion
+	}
+
+	d.pos = 3
+	return d
+}
+
+// Err returns the first error met while decoding.
+func (d *BinaryDecoder) Err() error {
+	return d.err
+}
+
+// Done returns the first error met while decoding, or ErrBinaryInvalid if
+// data remains after the decoded values.
+func (d *BinaryDecoder) Done() error {
+	if d.err == nil && d.pos != len(d.data) {
+		d.err = ErrBinaryInvalid
+	}
+
+	return d.err
+}
+
+// ReadUint reads a unsigned number.
+func (d *BinaryDecoder) ReadUint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	n, size := binary.Uvarint(d.data[d.pos:])
+	if size <= 0 {
+		d.fail(size)
+		return 0
+	}
+
+	d.pos += size
+	return n
+}
+
+// ReadInt reads a signed number.
+func (d *BinaryDecoder) ReadInt() int64 {
+	if d.err != nil {
+		return 0
+	}
+
+	n, size := binary.Varint(d.data[d.pos:])
+	if size <= 0 {
+		d.fail(size)
+		return 0
+	}
+
+	d.pos += size
+	return n
+}
+
+// ReadBool reads a boolean.
+func (d *BinaryDecoder) ReadBool() bool {
+	switch d.ReadUint() {
+	case 0:
+		return false
+	case 1:
+		return true
+	}
+
+	d.setErr(ErrBinaryInvalid)
+	return false
+}
+
+// ReadString reads a string.
+func (d *BinaryDecoder) ReadString() string {
+	ref := d.ReadUint()
+	if d.err != nil {
+		return ""
+	}
+
+	if ref != 0 {
+		if ref > uint64(len(d.strings)) {
+			d.setErr(ErrBinaryInvalid)
+			return ""
+		}
+
+		return d.strings[ref-1]
+	}
+
+	length := d.ReadUint()
+	if d.err != nil {
+		return ""
+	}
+
+	if length > uint64(len(d.data)-d.pos) {
+		d.setErr(ErrBinaryTruncated)
+		return ""
+	}
+
+	s := string(d.data[d.pos : d.pos+int(length)])
+	d.pos += int(length)
+	d.strings = append(d.strings, s)
+
+	return s
+}
+
+// ReadStrings reads a list of strings.
+func (d *BinaryDecoder) ReadStrings() []string {
+	length, isNil := d.ReadLength()
+	if isNil {
+		return nil
+	}
+
+	list := make([]string, 0, length)
+	for i := 0; i < length && d.err == nil; i++ {
+		list = append(list, d.ReadString())
+	}
+
+	return list
+}
+
+// ReadEvent reads a EventJSON.
+func (d *BinaryDecoder) ReadEvent() EventJSON {
+	var event EventJSON
+	event.Event = d.ReadString()
+	event.EventName = d.ReadString()
+	event.EventSelector = d.ReadString()
+	event.ParentSelector = d.ReadString()
+
+	bits := d.readFlags(4)
+	event.PreventDefault = bits[0]
+	event.StopPropagation = bits[1]
+	event.UseCapture = bits[2]
+	event.StopImmediatePropagation = bits[3]
+
+	return event
+}
+
+// ReadNode reads a NodeJSON and it's children.
+func (d *BinaryDecoder) ReadNode() NodeJSON {
+	var node NodeJSON
+
+	d.depth++
+	defer func() { d.depth-- }()
+
+	if d.depth > maxBinaryDepth {
+		d.setErr(ErrBinaryInvalid)
+		return node
+	}
+
+	node.Tag = d.ReadString()
+	node.ID = d.ReadString()
+	node.UID = d.ReadString()
+	node.Hash = d.ReadString()
+	node.Key = d.ReadString()
+	node.Text = d.ReadString()
+	node.ScopeClass = d.ReadString()
+
+	bits := d.readFlags(9)
+	node.Removed = bits[0]
+	node.AutoClose = bits[1]
+	node.NoChildren = bits[2]
+	node.NoAttributes = bits[3]
+	node.NoStyles = bits[4]
+	node.NoEvents = bits[5]
+	node.Stylesheet = bits[6]
+	node.Comment = bits[7]
+	node.Doctype = bits[8]
+
+	node.Attrs = d.readProperties()
+	node.Styles = d.readProperties()
+
+	if length, isNil := d.ReadLength(); !isNil {
+		node.Events = make([]EventJSON, 0, length)
+		for i := 0; i < length && d.err == nil; i++ {
+			node.Events = append(node.Events, d.ReadEvent())
+		}
+	}
+
+	if length, isNil := d.ReadLength(); !isNil {
+		node.Children = make([]NodeJSON, 0, length)
+		for i := 0; i < length && d.err == nil; i++ {
+			node.Children = append(node.Children, d.ReadNode())
+		}
+	}
+
+	return node
+}
+
+// ReadPatch reads a list of patch operations.
+func (d *BinaryDecoder) ReadPatch() []PatchOp {
+	length, isNil := d.ReadLength()
+	if isNil {
+		return nil
+	}
+
+	ops := make([]PatchOp, 0, length)
+	for i := 0; i < length && d.err == nil; i++ {
+		var op PatchOp
+		op.Type = PatchOpType(d.ReadString())
+		op.UID = d.ReadString()
+		op.Parent = d.ReadString()
+		op.Index = int(d.ReadInt())
+		op.From = int(d.ReadInt())
+		op.Name = d.ReadString()
+		op.Value = d.ReadString()
+		op.Markup = d.ReadString()
+
+		if d.ReadBool() {
+			event := d.ReadEvent()
+			op.Event = &event
+		}
+
+		ops = append(ops, op)
+	}
+
+	return ops
+}
+
+// ReadMarkupJSON reads a MarkupJSON.
+func (d *BinaryDecoder) ReadMarkupJSON() MarkupJSON {
+	var markup MarkupJSON
+	markup.TreeID = d.ReadString()
+	markup.Markup = d.ReadString()
+
+	if length, isNil := d.ReadLength(); !isNil {
+		markup.Events = make([]EventJSON, 0, length)
+		for i := 0; i < length && d.err == nil; i++ {
+			markup.Events = append(markup.Events, d.ReadEvent())
+		}
+	}
+
+	return markup
+}
+
+// readProperties reads a list of PropertyJSON.
+func (d *BinaryDecoder) readProperties() []PropertyJSON {
+	length, isNil := d.ReadLength()
+	if isNil {
+		return nil
+	}
+
+	properties := make([]PropertyJSON, 0, length)
+	for i := 0; i < length && d.err == nil; i++ {
+		var property PropertyJSON
+		property.Name = d.ReadString()
+		property.Value = d.ReadString()
+		property.Classes = d.ReadStrings()
+
+		properties = append(properties, property)
+	}
+
+	return properties
+}
+
+// ReadLength reads the length of a list, returning true if the list is nil.
+// Lengths longer than the remaining data are rejected, as every item takes at
+// least a byte.
+func (d *BinaryDecoder) ReadLength() (int, bool) {
+	length := d.ReadUint()
+	if d.err != nil || length == 0 {
+		return 0, true
+	}
+
+	if length-1 > uint64(len(d.data)-d.pos) {
+		d.setErr(ErrBinaryTruncated)
+		return 0, true
+	}
+
+	return int(length - 1), false
+}
+
+// readFlags reads the provided total of booleans stored as bits of a number.
+func (d *BinaryDecoder) readFlags(total int) []bool {
+	bits := d.ReadUint()
+	if bits >= 1<<uint(total) {
+		d.setErr(ErrBinaryInvalid)
+	}
+
+	states := make([]bool, total)
+	for index := range states {
+		states[index] = bits&(1<<uint(index)) != 0
+	}
+
+	return states
+}
+
+// fail records the error for a failed varint read of the provided size.
+func (d *BinaryDecoder) fail(size int) {
+	if size == 0 {
+		d.setErr(ErrBinaryTruncated)
+		return
+	}
+
+	d.setErr(ErrBinaryInvalid)
+}
+
+// setErr records the error if none was recorded.
+func (d *BinaryDecoder) setErr(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+//==============================================================================
+
+// EncodeNodeBinary returns the binary encoding of the structural form of the
+// markup.
+func EncodeNodeBinary(e *Markup) []byte {
+	encoder := NewBinaryEncoder(NodeMessage)
+	encoder.WriteNode(e.NodeJSON())
+	return encoder.Bytes()
+}
+
+// DecodeNodeBinary returns the markup from the binary encoding of it's structural
+// form as returned by EncodeNodeBinary.
+func DecodeNodeBinary(data []byte) (*Markup, error) {
+	decoder := NewBinaryDecoder(data, NodeMessage)
+	node := decoder.ReadNode()
+
+	if err := decoder.Done(); err != nil {
+		return nil, err
+	}
+
+	return node.Markup(), nil
+}
+
+// EncodePatchBinary returns the binary encoding of the patch operations.
+func EncodePatchBinary(ops []PatchOp) []byte {
+	encoder := NewBinaryEncoder(PatchMessage)
+	encoder.WritePatch(ops)
+	return encoder.Bytes()
+}
+
+// DecodePatchBinary returns the patch operations from their binary encoding
+// as returned by EncodePatchBinary.
+func DecodePatchBinary(data []byte) ([]PatchOp, error) {
+	decoder := NewBinaryDecoder(data, PatchMessage)
+	ops := decoder.ReadPatch()
+
+	if err := decoder.Done(); err != nil {
+		return nil, err
+	}
+
+	return ops, nil
+}
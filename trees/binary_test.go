@@ -0,0 +1,342 @@
+package trees_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/css"
+)
+
+// fuzzSource produces values from the bytes provided by the fuzzer, returning
+// zero values once the bytes run out.
+type fuzzSource struct {
+	data []byte
+}
+
+// pool holds strings repeated across generated values to exercise interning.
+var pool = []string{"", "div", "class", "click", "#uid", "box wide", "\x00\xff", "ünïcödé"}
+
+func (f *fuzzSource) byte() byte {
+	if len(f.data) == 0 {
+		return 0
+	}
+
+	b := f.data[0]
+	f.data = f.data[1:]
+	return b
+}
+
+func (f *fuzzSource) bool() bool {
+	return f.byte()&1 == 1
+}
+
+func (f *fuzzSource) int() int {
+	return int(int8(f.byte())) * int(f.byte())
+}
+
+func (f *fuzzSource) string() string {
+	b := f.byte()
+	if b < 192 {
+		return pool[int(b)%len(pool)]
+	}
+
+	size := int(b-192) % 16
+	if size > len(f.data) {
+		size = len(f.data)
+	}
+
+	s := string(f.data[:size])
+	f.data = f.data[size:]
+	return s
+}
+
+func (f *fuzzSource) length() (int, bool) {
+	b := f.byte()
+	if b%5 == 0 {
+		return 0, true
+	}
+
+	return int(b % 4), false
+}
+
+func (f *fuzzSource) event() trees.EventJSON {
+	return trees.EventJSON{
+		Event:                    f.string(),
+		EventName:                f.string(),
+		EventSelector:            f.string(),
+		ParentSelector:           f.string(),
+		PreventDefault:           f.bool(),
+		StopPropagation:          f.bool(),
+		UseCapture:               f.bool(),
+		StopImmediatePropagation: f.bool(),
+	}
+}
+
+func (f *fuzzSource) properties() []trees.PropertyJSON {
+	length, isNil := f.length()
+	if isNil {
+		return nil
+	}
+
+	properties := make([]trees.PropertyJSON, 0)
+	for i := 0; i < length; i++ {
+		property := trees.PropertyJSON{Name: f.string(), Value: f.string()}
+		if count, isNil := f.length(); !isNil {
+			property.Classes = make([]string, 0)
+			for j := 0; j < count; j++ {
+				property.Classes = append(property.Classes, f.string())
+			}
+		}
+
+		properties = append(properties, property)
+	}
+
+	return properties
+}
+
+func (f *fuzzSource) node(depth int) trees.NodeJSON {
+	node := trees.NodeJSON{
+		Tag:          f.string(),
+		ID:           f.string(),
+		UID:          f.string(),
+		Hash:         f.string(),
+		Key:          f.string(),
+		Text:         f.string(),
+		Removed:      f.bool(),
+		AutoClose:    f.bool(),
+		NoChildren:   f.bool(),
+		NoAttributes: f.bool(),
+		NoStyles:     f.bool(),
+		NoEvents:     f.bool(),
+		Stylesheet:   f.bool(),
+		Comment:      f.bool(),
+		Doctype:      f.bool(),
+		Attrs:        f.properties(),
+		Styles:       f.properties(),
+	}
+
+	if length, isNil := f.length(); !isNil {
+		node.Events = make([]trees.EventJSON, 0)
+		for i := 0; i < length; i++ {
+			node.Events = append(node.Events, f.event())
+		}
+	}
+
+	if length, isNil := f.length(); !isNil && depth < 4 {
+		node.Children = make([]trees.NodeJSON, 0)
+		for i := 0; i < length; i++ {
+			node.Children = append(node.Children, f.node(depth+1))
+		}
+	}
+
+	return node
+}
+
+func (f *fuzzSource) patch() []trees.PatchOp {
+	length, isNil := f.length()
+	if isNil {
+		return nil
+	}
+
+	ops := make([]trees.PatchOp, 0)
+	for i := 0; i < length*3; i++ {
+		op := trees.PatchOp{
+			Type:   trees.PatchOpType(f.string()),
+			UID:    f.string(),
+			Parent: f.string(),
+			Index:  f.int(),
+			From:   f.int(),
+			Name:   f.string(),
+			Value:  f.string(),
+			Markup: f.string(),
+		}
+
+		if f.bool() {
+			event := f.event()
+			op.Event = &event
+		}
+
+		ops = append(ops, op)
+	}
+
+	return ops
+}
+
+// seeds returns the seed corpus shared by the fuzz tests.
+func seeds() [][]byte {
+	return [][]byte{
+		nil,
+		[]byte("gu"),
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		bytes.Repeat([]byte{0x11, 0xc5, 'a', 'b', 'c', 'd', 'e', 3}, 24),
+		bytes.Repeat([]byte{0xff, 0x01, 0x42, 0x07}, 64),
+	}
+}
+
+// FuzzNodeBinary validates that nodes round trip through the binary encoding
+// with the same content as their JSON encoding.
+func FuzzNodeBinary(f *testing.F) {
+	for _, seed := range seeds() {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		node := (&fuzzSource{data: data}).node(0)
+
+		encoder := trees.NewBinaryEncoder(trees.NodeMessage)
+		encoder.WriteNode(node)
+
+		decoder := trees.NewBinaryDecoder(encoder.Bytes(), trees.NodeMessage)
+		decoded := decoder.ReadNode()
+
+		if err := decoder.Done(); err != nil {
+			t.Fatalf("\t%s\t  Should have decoded node from binary: %+q", failed, err)
+		}
+
+		if !reflect.DeepEqual(node, decoded) {
+			t.Fatalf("\t%s\t  Should have decoded node equal to encoded node: %+v != %+v", failed, node, decoded)
+		}
+
+		expected, _ := json.Marshal(node)
+		actual, _ := json.Marshal(decoded)
+		if !bytes.Equal(expected, actual) {
+			t.Fatalf("\t%s\t  Should have matched JSON encoding: %s != %s", failed, expected, actual)
+		}
+	})
+}
+
+// FuzzPatchBinary validates that patch operations round trip through the
+// binary encoding with the same content as their JSON encoding.
+func FuzzPatchBinary(f *testing.F) {
+	for _, seed := range seeds() {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		ops := (&fuzzSource{data: data}).patch()
+
+		decoded, err := trees.DecodePatchBinary(trees.EncodePatchBinary(ops))
+		if err != nil {
+			t.Fatalf("\t%s\t  Should have decoded patch from binary: %+q", failed, err)
+		}
+
+		if !reflect.DeepEqual(ops, decoded) {
+			t.Fatalf("\t%s\t  Should have decoded patch equal to encoded patch: %+v != %+v", failed, ops, decoded)
+		}
+
+		expected, _ := json.Marshal(ops)
+		actual, _ := json.Marshal(decoded)
+		if !bytes.Equal(expected, actual) {
+			t.Fatalf("\t%s\t  Should have matched JSON encoding: %s != %s", failed, expected, actual)
+		}
+	})
+}
+
+// FuzzBinaryDecode validates that malformed data fails decoding with a error
+// and never panics.
+func FuzzBinaryDecode(f *testing.F) {
+	for _, seed := range seeds() {
+		f.Add(seed)
+	}
+
+	f.Add(trees.EncodeNodeBinary(keyedList("a", "b")))
+	f.Add(trees.EncodeNodeBinary(trees.NodeJSON{Tag: "text", Text: "x", Comment: true}.Markup()))
+	f.Add(trees.EncodeNodeBinary(trees.NodeJSON{Tag: "text", Text: "x", Doctype: true}.Markup()))
+	f.Add(trees.EncodePatchBinary(trees.Diff(keyedList("a", "b"), keyedList("b", "c"))))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		node, err := trees.DecodeNodeBinary(data)
+		if err == nil && node == nil {
+			t.Fatalf("\t%s\t  Should have returned node for decoded data", failed)
+		}
+
+		if err == nil {
+			node.HTML()
+			trees.CompactPrinter.Print(node)
+		}
+
+		if ops, err := trees.DecodePatchBinary(data); err != nil && ops != nil {
+			t.Fatalf("\t%s\t  Should have returned no patch for malformed data", failed)
+		}
+	})
+}
+
+// TestNodeBinary validates the binary encoding of markup against it's JSON
+// encoding.
+func TestNodeBinary(t *testing.T) {
+	root := keyedList("a", "b", "c", "d")
+	trees.NewEvent("click", "", false, false, false, false).Apply(root)
+	trees.NewAttr("class", "list items").Apply(root)
+	trees.NewComment(" items ").Apply(root)
+
+	data := trees.EncodeNodeBinary(root)
+
+	decoded, err := trees.DecodeNodeBinary(data)
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have decoded markup from binary: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have decoded markup from binary", success)
+
+	expected, _ := trees.EncodeNode(root)
+	actual, _ := trees.EncodeNode(decoded)
+	if !bytes.Equal(expected, actual) {
+		t.Fatalf("\t%s\t  Should have matched JSON encoding: %s != %s", failed, expected, actual)
+	}
+	t.Logf("\t%s\t  Should have matched JSON encoding", success)
+
+	if children := decoded.Children(); !children[len(children)-1].IsComment() {
+		t.Fatalf("\t%s\t  Should have kept the comment", failed)
+	}
+	t.Logf("\t%s\t  Should have kept the comment", success)
+
+	if len(data) >= len(expected) {
+		t.Fatalf("\t%s\t  Should have encoded smaller than JSON: %d >= %d", failed, len(data), len(expected))
+	}
+	t.Logf("\t%s\t  Should have encoded smaller than JSON: %d < %d", success, len(data), len(expected))
+
+	if _, err := trees.DecodeNodeBinary(data[:len(data)-1]); err == nil {
+		t.Fatalf("\t%s\t  Should have failed decoding truncated data", failed)
+	}
+	t.Logf("\t%s\t  Should have failed decoding truncated data", success)
+
+	if _, err := trees.DecodePatchBinary(data); err != trees.ErrBinaryInvalid {
+		t.Fatalf("\t%s\t  Should have failed decoding node as patch: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have failed decoding node as patch", success)
+}
+
+// TestNodeBinaryStylesheet validates stylesheets remain stylesheets after the
+// binary encoding round trip.
+func TestNodeBinaryStylesheet(t *testing.T) {
+	root := stylesheetMarkup()
+
+	decoded, err := trees.DecodeNodeBinary(trees.EncodeNodeBinary(root))
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have decoded markup from binary: %+q", failed, err)
+	}
+
+	if !decoded.Children()[0].IsStylesheet() || decoded.Children()[1].IsStylesheet() {
+		t.Fatalf("\t%s\t  Should have kept the stylesheet state", failed)
+	}
+
+	if sheets := trees.ExtractStylesheets(decoded); len(sheets) != 1 || len(decoded.Children()) != 1 {
+		t.Fatalf("\t%s\t  Should have extracted the decoded stylesheet: %+q", failed, sheets)
+	}
+	t.Logf("\t%s\t  Should have kept the stylesheet state", success)
+
+	scoped := trees.NewMarkup("div", false)
+	sheet := trees.ScopedCSSStylesheet(css.New(`& span { color: red; }`), scoped)
+	sheet.Apply(scoped)
+
+	if decoded, err = trees.DecodeNodeBinary(trees.EncodeNodeBinary(scoped)); err != nil {
+		t.Fatalf("\t%s\t  Should have decoded markup from binary: %+q", failed, err)
+	}
+
+	if class := decoded.Children()[0].ScopeClass(); class == "" || class != sheet.ScopeClass() {
+		t.Fatalf("\t%s\t  Should have kept the scope class: %q", failed, class)
+	}
+	t.Logf("\t%s\t  Should have kept the scope class", success)
+}
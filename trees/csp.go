@@ -0,0 +1,120 @@
+package trees
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// NewNonce returns a new random nonce for use within a Content-Security-Policy
+// and the nonce attribute of inline scripts and styles. A new nonce should be
+// used for every render.
+func NewNonce() (string, error) {
+	data := make([]byte, 18)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// WithNonce returns a copy of the ElementWriter which sets the nonce
+// attribute of every inline script and style it writes.
+func (m *ElementWriter) WithNonce(nonce string) *ElementWriter {
+	writer := *m
+	writer.nonce = nonce
+	return &writer
+}
+
+// ApplyNonce sets the nonce attribute of every inline script and style within
+// the markup lacking one, for markup which is not written by a ElementWriter
+// or Printer, such as that sent as json to browser drivers. The nonce is also
+// set on stylesheets, such as those of CSSStylesheet, which take no other
+// attributes.
+func ApplyNonce(root *Markup, nonce string) {
+	Walk(root, func(e *Markup) WalkAction {
+		if needsNonce(e, nonce) {
+			e.attrs = append(e.attrs, NewAttr("nonce", nonce))
+		}
+
+		return WalkContinue
+	})
+}
+
+// InlineHashes returns the Content-Security-Policy hashes, such as
+// "'sha256-...'", of the content of the inline scripts and styles within the
+// markup as written by the SimpleElementWriter.
+func InlineHashes(root *Markup) (scripts []string, styles []string) {
+	Walk(root, func(e *Markup) WalkAction {
+		if e.Removed() && GetMode() > Normal {
+			return WalkSkip
+		}
+
+		if !isInline(e) {
+			return WalkContinue
+		}
+
+		sum := sha256.Sum256([]byte(inlineContent(e)))
+		hash := "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+
+		if strings.EqualFold(e.tagname, "script") {
+			scripts = appendUnique(scripts, hash)
+		} else {
+			styles = appendUnique(styles, hash)
+		}
+
+		return WalkSkip
+	})
+
+	return scripts, styles
+}
+
+// isInline returns true/false if the markup is a style or a script without a
+// src attribute, whose content is inlined within the page.
+func isInline(e *Markup) bool {
+	switch strings.ToLower(e.tagname) {
+	case "style":
+		return true
+	case "script":
+		_, err := GetAttr(e, "src")
+		return err != nil
+	}
+
+	return false
+}
+
+// needsNonce returns true/false if the nonce attribute should be added to the
+// markup.
+func needsNonce(e *Markup, nonce string) bool {
+	if nonce == "" || !isInline(e) {
+		return false
+	}
+
+	_, err := GetAttr(e, "nonce")
+	return err != nil
+}
+
+// inlineContent returns the content of the inline script or style as written
+// by the SimpleElementWriter.
+func inlineContent(e *Markup) string {
+	var out strings.Builder
+	out.WriteString(escapeRawText(e.TextContent()))
+
+	for _, child := range e.children {
+		SimpleElementWriter.write(&out, child, 1, nil)
+	}
+
+	return out.String()
+}
+
+// appendUnique appends the item to the list if not yet contained.
+func appendUnique(list []string, item string) []string {
+	for _, existing := range list {
+		if existing == item {
+			return list
+		}
+	}
+
+	return append(list, item)
+}
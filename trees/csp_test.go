@@ -0,0 +1,101 @@
+package trees_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/css"
+)
+
+// cspDocument returns a tree holding inline and external scripts and styles.
+func cspDocument() *trees.Markup {
+	root := trees.NewMarkup("div", false)
+
+	style := trees.NewMarkup("style", false)
+	trees.NewText("div { color: red; }").Apply(style)
+	style.Apply(root)
+
+	script := trees.NewMarkup("script", false)
+	trees.NewText("console.log('</script>')").Apply(script)
+	script.Apply(root)
+
+	external := trees.NewMarkup("script", false)
+	trees.NewAttr("src", "/app.js").Apply(external)
+	external.Apply(root)
+
+	trees.CSSStylesheet(css.New(`& span { color: red; }`), nil).Apply(root)
+
+	return root
+}
+
+// TestNonce validates the nonce is set on inline scripts and styles only.
+func TestNonce(t *testing.T) {
+	nonce, err := trees.NewNonce()
+	if err != nil || nonce == "" {
+		t.Fatalf("\t%s\t  Should have generated a nonce: %+q", failed, err)
+	}
+
+	if other, _ := trees.NewNonce(); other == nonce {
+		t.Fatalf("\t%s\t  Should have generated a different nonce per call", failed)
+	}
+	t.Logf("\t%s\t  Should have generated a nonce", success)
+
+	applied := cspDocument()
+	trees.ApplyNonce(applied, nonce)
+
+	outputs := map[string]string{
+		"applied":  trees.SimpleElementWriter.Print(applied),
+		"writer":   trees.SimpleElementWriter.WithNonce(nonce).Print(cspDocument()),
+		"compact":  trees.Printer{Format: trees.CompactFormat, Nonce: nonce}.Print(cspDocument()),
+		"minified": trees.Printer{Format: trees.MinifiedFormat, Nonce: nonce}.Print(cspDocument()),
+	}
+
+	for name, output := range outputs {
+		parsed, err := trees.ParseTreeErr(output)
+		if err != nil || len(parsed) != 1 {
+			t.Fatalf("\t%s\t  Should have printed well-formed %s markup: %+q\n%s", failed, name, err, output)
+		}
+
+		for _, item := range trees.Query.QueryAll(parsed[0], "style, script") {
+			value, _ := attrOf(item, "nonce")
+			_, external := attrOf(item, "src")
+
+			if external && value != "" {
+				t.Fatalf("\t%s\t  Should have left external scripts without a nonce in %s markup:\n%s", failed, name, output)
+			}
+
+			if !external && value != nonce {
+				t.Fatalf("\t%s\t  Should have set the nonce on inline %s in %s markup:\n%s", failed, item.Name(), name, output)
+			}
+		}
+	}
+	t.Logf("\t%s\t  Should have set the nonce on inline scripts and styles", success)
+
+	if output := trees.SimpleElementWriter.Print(cspDocument()); strings.Contains(output, "nonce") {
+		t.Fatalf("\t%s\t  Should have written no nonce without one: %s", failed, output)
+	}
+	t.Logf("\t%s\t  Should have written no nonce without one", success)
+}
+
+// TestInlineHashes validates the hashes match the written inline content.
+func TestInlineHashes(t *testing.T) {
+	hash := func(content string) string {
+		sum := sha256.Sum256([]byte(content))
+		return "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+	}
+
+	scripts, styles := trees.InlineHashes(cspDocument())
+
+	if len(scripts) != 1 || scripts[0] != hash(`console.log('<\/script>')`) {
+		t.Fatalf("\t%s\t  Should have hashed the written inline script: %+q", failed, scripts)
+	}
+	t.Logf("\t%s\t  Should have hashed the written inline script", success)
+
+	if len(styles) != 2 || styles[0] != hash("div { color: red; }") {
+		t.Fatalf("\t%s\t  Should have hashed the inline styles: %+q", failed, styles)
+	}
+	t.Logf("\t%s\t  Should have hashed the inline styles", success)
+}
@@ -0,0 +1,287 @@
+package css
+
+import (
+	"strings"
+
+	bcss "github.com/aymerick/douceur/css"
+)
+
+// Item defines a part of a stylesheet built in Go with Build, such as a
+// Declaration, a selector block or an at-rule.
+type Item interface {
+	// build returns the declarations the item adds to the enclosing block and
+	// the rules it adds after it, where parents are the selectors of the
+	// enclosing block and level is the nesting level of the rules.
+	build(parents []string, level int) ([]*bcss.Declaration, []*bcss.Rule)
+}
+
+// Declaration defines a single css property with it's value, returned by the
+// generated property functions such as Color and Margin or by Prop.
+type Declaration struct {
+	Property  string
+	Value     string
+	important bool
+}
+
+// Prop returns a Declaration for the property, used for properties without a
+// generated function such as custom properties.
+func Prop(property string, value string) Declaration {
+	return Declaration{Property: property, Value: value}
+}
+
+// Important returns a copy of the Declaration marked as !important.
+func (d Declaration) Important() Declaration {
+	d.important = true
+	return d
+}
+
+// build returns the declaration.
+func (d Declaration) build(parents []string, level int) ([]*bcss.Declaration, []*bcss.Rule) {
+	return []*bcss.Declaration{{
+		Property:  strings.TrimSpace(d.Property),
+		Value:     strings.TrimSpace(d.Value),
+		Important: d.important,
+	}}, nil
+}
+
+//==============================================================================
+
+// Build returns a new Rule built from the provided items rather than a
+// template, producing the same stylesheets with the same scoping. Selectors
+// of nested blocks are resolved against their parents, where "&" refers to
+// the parent selector and selectors without "&" are descendants of it, while
+// "&" within top level selectors refers to the element the stylesheet is
+// scoped to. Declarations outside of a block apply to that element.
+//
+//	css.Build(
+//		css.Select("&", css.Color("red"),
+//			css.Select("&:hover", css.Color("blue")),
+//			css.Select("span", css.FontWeight("bold")),
+//		),
+//		css.Media("(max-width: 400px)",
+//			css.Display("none"),
+//		),
+//	)
+func Build(items ...Item) *Rule {
+	rule := &Rule{items: items}
+
+	var sheet bcss.Stylesheet
+	sheet.Rules = rule.buildRules()
+	rule.source = sheet.String()
+
+	return rule
+}
+
+// buildRules returns the rules built from the items of the rule.
+func (r *Rule) buildRules() []*bcss.Rule {
+	return buildBlock([]string{"&"}, nil, 0, r.items)
+}
+
+// block defines a selector block.
+type block struct {
+	selectors []string
+	items     []Item
+}
+
+// Select returns a block of the items for the selectors, which can be
+// separated by commas.
+func Select(selector string, items ...Item) Item {
+	return block{selectors: splitSelectors(selector), items: items}
+}
+
+// build returns the rules of the block.
+func (b block) build(parents []string, level int) ([]*bcss.Declaration, []*bcss.Rule) {
+	selectors := resolveSelectors(parents, b.selectors)
+	return nil, buildBlock(selectors, selectors, level, b.items)
+}
+
+// atRule defines an at-rule holding rules, such as @media and @supports.
+type atRule struct {
+	name    string
+	prelude string
+	items   []Item
+}
+
+// Media returns a @media rule for the query holding the items, which apply to
+// the enclosing block.
+func Media(query string, items ...Item) Item {
+	return atRule{name: "@media", prelude: query, items: items}
+}
+
+// Supports returns a @supports rule for the condition holding the items,
+// which apply to the enclosing block.
+func Supports(condition string, items ...Item) Item {
+	return atRule{name: "@supports", prelude: condition, items: items}
+}
+
+// build returns the at-rule with the rules of it's items.
+func (a atRule) build(parents []string, level int) ([]*bcss.Declaration, []*bcss.Rule) {
+	selectors := parents
+	if selectors == nil {
+		selectors = []string{"&"}
+	}
+
+	rule := bcss.NewRule(bcss.AtRule)
+	rule.Name = a.name
+	rule.Prelude = strings.TrimSpace(a.prelude)
+	rule.EmbedLevel = level
+	rule.Rules = buildBlock(selectors, parents, level+1, a.items)
+
+	return nil, []*bcss.Rule{rule}
+}
+
+// keyframes defines a @keyframes rule.
+type keyframes struct {
+	name   string
+	frames []Item
+}
+
+// Keyframes returns a @keyframes rule of the name, whose frames are given with
+// Select, such as css.Select("from", css.Opacity("0")). The name is scoped
+// like the names of @keyframes within templates.
+func Keyframes(name string, frames ...Item) Item {
+	return keyframes{name: name, frames: frames}
+}
+
+// build returns the @keyframes rule.
+func (k keyframes) build(parents []string, level int) ([]*bcss.Declaration, []*bcss.Rule) {
+	rule := bcss.NewRule(bcss.AtRule)
+	rule.Name = "@keyframes"
+	rule.Prelude = strings.TrimSpace(k.name)
+	rule.EmbedLevel = level
+
+	for _, frame := range k.frames {
+		_, rules := frame.build(nil, level+1)
+		rule.Rules = append(rule.Rules, rules...)
+	}
+
+	return nil, []*bcss.Rule{rule}
+}
+
+// declarationsRule defines an at-rule holding declarations, such as
+// @font-face.
+type declarationsRule struct {
+	name         string
+	declarations []Declaration
+}
+
+// FontFace returns a @font-face rule holding the declarations.
+func FontFace(declarations ...Declaration) Item {
+	return declarationsRule{name: "@font-face", declarations: declarations}
+}
+
+// build returns the at-rule.
+func (d declarationsRule) build(parents []string, level int) ([]*bcss.Declaration, []*bcss.Rule) {
+	rule := bcss.NewRule(bcss.AtRule)
+	rule.Name = d.name
+	rule.EmbedLevel = level
+
+	for _, declaration := range d.declarations {
+		declarations, _ := declaration.build(nil, level)
+		rule.Declarations = append(rule.Declarations, declarations...)
+	}
+
+	return nil, []*bcss.Rule{rule}
+}
+
+// importRule defines a @import rule.
+type importRule struct {
+	url string
+}
+
+// Import returns a @import rule of the stylesheet at the url.
+func Import(url string) Item {
+	return importRule{url: url}
+}
+
+// build returns the @import rule.
+func (i importRule) build(parents []string, level int) ([]*bcss.Declaration, []*bcss.Rule) {
+	rule := bcss.NewRule(bcss.AtRule)
+	rule.Name = "@import"
+	rule.Prelude = `url("` + i.url + `")`
+	rule.EmbedLevel = level
+
+	return nil, []*bcss.Rule{rule}
+}
+
+//==============================================================================
+
+// buildBlock returns the rule holding the declarations of the items for the
+// selectors, followed by the rules of the items, where parents are passed to
+// the items.
+func buildBlock(selectors []string, parents []string, level int, items []Item) []*bcss.Rule {
+	var declarations []*bcss.Declaration
+	var rules []*bcss.Rule
+
+	for _, item := range items {
+		itemDeclarations, itemRules := item.build(parents, level)
+		declarations = append(declarations, itemDeclarations...)
+		rules = append(rules, itemRules...)
+	}
+
+	if len(declarations) == 0 {
+		return rules
+	}
+
+	rule := bcss.NewRule(bcss.QualifiedRule)
+	rule.Prelude = strings.Join(selectors, ", ")
+	rule.Selectors = append([]string(nil), selectors...)
+	rule.Declarations = declarations
+	rule.EmbedLevel = level
+
+	return append([]*bcss.Rule{rule}, rules...)
+}
+
+// resolveSelectors returns the selectors resolved against the parents, where
+// "&" is replaced with each parent and selectors without it are descendants
+// of each parent.
+func resolveSelectors(parents []string, selectors []string) []string {
+	if parents == nil {
+		return selectors
+	}
+
+	var resolved []string
+	for _, parent := range parents {
+		for _, selector := range selectors {
+			if strings.Contains(selector, "&") {
+				resolved = append(resolved, strings.Replace(selector, "&", parent, -1))
+				continue
+			}
+
+			resolved = append(resolved, parent+" "+selector)
+		}
+	}
+
+	return resolved
+}
+
+// splitSelectors returns the selectors of a comma separated list, keeping
+// commas within parentheses and brackets such as those of :is(a, b).
+func splitSelectors(selector string) []string {
+	var selectors []string
+	var depth, start int
+
+	add := func(part string) {
+		if part = strings.TrimSpace(part); part != "" {
+			selectors = append(selectors, part)
+		}
+	}
+
+	for index, char := range selector {
+		switch char {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				add(selector[start:index])
+				start = index + 1
+			}
+		}
+	}
+
+	add(selector[start:])
+
+	return selectors
+}
@@ -2,6 +2,9 @@ package css
 
 import (
 	"bytes"
+	"hash/fnv"
+	"io"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -12,55 +15,103 @@ import (
 // Rule defines the a single css rule which will be transformed and
 // converted into a usable stylesheet during rendering.
 type Rule struct {
+	source   string
 	template *template.Template
+	items    []Item
 	depends  []*Rule
 }
 
 // New returns a new instance of a Rule which provides capability to parse
 // and extrapolate the giving content using the provided binding.
 func New(rules string, rs ...*Rule) *Rule {
-	tmp, err := template.New("css").Parse(rules)
+	tmp, err := template.New("css").Funcs(funcs).Parse(rules)
 	if err != nil {
 		panic(err)
 	}
 
 	return &Rule{
+		source:   rules,
 		template: tmp,
 		depends:  rs,
 	}
 }
 
+// ScopeClass returns a class name derived from the rule template, the
+// templates of it's dependencies and the provided scope, such as the type of
+// a component. The class is stable across renders and processes, hence every
+// markup scoped with the same rule and scope shares the same stylesheet.
+func (r *Rule) ScopeClass(scope string) string {
+	hash := fnv.New64a()
+	r.writeSource(hash)
+	hash.Write([]byte(scope))
+
+	return "gu-" + strconv.FormatUint(hash.Sum64(), 36)
+}
+
+// writeSource writes the templates of the rule and it's dependencies into
+// the writer.
+func (r *Rule) writeSource(w io.Writer) {
+	for _, rule := range r.depends {
+		rule.writeSource(w)
+		w.Write([]byte{0})
+	}
+
+	w.Write([]byte(r.source))
+}
+
 // Stylesheet returns the provided styles using the binding as the argument for the
 // provided css template.
+//
+// Selectors are scoped with the parentNode, which replaces "&" and prefixes
+// selectors starting with a pseudo-class. Rules within @media, @supports and
+// @document are scoped the same way. Names of @keyframes are suffixed with a
+// hash of the parentNode, which is also applied to the animation and
+// animation-name declarations using them, while the frames within are left
+// as is. Other at-rules such as @font-face and @import are passed through.
 func (r *Rule) Stylesheet(bind interface{}, parentNode string) (*bcss.Stylesheet, error) {
 	var stylesheet bcss.Stylesheet
 
+	if err := r.parse(bind, &stylesheet); err != nil {
+		return nil, err
+	}
+
+	frames := make(map[string]string)
+	keyframeNames(stylesheet.Rules, parentNode, frames)
+
+	for _, rule := range stylesheet.Rules {
+		r.morphRule(rule, parentNode, frames)
+	}
+
+	return &stylesheet, nil
+}
+
+// parse adds the rules of the dependencies and the rule, as parsed from their
+// templates using the binding, into the stylesheet.
+func (r *Rule) parse(bind interface{}, stylesheet *bcss.Stylesheet) error {
 	for _, rule := range r.depends {
-		sheet, err := rule.Stylesheet(bind, parentNode)
-		if err != nil {
-			return nil, err
+		if err := rule.parse(bind, stylesheet); err != nil {
+			return err
 		}
+	}
 
-		stylesheet.Rules = append(stylesheet.Rules, sheet.Rules...)
+	if r.template == nil {
+		stylesheet.Rules = append(stylesheet.Rules, r.buildRules()...)
+		return nil
 	}
 
 	var content bytes.Buffer
 	if err := r.template.Execute(&content, bind); err != nil {
-		return nil, err
+		return err
 	}
 
 	sheet, err := parser.Parse(content.String())
 	if err != nil {
-		return nil, err
-	}
-
-	for _, rule := range sheet.Rules {
-		r.morphRule(rule, parentNode)
+		return err
 	}
 
 	stylesheet.Rules = append(stylesheet.Rules, sheet.Rules...)
 
-	return &stylesheet, nil
+	return nil
 }
 
 // adjustName adjust the provided name according to the set rules of for specific
@@ -81,19 +132,71 @@ func (r *Rule) adjustName(sel string, parentNode string) string {
 }
 
 // morphRules adjusts the provided rules with the parent selector.
-func (r *Rule) morphRule(base *bcss.Rule, parentNode string) {
-	for index, sel := range base.Selectors {
-		base.Selectors[index] = r.adjustName(sel, parentNode)
+func (r *Rule) morphRule(base *bcss.Rule, parentNode string, frames map[string]string) {
+	switch {
+	case base.Kind == bcss.QualifiedRule:
+		for index, sel := range base.Selectors {
+			base.Selectors[index] = r.adjustName(sel, parentNode)
+		}
+
+		for _, declaration := range base.Declarations {
+			switch strings.ToLower(declaration.Property) {
+			case "animation", "animation-name":
+				declaration.Value = renameAnimations(declaration.Value, frames)
+			}
+		}
+
+	case base.Name == "@keyframes":
+		if name, ok := frames[base.Prelude]; ok {
+			base.Prelude = name
+		}
+
+	case base.EmbedsRules():
+		for _, rule := range base.Rules {
+			r.morphRule(rule, parentNode, frames)
+		}
+	}
+}
+
+// keyframeNames adds the names of the @keyframes within the rules into frames,
+// mapped to their names scoped with the parentNode.
+func keyframeNames(rules []*bcss.Rule, parentNode string, frames map[string]string) {
+	if parentNode == "" {
+		return
 	}
 
-	for _, rule := range base.Rules {
-		if rule.Kind == bcss.AtRule {
-			r.morphRule(rule, parentNode)
-			continue
+	hash := fnv.New32a()
+	hash.Write([]byte(parentNode))
+	suffix := "-" + strconv.FormatUint(uint64(hash.Sum32()), 36)
+
+	for _, rule := range rules {
+		switch {
+		case rule.Name == "@keyframes" && rule.Prelude != "":
+			frames[rule.Prelude] = rule.Prelude + suffix
+		case rule.EmbedsRules():
+			keyframeNames(rule.Rules, parentNode, frames)
 		}
+	}
+}
+
+// renameAnimations returns the value of a animation declaration with the
+// names of scoped keyframes replaced.
+func renameAnimations(value string, frames map[string]string) string {
+	if len(frames) == 0 {
+		return value
+	}
 
-		for index, sel := range rule.Selectors {
-			rule.Selectors[index] = r.adjustName(sel, parentNode)
+	animations := strings.Split(value, ",")
+	for index, animation := range animations {
+		fields := strings.Fields(animation)
+		for field, name := range fields {
+			if scoped, ok := frames[name]; ok {
+				fields[field] = scoped
+			}
 		}
+
+		animations[index] = strings.Join(fields, " ")
 	}
+
+	return strings.Join(animations, ", ")
 }
@@ -1,8 +1,11 @@
 package css_test
 
 import (
+	"strings"
 	"testing"
 
+	bcss "github.com/aymerick/douceur/css"
+	"github.com/aymerick/douceur/parser"
 	"github.com/gu-io/gu/tests"
 	"github.com/gu-io/gu/trees/css"
 )
@@ -103,3 +106,320 @@ func TestLinkedCSS(t *testing.T) {
 	}
 	tests.Passed(t, "Should have rendered expected stylesheet")
 }
+
+func TestScopeClass(t *testing.T) {
+	base := css.New(`block { color: red; }`)
+	rule := css.New(`& { display: block; }`, base)
+
+	class := rule.ScopeClass("components.Card")
+	if !strings.HasPrefix(class, "gu-") || class != rule.ScopeClass("components.Card") {
+		tests.Failed(t, "Should have derived a stable class from the rule: %q", class)
+	}
+	tests.Passed(t, "Should have derived a stable class from the rule")
+
+	if css.New(`& { display: block; }`, base).ScopeClass("components.Card") != class {
+		tests.Failed(t, "Should have derived the same class from equal rules")
+	}
+	tests.Passed(t, "Should have derived the same class from equal rules")
+
+	if rule.ScopeClass("components.List") == class || css.New(`& { display: block; }`).ScopeClass("components.Card") == class {
+		tests.Failed(t, "Should have derived different classes for other scopes and rules")
+	}
+	tests.Passed(t, "Should have derived different classes for other scopes and rules")
+}
+
+// parseSheet returns the stylesheet parsed back from it's string form.
+func parseSheet(t *testing.T, rule *css.Rule, parentNode string) *bcss.Stylesheet {
+	sheet, err := rule.Stylesheet(nil, parentNode)
+	if err != nil {
+		tests.Failed(t, "Should have successfully processed stylesheet for rule: %+q", err)
+	}
+
+	parsed, err := parser.Parse(sheet.String())
+	if err != nil {
+		tests.Failed(t, "Should have rendered a valid stylesheet: %+q\n%s", err, sheet.String())
+	}
+
+	return parsed
+}
+
+func TestKeyframes(t *testing.T) {
+	sheet := parseSheet(t, css.New(`
+		@keyframes spin {
+			from { transform: rotate(0deg); }
+			50% { transform: rotate(180deg); }
+			to { transform: rotate(360deg); }
+		}
+
+		& {
+			animation: spin 1s linear infinite, fade 2s;
+		}
+
+		& span {
+			animation-name: spin;
+		}
+	`), "#galatica")
+
+	frames := sheet.Rules[0]
+	if frames.Name != "@keyframes" || !strings.HasPrefix(frames.Prelude, "spin-") {
+		tests.Failed(t, "Should have scoped the keyframes name: %q", frames.Prelude)
+	}
+	tests.Passed(t, "Should have scoped the keyframes name")
+
+	var selectors []string
+	for _, frame := range frames.Rules {
+		selectors = append(selectors, frame.Selectors...)
+	}
+
+	if strings.Join(selectors, ",") != "from,50%,to" {
+		tests.Failed(t, "Should have left the frame selectors as is: %q", selectors)
+	}
+	tests.Passed(t, "Should have left the frame selectors as is")
+
+	if value := sheet.Rules[1].Declarations[0].Value; value != frames.Prelude+" 1s linear infinite, fade 2s" {
+		tests.Failed(t, "Should have renamed the animation declaration: %q", value)
+	}
+
+	if value := sheet.Rules[2].Declarations[0].Value; value != frames.Prelude {
+		tests.Failed(t, "Should have renamed the animation-name declaration: %q", value)
+	}
+	tests.Passed(t, "Should have renamed animation declarations")
+
+	if other := parseSheet(t, css.New(`@keyframes spin { to { opacity: 0; } }`), "#other"); other.Rules[0].Prelude == frames.Prelude {
+		tests.Failed(t, "Should have scoped keyframes differently for other parents")
+	}
+	tests.Passed(t, "Should have scoped keyframes differently for other parents")
+
+	if plain := parseSheet(t, css.New(`@keyframes spin { to { opacity: 0; } }`), ""); plain.Rules[0].Prelude != "spin" {
+		tests.Failed(t, "Should have kept keyframe names without a parent: %q", plain.Rules[0].Prelude)
+	}
+	tests.Passed(t, "Should have kept keyframe names without a parent")
+}
+
+func TestLinkedKeyframes(t *testing.T) {
+	frames := css.New(`@keyframes pulse { to { opacity: .5; } }`)
+
+	sheet := parseSheet(t, css.New(`& { animation: pulse 1s; }`, frames), "#galatica")
+	if sheet.Rules[1].Declarations[0].Value != sheet.Rules[0].Prelude+" 1s" {
+		tests.Failed(t, "Should have renamed animations of linked keyframes: %q", sheet.Rules[1].Declarations[0].Value)
+	}
+	tests.Passed(t, "Should have renamed animations of linked keyframes")
+}
+
+func TestNestedAtRules(t *testing.T) {
+	sheet := parseSheet(t, css.New(`
+		@media screen and (min-width: 600px) {
+			@supports (display: grid) {
+				& {
+					display: grid;
+				}
+
+				:hover {
+					color: red;
+				}
+			}
+
+			& div {
+				float: left;
+			}
+		}
+
+		@supports not (display: grid) {
+			& {
+				display: block;
+			}
+		}
+	`), "#galatica")
+
+	media := sheet.Rules[0]
+	if media.Name != "@media" || media.Prelude != "screen and (min-width: 600px)" || len(media.Rules) != 2 {
+		tests.Failed(t, "Should have kept the media rule: %+v", media)
+	}
+
+	supports := media.Rules[0]
+	if supports.Name != "@supports" || supports.Rules[0].Selectors[0] != "#galatica" || supports.Rules[1].Selectors[0] != "#galatica:hover" {
+		tests.Failed(t, "Should have scoped rules of supports nested in media: %+v", supports.Rules)
+	}
+
+	if media.Rules[1].Selectors[0] != "#galatica div" {
+		tests.Failed(t, "Should have scoped rules within media: %q", media.Rules[1].Selectors)
+	}
+	tests.Passed(t, "Should have scoped rules within nested at-rules")
+
+	if sheet.Rules[1].Name != "@supports" || sheet.Rules[1].Rules[0].Selectors[0] != "#galatica" {
+		tests.Failed(t, "Should have scoped rules within supports: %+v", sheet.Rules[1])
+	}
+	tests.Passed(t, "Should have scoped rules within supports")
+}
+
+func TestPassthroughAtRules(t *testing.T) {
+	sheet := parseSheet(t, css.New(`
+		@import url("fonts.css");
+
+		@font-face {
+			font-family: "Gu";
+			src: url(gu.woff2) format("woff2");
+		}
+
+		@page :first {
+			margin: 1in;
+		}
+	`), "#galatica")
+
+	if len(sheet.Rules) != 3 {
+		tests.Failed(t, "Should have kept all at-rules: %d", len(sheet.Rules))
+	}
+
+	if sheet.Rules[0].Name != "@import" || sheet.Rules[0].Prelude != `url("fonts.css")` {
+		tests.Failed(t, "Should have passed through the import: %+v", sheet.Rules[0])
+	}
+
+	fontFace := sheet.Rules[1]
+	if fontFace.Name != "@font-face" || len(fontFace.Declarations) != 2 || fontFace.Declarations[0].Value != `"Gu"` {
+		tests.Failed(t, "Should have passed through the font-face: %+v", fontFace)
+	}
+
+	if sheet.Rules[2].Name != "@page" || sheet.Rules[2].Prelude != ":first" {
+		tests.Failed(t, "Should have passed through the page rule: %+v", sheet.Rules[2])
+	}
+	tests.Passed(t, "Should have passed through other at-rules")
+}
+
+func TestBuild(t *testing.T) {
+	template := css.New(`
+		@import url("fonts.css");
+
+		& {
+			color: red;
+			margin: 0 !important;
+		}
+
+		&:hover, & span {
+			color: blue;
+		}
+
+		& span strong {
+			font-weight: bold;
+		}
+
+		div a {
+			color: black;
+		}
+
+		@media (max-width: 400px) {
+			& {
+				display: none;
+			}
+
+			& span {
+				display: block;
+			}
+		}
+
+		@keyframes spin {
+			from {
+				opacity: 0;
+			}
+
+			to {
+				opacity: 1;
+			}
+		}
+
+		@font-face {
+			font-family: "Gu";
+		}
+	`)
+
+	built := css.Build(
+		css.Import("fonts.css"),
+		css.Select("&",
+			css.Color("red"),
+			css.Margin("0").Important(),
+			css.Select("&:hover, span",
+				css.Color("blue"),
+			),
+			css.Select("span strong",
+				css.FontWeight("bold"),
+			),
+		),
+		css.Select("div a",
+			css.Prop("color", "black"),
+		),
+		css.Media("(max-width: 400px)",
+			css.Display("none"),
+			css.Select("& span",
+				css.Display("block"),
+			),
+		),
+		css.Keyframes("spin",
+			css.Select("from", css.Opacity("0")),
+			css.Select("to", css.Opacity("1")),
+		),
+		css.FontFace(css.FontFamily(`"Gu"`)),
+	)
+
+	expected, err := template.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed(t, "Should have successfully processed stylesheet for rule: %+q", err)
+	}
+
+	sheet, err := built.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed(t, "Should have successfully processed stylesheet for built rule: %+q", err)
+	}
+	tests.Passed(t, "Should have successfully processed stylesheet for built rule")
+
+	if sheet.String() != expected.String() {
+		t.Logf("\t\tRecieved: %q\n", sheet.String())
+		t.Logf("\t\tExpected: %q\n", expected.String())
+		tests.Failed(t, "Should have built the same stylesheet as the template")
+	}
+	tests.Passed(t, "Should have built the same stylesheet as the template")
+
+	if again, _ := built.Stylesheet(nil, "#other"); !strings.Contains(again.String(), "#other span") {
+		tests.Failed(t, "Should have scoped the built rule again: %q", again.String())
+	}
+	tests.Passed(t, "Should have scoped the built rule again")
+
+	red := css.Build(css.Select("&", css.Color("red")))
+	if red.ScopeClass("Card") != css.Build(css.Select("&", css.Color("red"))).ScopeClass("Card") || red.ScopeClass("Card") == built.ScopeClass("Card") {
+		tests.Failed(t, "Should have derived the scope class from the built rules")
+	}
+	tests.Passed(t, "Should have derived the scope class from the built rules")
+}
+
+func TestTheme(t *testing.T) {
+	theme := &css.Theme{
+		Name:       "light",
+		Colors:     map[string]string{"primary": "#c00", "text": "#222"},
+		Spacing:    map[string]string{"md": "16px"},
+		Typography: map[string]string{"body": "Helvetica, sans-serif"},
+		Tokens:     map[string]string{"radius": "4px"},
+	}
+
+	if theme.Token("color-primary") != "#c00" || theme.Token("spacing-md") != "16px" || theme.Token("radius") != "4px" || theme.Token("missing") != "" {
+		tests.Failed(t, "Should have named the tokens by their group")
+	}
+	tests.Passed(t, "Should have named the tokens by their group")
+
+	expected := ":root {\n  --gu-color-primary: #c00;\n  --gu-color-text: #222;\n  --gu-font-body: Helvetica, sans-serif;\n  --gu-radius: 4px;\n  --gu-spacing-md: 16px;\n}"
+	if sheet := theme.Stylesheet().String(); sheet != expected {
+		t.Logf("\t\tRecieved: %q\n", sheet)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed(t, "Should have written the tokens as custom properties")
+	}
+	tests.Passed(t, "Should have written the tokens as custom properties")
+
+	templated, err := css.New(`& { color: {{ token "color-primary" }}; }`).Stylesheet(nil, "#galatica")
+	if err != nil || templated.String() != "#galatica {\n  color: var(--gu-color-primary);\n}" {
+		tests.Failed(t, "Should have referred to tokens within templates: %+q %q", err, templated)
+	}
+	tests.Passed(t, "Should have referred to tokens within templates")
+
+	built, _ := css.Build(css.Color(css.Var("color-primary"))).Stylesheet(nil, "#galatica")
+	if built.String() != templated.String() {
+		tests.Failed(t, "Should have referred to tokens within built rules: %q", built.String())
+	}
+	tests.Passed(t, "Should have referred to tokens within built rules")
+}
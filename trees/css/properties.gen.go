@@ -0,0 +1,2472 @@
+// Code generated by generate.go; DO NOT EDIT.
+
+//go:generate go run generate.go
+
+// Property source: "All CSS properties" by W3C, https://www.w3.org/Style/CSS/all-properties.en.html.
+
+package css
+
+// Properties contains the names of the css properties which have a function
+// returning their Declaration.
+var Properties = []string{
+	"accent-color",
+	"align-content",
+	"align-items",
+	"align-self",
+	"alignment-baseline",
+	"all",
+	"animation",
+	"animation-delay",
+	"animation-direction",
+	"animation-duration",
+	"animation-fill-mode",
+	"animation-iteration-count",
+	"animation-name",
+	"animation-play-state",
+	"animation-timing-function",
+	"appearance",
+	"aspect-ratio",
+	"backdrop-filter",
+	"backface-visibility",
+	"background",
+	"background-attachment",
+	"background-blend-mode",
+	"background-clip",
+	"background-color",
+	"background-image",
+	"background-origin",
+	"background-position",
+	"background-position-x",
+	"background-position-y",
+	"background-repeat",
+	"background-size",
+	"baseline-shift",
+	"block-size",
+	"border",
+	"border-block",
+	"border-block-color",
+	"border-block-end",
+	"border-block-end-color",
+	"border-block-end-style",
+	"border-block-end-width",
+	"border-block-start",
+	"border-block-start-color",
+	"border-block-start-style",
+	"border-block-start-width",
+	"border-block-style",
+	"border-block-width",
+	"border-bottom",
+	"border-bottom-color",
+	"border-bottom-left-radius",
+	"border-bottom-right-radius",
+	"border-bottom-style",
+	"border-bottom-width",
+	"border-collapse",
+	"border-color",
+	"border-end-end-radius",
+	"border-end-start-radius",
+	"border-image",
+	"border-image-outset",
+	"border-image-repeat",
+	"border-image-slice",
+	"border-image-source",
+	"border-image-width",
+	"border-inline",
+	"border-inline-color",
+	"border-inline-end",
+	"border-inline-end-color",
+	"border-inline-end-style",
+	"border-inline-end-width",
+	"border-inline-start",
+	"border-inline-start-color",
+	"border-inline-start-style",
+	"border-inline-start-width",
+	"border-inline-style",
+	"border-inline-width",
+	"border-left",
+	"border-left-color",
+	"border-left-style",
+	"border-left-width",
+	"border-radius",
+	"border-right",
+	"border-right-color",
+	"border-right-style",
+	"border-right-width",
+	"border-spacing",
+	"border-start-end-radius",
+	"border-start-start-radius",
+	"border-style",
+	"border-top",
+	"border-top-color",
+	"border-top-left-radius",
+	"border-top-right-radius",
+	"border-top-style",
+	"border-top-width",
+	"border-width",
+	"bottom",
+	"box-decoration-break",
+	"box-shadow",
+	"box-sizing",
+	"break-after",
+	"break-before",
+	"break-inside",
+	"caption-side",
+	"caret-color",
+	"clear",
+	"clip",
+	"clip-path",
+	"clip-rule",
+	"color",
+	"color-interpolation",
+	"color-interpolation-filters",
+	"color-scheme",
+	"column-count",
+	"column-fill",
+	"column-gap",
+	"column-rule",
+	"column-rule-color",
+	"column-rule-style",
+	"column-rule-width",
+	"column-span",
+	"column-width",
+	"columns",
+	"contain",
+	"contain-intrinsic-size",
+	"container",
+	"container-name",
+	"container-type",
+	"content",
+	"content-visibility",
+	"counter-increment",
+	"counter-reset",
+	"counter-set",
+	"cursor",
+	"cx",
+	"cy",
+	"d",
+	"direction",
+	"display",
+	"dominant-baseline",
+	"empty-cells",
+	"fill",
+	"fill-opacity",
+	"fill-rule",
+	"filter",
+	"flex",
+	"flex-basis",
+	"flex-direction",
+	"flex-flow",
+	"flex-grow",
+	"flex-shrink",
+	"flex-wrap",
+	"float",
+	"flood-color",
+	"flood-opacity",
+	"font",
+	"font-family",
+	"font-feature-settings",
+	"font-kerning",
+	"font-language-override",
+	"font-optical-sizing",
+	"font-size",
+	"font-size-adjust",
+	"font-stretch",
+	"font-style",
+	"font-synthesis",
+	"font-variant",
+	"font-variant-alternates",
+	"font-variant-caps",
+	"font-variant-east-asian",
+	"font-variant-ligatures",
+	"font-variant-numeric",
+	"font-variant-position",
+	"font-variation-settings",
+	"font-weight",
+	"forced-color-adjust",
+	"gap",
+	"grid",
+	"grid-area",
+	"grid-auto-columns",
+	"grid-auto-flow",
+	"grid-auto-rows",
+	"grid-column",
+	"grid-column-end",
+	"grid-column-start",
+	"grid-row",
+	"grid-row-end",
+	"grid-row-start",
+	"grid-template",
+	"grid-template-areas",
+	"grid-template-columns",
+	"grid-template-rows",
+	"hanging-punctuation",
+	"height",
+	"hyphens",
+	"image-orientation",
+	"image-rendering",
+	"inline-size",
+	"inset",
+	"inset-block",
+	"inset-block-end",
+	"inset-block-start",
+	"inset-inline",
+	"inset-inline-end",
+	"inset-inline-start",
+	"isolation",
+	"justify-content",
+	"justify-items",
+	"justify-self",
+	"left",
+	"letter-spacing",
+	"lighting-color",
+	"line-break",
+	"line-height",
+	"list-style",
+	"list-style-image",
+	"list-style-position",
+	"list-style-type",
+	"margin",
+	"margin-block",
+	"margin-block-end",
+	"margin-block-start",
+	"margin-bottom",
+	"margin-inline",
+	"margin-inline-end",
+	"margin-inline-start",
+	"margin-left",
+	"margin-right",
+	"margin-top",
+	"marker",
+	"marker-end",
+	"marker-mid",
+	"marker-start",
+	"mask",
+	"mask-border",
+	"mask-clip",
+	"mask-composite",
+	"mask-image",
+	"mask-mode",
+	"mask-origin",
+	"mask-position",
+	"mask-repeat",
+	"mask-size",
+	"mask-type",
+	"max-block-size",
+	"max-height",
+	"max-inline-size",
+	"max-width",
+	"min-block-size",
+	"min-height",
+	"min-inline-size",
+	"min-width",
+	"mix-blend-mode",
+	"object-fit",
+	"object-position",
+	"offset",
+	"offset-anchor",
+	"offset-distance",
+	"offset-path",
+	"offset-rotate",
+	"opacity",
+	"order",
+	"orphans",
+	"outline",
+	"outline-color",
+	"outline-offset",
+	"outline-style",
+	"outline-width",
+	"overflow",
+	"overflow-anchor",
+	"overflow-block",
+	"overflow-clip-margin",
+	"overflow-inline",
+	"overflow-wrap",
+	"overflow-x",
+	"overflow-y",
+	"overscroll-behavior",
+	"overscroll-behavior-block",
+	"overscroll-behavior-inline",
+	"overscroll-behavior-x",
+	"overscroll-behavior-y",
+	"padding",
+	"padding-block",
+	"padding-block-end",
+	"padding-block-start",
+	"padding-bottom",
+	"padding-inline",
+	"padding-inline-end",
+	"padding-inline-start",
+	"padding-left",
+	"padding-right",
+	"padding-top",
+	"page-break-after",
+	"page-break-before",
+	"page-break-inside",
+	"paint-order",
+	"perspective",
+	"perspective-origin",
+	"place-content",
+	"place-items",
+	"place-self",
+	"pointer-events",
+	"position",
+	"print-color-adjust",
+	"quotes",
+	"r",
+	"resize",
+	"right",
+	"rotate",
+	"row-gap",
+	"ruby-align",
+	"ruby-position",
+	"rx",
+	"ry",
+	"scale",
+	"scroll-behavior",
+	"scroll-margin",
+	"scroll-margin-block",
+	"scroll-margin-block-end",
+	"scroll-margin-block-start",
+	"scroll-margin-bottom",
+	"scroll-margin-inline",
+	"scroll-margin-inline-end",
+	"scroll-margin-inline-start",
+	"scroll-margin-left",
+	"scroll-margin-right",
+	"scroll-margin-top",
+	"scroll-padding",
+	"scroll-padding-block",
+	"scroll-padding-block-end",
+	"scroll-padding-block-start",
+	"scroll-padding-bottom",
+	"scroll-padding-inline",
+	"scroll-padding-inline-end",
+	"scroll-padding-inline-start",
+	"scroll-padding-left",
+	"scroll-padding-right",
+	"scroll-padding-top",
+	"scroll-snap-align",
+	"scroll-snap-stop",
+	"scroll-snap-type",
+	"scrollbar-color",
+	"scrollbar-gutter",
+	"scrollbar-width",
+	"shape-image-threshold",
+	"shape-margin",
+	"shape-outside",
+	"shape-rendering",
+	"stop-color",
+	"stop-opacity",
+	"stroke",
+	"stroke-dasharray",
+	"stroke-dashoffset",
+	"stroke-linecap",
+	"stroke-linejoin",
+	"stroke-miterlimit",
+	"stroke-opacity",
+	"stroke-width",
+	"tab-size",
+	"table-layout",
+	"text-align",
+	"text-align-last",
+	"text-anchor",
+	"text-combine-upright",
+	"text-decoration",
+	"text-decoration-color",
+	"text-decoration-line",
+	"text-decoration-skip-ink",
+	"text-decoration-style",
+	"text-decoration-thickness",
+	"text-emphasis",
+	"text-emphasis-color",
+	"text-emphasis-position",
+	"text-emphasis-style",
+	"text-indent",
+	"text-justify",
+	"text-orientation",
+	"text-overflow",
+	"text-rendering",
+	"text-shadow",
+	"text-transform",
+	"text-underline-offset",
+	"text-underline-position",
+	"top",
+	"touch-action",
+	"transform",
+	"transform-box",
+	"transform-origin",
+	"transform-style",
+	"transition",
+	"transition-delay",
+	"transition-duration",
+	"transition-property",
+	"transition-timing-function",
+	"translate",
+	"unicode-bidi",
+	"user-select",
+	"vector-effect",
+	"vertical-align",
+	"visibility",
+	"white-space",
+	"widows",
+	"width",
+	"will-change",
+	"word-break",
+	"word-spacing",
+	"word-wrap",
+	"writing-mode",
+	"x",
+	"y",
+	"z-index",
+	"zoom",
+}
+
+// AccentColor returns a Declaration of the css "accent-color" property.
+func AccentColor(value string) Declaration {
+	return Declaration{Property: "accent-color", Value: value}
+}
+
+// AlignContent returns a Declaration of the css "align-content" property.
+func AlignContent(value string) Declaration {
+	return Declaration{Property: "align-content", Value: value}
+}
+
+// AlignItems returns a Declaration of the css "align-items" property.
+func AlignItems(value string) Declaration {
+	return Declaration{Property: "align-items", Value: value}
+}
+
+// AlignSelf returns a Declaration of the css "align-self" property.
+func AlignSelf(value string) Declaration {
+	return Declaration{Property: "align-self", Value: value}
+}
+
+// AlignmentBaseline returns a Declaration of the css "alignment-baseline" property.
+func AlignmentBaseline(value string) Declaration {
+	return Declaration{Property: "alignment-baseline", Value: value}
+}
+
+// All returns a Declaration of the css "all" property.
+func All(value string) Declaration {
+	return Declaration{Property: "all", Value: value}
+}
+
+// Animation returns a Declaration of the css "animation" property.
+func Animation(value string) Declaration {
+	return Declaration{Property: "animation", Value: value}
+}
+
+// AnimationDelay returns a Declaration of the css "animation-delay" property.
+func AnimationDelay(value string) Declaration {
+	return Declaration{Property: "animation-delay", Value: value}
+}
+
+// AnimationDirection returns a Declaration of the css "animation-direction" property.
+func AnimationDirection(value string) Declaration {
+	return Declaration{Property: "animation-direction", Value: value}
+}
+
+// AnimationDuration returns a Declaration of the css "animation-duration" property.
+func AnimationDuration(value string) Declaration {
+	return Declaration{Property: "animation-duration", Value: value}
+}
+
+// AnimationFillMode returns a Declaration of the css "animation-fill-mode" property.
+func AnimationFillMode(value string) Declaration {
+	return Declaration{Property: "animation-fill-mode", Value: value}
+}
+
+// AnimationIterationCount returns a Declaration of the css "animation-iteration-count" property.
+func AnimationIterationCount(value string) Declaration {
+	return Declaration{Property: "animation-iteration-count", Value: value}
+}
+
+// AnimationName returns a Declaration of the css "animation-name" property.
+func AnimationName(value string) Declaration {
+	return Declaration{Property: "animation-name", Value: value}
+}
+
+// AnimationPlayState returns a Declaration of the css "animation-play-state" property.
+func AnimationPlayState(value string) Declaration {
+	return Declaration{Property: "animation-play-state", Value: value}
+}
+
+// AnimationTimingFunction returns a Declaration of the css "animation-timing-function" property.
+func AnimationTimingFunction(value string) Declaration {
+	return Declaration{Property: "animation-timing-function", Value: value}
+}
+
+// Appearance returns a Declaration of the css "appearance" property.
+func Appearance(value string) Declaration {
+	return Declaration{Property: "appearance", Value: value}
+}
+
+// AspectRatio returns a Declaration of the css "aspect-ratio" property.
+func AspectRatio(value string) Declaration {
+	return Declaration{Property: "aspect-ratio", Value: value}
+}
+
+// BackdropFilter returns a Declaration of the css "backdrop-filter" property.
+func BackdropFilter(value string) Declaration {
+	return Declaration{Property: "backdrop-filter", Value: value}
+}
+
+// BackfaceVisibility returns a Declaration of the css "backface-visibility" property.
+func BackfaceVisibility(value string) Declaration {
+	return Declaration{Property: "backface-visibility", Value: value}
+}
+
+// Background returns a Declaration of the css "background" property.
+func Background(value string) Declaration {
+	return Declaration{Property: "background", Value: value}
+}
+
+// BackgroundAttachment returns a Declaration of the css "background-attachment" property.
+func BackgroundAttachment(value string) Declaration {
+	return Declaration{Property: "background-attachment", Value: value}
+}
+
+// BackgroundBlendMode returns a Declaration of the css "background-blend-mode" property.
+func BackgroundBlendMode(value string) Declaration {
+	return Declaration{Property: "background-blend-mode", Value: value}
+}
+
+// BackgroundClip returns a Declaration of the css "background-clip" property.
+func BackgroundClip(value string) Declaration {
+	return Declaration{Property: "background-clip", Value: value}
+}
+
+// BackgroundColor returns a Declaration of the css "background-color" property.
+func BackgroundColor(value string) Declaration {
+	return Declaration{Property: "background-color", Value: value}
+}
+
+// BackgroundImage returns a Declaration of the css "background-image" property.
+func BackgroundImage(value string) Declaration {
+	return Declaration{Property: "background-image", Value: value}
+}
+
+// BackgroundOrigin returns a Declaration of the css "background-origin" property.
+func BackgroundOrigin(value string) Declaration {
+	return Declaration{Property: "background-origin", Value: value}
+}
+
+// BackgroundPosition returns a Declaration of the css "background-position" property.
+func BackgroundPosition(value string) Declaration {
+	return Declaration{Property: "background-position", Value: value}
+}
+
+// BackgroundPositionX returns a Declaration of the css "background-position-x" property.
+func BackgroundPositionX(value string) Declaration {
+	return Declaration{Property: "background-position-x", Value: value}
+}
+
+// BackgroundPositionY returns a Declaration of the css "background-position-y" property.
+func BackgroundPositionY(value string) Declaration {
+	return Declaration{Property: "background-position-y", Value: value}
+}
+
+// BackgroundRepeat returns a Declaration of the css "background-repeat" property.
+func BackgroundRepeat(value string) Declaration {
+	return Declaration{Property: "background-repeat", Value: value}
+}
+
+// BackgroundSize returns a Declaration of the css "background-size" property.
+func BackgroundSize(value string) Declaration {
+	return Declaration{Property: "background-size", Value: value}
+}
+
+// BaselineShift returns a Declaration of the css "baseline-shift" property.
+func BaselineShift(value string) Declaration {
+	return Declaration{Property: "baseline-shift", Value: value}
+}
+
+// BlockSize returns a Declaration of the css "block-size" property.
+func BlockSize(value string) Declaration {
+	return Declaration{Property: "block-size", Value: value}
+}
+
+// Border returns a Declaration of the css "border" property.
+func Border(value string) Declaration {
+	return Declaration{Property: "border", Value: value}
+}
+
+// BorderBlock returns a Declaration of the css "border-block" property.
+func BorderBlock(value string) Declaration {
+	return Declaration{Property: "border-block", Value: value}
+}
+
+// BorderBlockColor returns a Declaration of the css "border-block-color" property.
+func BorderBlockColor(value string) Declaration {
+	return Declaration{Property: "border-block-color", Value: value}
+}
+
+// BorderBlockEnd returns a Declaration of the css "border-block-end" property.
+func BorderBlockEnd(value string) Declaration {
+	return Declaration{Property: "border-block-end", Value: value}
+}
+
+// BorderBlockEndColor returns a Declaration of the css "border-block-end-color" property.
+func BorderBlockEndColor(value string) Declaration {
+	return Declaration{Property: "border-block-end-color", Value: value}
+}
+
+// BorderBlockEndStyle returns a Declaration of the css "border-block-end-style" property.
+func BorderBlockEndStyle(value string) Declaration {
+	return Declaration{Property: "border-block-end-style", Value: value}
+}
+
+// BorderBlockEndWidth returns a Declaration of the css "border-block-end-width" property.
+func BorderBlockEndWidth(value string) Declaration {
+	return Declaration{Property: "border-block-end-width", Value: value}
+}
+
+// BorderBlockStart returns a Declaration of the css "border-block-start" property.
+func BorderBlockStart(value string) Declaration {
+	return Declaration{Property: "border-block-start", Value: value}
+}
+
+// BorderBlockStartColor returns a Declaration of the css "border-block-start-color" property.
+func BorderBlockStartColor(value string) Declaration {
+	return Declaration{Property: "border-block-start-color", Value: value}
+}
+
+// BorderBlockStartStyle returns a Declaration of the css "border-block-start-style" property.
+func BorderBlockStartStyle(value string) Declaration {
+	return Declaration{Property: "border-block-start-style", Value: value}
+}
+
+// BorderBlockStartWidth returns a Declaration of the css "border-block-start-width" property.
+func BorderBlockStartWidth(value string) Declaration {
+	return Declaration{Property: "border-block-start-width", Value: value}
+}
+
+// BorderBlockStyle returns a Declaration of the css "border-block-style" property.
+func BorderBlockStyle(value string) Declaration {
+	return Declaration{Property: "border-block-style", Value: value}
+}
+
+// BorderBlockWidth returns a Declaration of the css "border-block-width" property.
+func BorderBlockWidth(value string) Declaration {
+	return Declaration{Property: "border-block-width", Value: value}
+}
+
+// BorderBottom returns a Declaration of the css "border-bottom" property.
+func BorderBottom(value string) Declaration {
+	return Declaration{Property: "border-bottom", Value: value}
+}
+
+// BorderBottomColor returns a Declaration of the css "border-bottom-color" property.
+func BorderBottomColor(value string) Declaration {
+	return Declaration{Property: "border-bottom-color", Value: value}
+}
+
+// BorderBottomLeftRadius returns a Declaration of the css "border-bottom-left-radius" property.
+func BorderBottomLeftRadius(value string) Declaration {
+	return Declaration{Property: "border-bottom-left-radius", Value: value}
+}
+
+// BorderBottomRightRadius returns a Declaration of the css "border-bottom-right-radius" property.
+func BorderBottomRightRadius(value string) Declaration {
+	return Declaration{Property: "border-bottom-right-radius", Value: value}
+}
+
+// BorderBottomStyle returns a Declaration of the css "border-bottom-style" property.
+func BorderBottomStyle(value string) Declaration {
+	return Declaration{Property: "border-bottom-style", Value: value}
+}
+
+// BorderBottomWidth returns a Declaration of the css "border-bottom-width" property.
+func BorderBottomWidth(value string) Declaration {
+	return Declaration{Property: "border-bottom-width", Value: value}
+}
+
+// BorderCollapse returns a Declaration of the css "border-collapse" property.
+func BorderCollapse(value string) Declaration {
+	return Declaration{Property: "border-collapse", Value: value}
+}
+
+// BorderColor returns a Declaration of the css "border-color" property.
+func BorderColor(value string) Declaration {
+	return Declaration{Property: "border-color", Value: value}
+}
+
+// BorderEndEndRadius returns a Declaration of the css "border-end-end-radius" property.
+func BorderEndEndRadius(value string) Declaration {
+	return Declaration{Property: "border-end-end-radius", Value: value}
+}
+
+// BorderEndStartRadius returns a Declaration of the css "border-end-start-radius" property.
+func BorderEndStartRadius(value string) Declaration {
+	return Declaration{Property: "border-end-start-radius", Value: value}
+}
+
+// BorderImage returns a Declaration of the css "border-image" property.
+func BorderImage(value string) Declaration {
+	return Declaration{Property: "border-image", Value: value}
+}
+
+// BorderImageOutset returns a Declaration of the css "border-image-outset" property.
+func BorderImageOutset(value string) Declaration {
+	return Declaration{Property: "border-image-outset", Value: value}
+}
+
+// BorderImageRepeat returns a Declaration of the css "border-image-repeat" property.
+func BorderImageRepeat(value string) Declaration {
+	return Declaration{Property: "border-image-repeat", Value: value}
+}
+
+// BorderImageSlice returns a Declaration of the css "border-image-slice" property.
+func BorderImageSlice(value string) Declaration {
+	return Declaration{Property: "border-image-slice", Value: value}
+}
+
+// BorderImageSource returns a Declaration of the css "border-image-source" property.
+func BorderImageSource(value string) Declaration {
+	return Declaration{Property: "border-image-source", Value: value}
+}
+
+// BorderImageWidth returns a Declaration of the css "border-image-width" property.
+func BorderImageWidth(value string) Declaration {
+	return Declaration{Property: "border-image-width", Value: value}
+}
+
+// BorderInline returns a Declaration of the css "border-inline" property.
+func BorderInline(value string) Declaration {
+	return Declaration{Property: "border-inline", Value: value}
+}
+
+// BorderInlineColor returns a Declaration of the css "border-inline-color" property.
+func BorderInlineColor(value string) Declaration {
+	return Declaration{Property: "border-inline-color", Value: value}
+}
+
+// BorderInlineEnd returns a Declaration of the css "border-inline-end" property.
+func BorderInlineEnd(value string) Declaration {
+	return Declaration{Property: "border-inline-end", Value: value}
+}
+
+// BorderInlineEndColor returns a Declaration of the css "border-inline-end-color" property.
+func BorderInlineEndColor(value string) Declaration {
+	return Declaration{Property: "border-inline-end-color", Value: value}
+}
+
+// BorderInlineEndStyle returns a Declaration of the css "border-inline-end-style" property.
+func BorderInlineEndStyle(value string) Declaration {
+	return Declaration{Property: "border-inline-end-style", Value: value}
+}
+
+// BorderInlineEndWidth returns a Declaration of the css "border-inline-end-width" property.
+func BorderInlineEndWidth(value string) Declaration {
+	return Declaration{Property: "border-inline-end-width", Value: value}
+}
+
+// BorderInlineStart returns a Declaration of the css "border-inline-start" property.
+func BorderInlineStart(value string) Declaration {
+	return Declaration{Property: "border-inline-start", Value: value}
+}
+
+// BorderInlineStartColor returns a Declaration of the css "border-inline-start-color" property.
+func BorderInlineStartColor(value string) Declaration {
+	return Declaration{Property: "border-inline-start-color", Value: value}
+}
+
+// BorderInlineStartStyle returns a Declaration of the css "border-inline-start-style" property.
+func BorderInlineStartStyle(value string) Declaration {
+	return Declaration{Property: "border-inline-start-style", Value: value}
+}
+
+// BorderInlineStartWidth returns a Declaration of the css "border-inline-start-width" property.
+func BorderInlineStartWidth(value string) Declaration {
+	return Declaration{Property: "border-inline-start-width", Value: value}
+}
+
+// BorderInlineStyle returns a Declaration of the css "border-inline-style" property.
+func BorderInlineStyle(value string) Declaration {
+	return Declaration{Property: "border-inline-style", Value: value}
+}
+
+// BorderInlineWidth returns a Declaration of the css "border-inline-width" property.
+func BorderInlineWidth(value string) Declaration {
+	return Declaration{Property: "border-inline-width", Value: value}
+}
+
+// BorderLeft returns a Declaration of the css "border-left" property.
+func BorderLeft(value string) Declaration {
+	return Declaration{Property: "border-left", Value: value}
+}
+
+// BorderLeftColor returns a Declaration of the css "border-left-color" property.
+func BorderLeftColor(value string) Declaration {
+	return Declaration{Property: "border-left-color", Value: value}
+}
+
+// BorderLeftStyle returns a Declaration of the css "border-left-style" property.
+func BorderLeftStyle(value string) Declaration {
+	return Declaration{Property: "border-left-style", Value: value}
+}
+
+// BorderLeftWidth returns a Declaration of the css "border-left-width" property.
+func BorderLeftWidth(value string) Declaration {
+	return Declaration{Property: "border-left-width", Value: value}
+}
+
+// BorderRadius returns a Declaration of the css "border-radius" property.
+func BorderRadius(value string) Declaration {
+	return Declaration{Property: "border-radius", Value: value}
+}
+
+// BorderRight returns a Declaration of the css "border-right" property.
+func BorderRight(value string) Declaration {
+	return Declaration{Property: "border-right", Value: value}
+}
+
+// BorderRightColor returns a Declaration of the css "border-right-color" property.
+func BorderRightColor(value string) Declaration {
+	return Declaration{Property: "border-right-color", Value: value}
+}
+
+// BorderRightStyle returns a Declaration of the css "border-right-style" property.
+func BorderRightStyle(value string) Declaration {
+	return Declaration{Property: "border-right-style", Value: value}
+}
+
+// BorderRightWidth returns a Declaration of the css "border-right-width" property.
+func BorderRightWidth(value string) Declaration {
+	return Declaration{Property: "border-right-width", Value: value}
+}
+
+// BorderSpacing returns a Declaration of the css "border-spacing" property.
+func BorderSpacing(value string) Declaration {
+	return Declaration{Property: "border-spacing", Value: value}
+}
+
+// BorderStartEndRadius returns a Declaration of the css "border-start-end-radius" property.
+func BorderStartEndRadius(value string) Declaration {
+	return Declaration{Property: "border-start-end-radius", Value: value}
+}
+
+// BorderStartStartRadius returns a Declaration of the css "border-start-start-radius" property.
+func BorderStartStartRadius(value string) Declaration {
+	return Declaration{Property: "border-start-start-radius", Value: value}
+}
+
+// BorderStyle returns a Declaration of the css "border-style" property.
+func BorderStyle(value string) Declaration {
+	return Declaration{Property: "border-style", Value: value}
+}
+
+// BorderTop returns a Declaration of the css "border-top" property.
+func BorderTop(value string) Declaration {
+	return Declaration{Property: "border-top", Value: value}
+}
+
+// BorderTopColor returns a Declaration of the css "border-top-color" property.
+func BorderTopColor(value string) Declaration {
+	return Declaration{Property: "border-top-color", Value: value}
+}
+
+// BorderTopLeftRadius returns a Declaration of the css "border-top-left-radius" property.
+func BorderTopLeftRadius(value string) Declaration {
+	return Declaration{Property: "border-top-left-radius", Value: value}
+}
+
+// BorderTopRightRadius returns a Declaration of the css "border-top-right-radius" property.
+func BorderTopRightRadius(value string) Declaration {
+	return Declaration{Property: "border-top-right-radius", Value: value}
+}
+
+// BorderTopStyle returns a Declaration of the css "border-top-style" property.
+func BorderTopStyle(value string) Declaration {
+	return Declaration{Property: "border-top-style", Value: value}
+}
+
+// BorderTopWidth returns a Declaration of the css "border-top-width" property.
+func BorderTopWidth(value string) Declaration {
+	return Declaration{Property: "border-top-width", Value: value}
+}
+
+// BorderWidth returns a Declaration of the css "border-width" property.
+func BorderWidth(value string) Declaration {
+	return Declaration{Property: "border-width", Value: value}
+}
+
+// Bottom returns a Declaration of the css "bottom" property.
+func Bottom(value string) Declaration {
+	return Declaration{Property: "bottom", Value: value}
+}
+
+// BoxDecorationBreak returns a Declaration of the css "box-decoration-break" property.
+func BoxDecorationBreak(value string) Declaration {
+	return Declaration{Property: "box-decoration-break", Value: value}
+}
+
+// BoxShadow returns a Declaration of the css "box-shadow" property.
+func BoxShadow(value string) Declaration {
+	return Declaration{Property: "box-shadow", Value: value}
+}
+
+// BoxSizing returns a Declaration of the css "box-sizing" property.
+func BoxSizing(value string) Declaration {
+	return Declaration{Property: "box-sizing", Value: value}
+}
+
+// BreakAfter returns a Declaration of the css "break-after" property.
+func BreakAfter(value string) Declaration {
+	return Declaration{Property: "break-after", Value: value}
+}
+
+// BreakBefore returns a Declaration of the css "break-before" property.
+func BreakBefore(value string) Declaration {
+	return Declaration{Property: "break-before", Value: value}
+}
+
+// BreakInside returns a Declaration of the css "break-inside" property.
+func BreakInside(value string) Declaration {
+	return Declaration{Property: "break-inside", Value: value}
+}
+
+// CaptionSide returns a Declaration of the css "caption-side" property.
+func CaptionSide(value string) Declaration {
+	return Declaration{Property: "caption-side", Value: value}
+}
+
+// CaretColor returns a Declaration of the css "caret-color" property.
+func CaretColor(value string) Declaration {
+	return Declaration{Property: "caret-color", Value: value}
+}
+
+// Clear returns a Declaration of the css "clear" property.
+func Clear(value string) Declaration {
+	return Declaration{Property: "clear", Value: value}
+}
+
+// Clip returns a Declaration of the css "clip" property.
+func Clip(value string) Declaration {
+	return Declaration{Property: "clip", Value: value}
+}
+
+// ClipPath returns a Declaration of the css "clip-path" property.
+func ClipPath(value string) Declaration {
+	return Declaration{Property: "clip-path", Value: value}
+}
+
+// ClipRule returns a Declaration of the css "clip-rule" property.
+func ClipRule(value string) Declaration {
+	return Declaration{Property: "clip-rule", Value: value}
+}
+
+// Color returns a Declaration of the css "color" property.
+func Color(value string) Declaration {
+	return Declaration{Property: "color", Value: value}
+}
+
+// ColorInterpolation returns a Declaration of the css "color-interpolation" property.
+func ColorInterpolation(value string) Declaration {
+	return Declaration{Property: "color-interpolation", Value: value}
+}
+
+// ColorInterpolationFilters returns a Declaration of the css "color-interpolation-filters" property.
+func ColorInterpolationFilters(value string) Declaration {
+	return Declaration{Property: "color-interpolation-filters", Value: value}
+}
+
+// ColorScheme returns a Declaration of the css "color-scheme" property.
+func ColorScheme(value string) Declaration {
+	return Declaration{Property: "color-scheme", Value: value}
+}
+
+// ColumnCount returns a Declaration of the css "column-count" property.
+func ColumnCount(value string) Declaration {
+	return Declaration{Property: "column-count", Value: value}
+}
+
+// ColumnFill returns a Declaration of the css "column-fill" property.
+func ColumnFill(value string) Declaration {
+	return Declaration{Property: "column-fill", Value: value}
+}
+
+// ColumnGap returns a Declaration of the css "column-gap" property.
+func ColumnGap(value string) Declaration {
+	return Declaration{Property: "column-gap", Value: value}
+}
+
+// ColumnRule returns a Declaration of the css "column-rule" property.
+func ColumnRule(value string) Declaration {
+	return Declaration{Property: "column-rule", Value: value}
+}
+
+// ColumnRuleColor returns a Declaration of the css "column-rule-color" property.
+func ColumnRuleColor(value string) Declaration {
+	return Declaration{Property: "column-rule-color", Value: value}
+}
+
+// ColumnRuleStyle returns a Declaration of the css "column-rule-style" property.
+func ColumnRuleStyle(value string) Declaration {
+	return Declaration{Property: "column-rule-style", Value: value}
+}
+
+// ColumnRuleWidth returns a Declaration of the css "column-rule-width" property.
+func ColumnRuleWidth(value string) Declaration {
+	return Declaration{Property: "column-rule-width", Value: value}
+}
+
+// ColumnSpan returns a Declaration of the css "column-span" property.
+func ColumnSpan(value string) Declaration {
+	return Declaration{Property: "column-span", Value: value}
+}
+
+// ColumnWidth returns a Declaration of the css "column-width" property.
+func ColumnWidth(value string) Declaration {
+	return Declaration{Property: "column-width", Value: value}
+}
+
+// Columns returns a Declaration of the css "columns" property.
+func Columns(value string) Declaration {
+	return Declaration{Property: "columns", Value: value}
+}
+
+// Contain returns a Declaration of the css "contain" property.
+func Contain(value string) Declaration {
+	return Declaration{Property: "contain", Value: value}
+}
+
+// ContainIntrinsicSize returns a Declaration of the css "contain-intrinsic-size" property.
+func ContainIntrinsicSize(value string) Declaration {
+	return Declaration{Property: "contain-intrinsic-size", Value: value}
+}
+
+// Container returns a Declaration of the css "container" property.
+func Container(value string) Declaration {
+	return Declaration{Property: "container", Value: value}
+}
+
+// ContainerName returns a Declaration of the css "container-name" property.
+func ContainerName(value string) Declaration {
+	return Declaration{Property: "container-name", Value: value}
+}
+
+// ContainerType returns a Declaration of the css "container-type" property.
+func ContainerType(value string) Declaration {
+	return Declaration{Property: "container-type", Value: value}
+}
+
+// Content returns a Declaration of the css "content" property.
+func Content(value string) Declaration {
+	return Declaration{Property: "content", Value: value}
+}
+
+// ContentVisibility returns a Declaration of the css "content-visibility" property.
+func ContentVisibility(value string) Declaration {
+	return Declaration{Property: "content-visibility", Value: value}
+}
+
+// CounterIncrement returns a Declaration of the css "counter-increment" property.
+func CounterIncrement(value string) Declaration {
+	return Declaration{Property: "counter-increment", Value: value}
+}
+
+// CounterReset returns a Declaration of the css "counter-reset" property.
+func CounterReset(value string) Declaration {
+	return Declaration{Property: "counter-reset", Value: value}
+}
+
+// CounterSet returns a Declaration of the css "counter-set" property.
+func CounterSet(value string) Declaration {
+	return Declaration{Property: "counter-set", Value: value}
+}
+
+// Cursor returns a Declaration of the css "cursor" property.
+func Cursor(value string) Declaration {
+	return Declaration{Property: "cursor", Value: value}
+}
+
+// Cx returns a Declaration of the css "cx" property.
+func Cx(value string) Declaration {
+	return Declaration{Property: "cx", Value: value}
+}
+
+// Cy returns a Declaration of the css "cy" property.
+func Cy(value string) Declaration {
+	return Declaration{Property: "cy", Value: value}
+}
+
+// D returns a Declaration of the css "d" property.
+func D(value string) Declaration {
+	return Declaration{Property: "d", Value: value}
+}
+
+// Direction returns a Declaration of the css "direction" property.
+func Direction(value string) Declaration {
+	return Declaration{Property: "direction", Value: value}
+}
+
+// Display returns a Declaration of the css "display" property.
+func Display(value string) Declaration {
+	return Declaration{Property: "display", Value: value}
+}
+
+// DominantBaseline returns a Declaration of the css "dominant-baseline" property.
+func DominantBaseline(value string) Declaration {
+	return Declaration{Property: "dominant-baseline", Value: value}
+}
+
+// EmptyCells returns a Declaration of the css "empty-cells" property.
+func EmptyCells(value string) Declaration {
+	return Declaration{Property: "empty-cells", Value: value}
+}
+
+// Fill returns a Declaration of the css "fill" property.
+func Fill(value string) Declaration {
+	return Declaration{Property: "fill", Value: value}
+}
+
+// FillOpacity returns a Declaration of the css "fill-opacity" property.
+func FillOpacity(value string) Declaration {
+	return Declaration{Property: "fill-opacity", Value: value}
+}
+
+// FillRule returns a Declaration of the css "fill-rule" property.
+func FillRule(value string) Declaration {
+	return Declaration{Property: "fill-rule", Value: value}
+}
+
+// Filter returns a Declaration of the css "filter" property.
+func Filter(value string) Declaration {
+	return Declaration{Property: "filter", Value: value}
+}
+
+// Flex returns a Declaration of the css "flex" property.
+func Flex(value string) Declaration {
+	return Declaration{Property: "flex", Value: value}
+}
+
+// FlexBasis returns a Declaration of the css "flex-basis" property.
+func FlexBasis(value string) Declaration {
+	return Declaration{Property: "flex-basis", Value: value}
+}
+
+// FlexDirection returns a Declaration of the css "flex-direction" property.
+func FlexDirection(value string) Declaration {
+	return Declaration{Property: "flex-direction", Value: value}
+}
+
+// FlexFlow returns a Declaration of the css "flex-flow" property.
+func FlexFlow(value string) Declaration {
+	return Declaration{Property: "flex-flow", Value: value}
+}
+
+// FlexGrow returns a Declaration of the css "flex-grow" property.
+func FlexGrow(value string) Declaration {
+	return Declaration{Property: "flex-grow", Value: value}
+}
+
+// FlexShrink returns a Declaration of the css "flex-shrink" property.
+func FlexShrink(value string) Declaration {
+	return Declaration{Property: "flex-shrink", Value: value}
+}
+
+// FlexWrap returns a Declaration of the css "flex-wrap" property.
+func FlexWrap(value string) Declaration {
+	return Declaration{Property: "flex-wrap", Value: value}
+}
+
+// Float returns a Declaration of the css "float" property.
+func Float(value string) Declaration {
+	return Declaration{Property: "float", Value: value}
+}
+
+// FloodColor returns a Declaration of the css "flood-color" property.
+func FloodColor(value string) Declaration {
+	return Declaration{Property: "flood-color", Value: value}
+}
+
+// FloodOpacity returns a Declaration of the css "flood-opacity" property.
+func FloodOpacity(value string) Declaration {
+	return Declaration{Property: "flood-opacity", Value: value}
+}
+
+// Font returns a Declaration of the css "font" property.
+func Font(value string) Declaration {
+	return Declaration{Property: "font", Value: value}
+}
+
+// FontFamily returns a Declaration of the css "font-family" property.
+func FontFamily(value string) Declaration {
+	return Declaration{Property: "font-family", Value: value}
+}
+
+// FontFeatureSettings returns a Declaration of the css "font-feature-settings" property.
+func FontFeatureSettings(value string) Declaration {
+	return Declaration{Property: "font-feature-settings", Value: value}
+}
+
+// FontKerning returns a Declaration of the css "font-kerning" property.
+func FontKerning(value string) Declaration {
+	return Declaration{Property: "font-kerning", Value: value}
+}
+
+// FontLanguageOverride returns a Declaration of the css "font-language-override" property.
+func FontLanguageOverride(value string) Declaration {
+	return Declaration{Property: "font-language-override", Value: value}
+}
+
+// FontOpticalSizing returns a Declaration of the css "font-optical-sizing" property.
+func FontOpticalSizing(value string) Declaration {
+	return Declaration{Property: "font-optical-sizing", Value: value}
+}
+
+// FontSize returns a Declaration of the css "font-size" property.
+func FontSize(value string) Declaration {
+	return Declaration{Property: "font-size", Value: value}
+}
+
+// FontSizeAdjust returns a Declaration of the css "font-size-adjust" property.
+func FontSizeAdjust(value string) Declaration {
+	return Declaration{Property: "font-size-adjust", Value: value}
+}
+
+// FontStretch returns a Declaration of the css "font-stretch" property.
+func FontStretch(value string) Declaration {
+	return Declaration{Property: "font-stretch", Value: value}
+}
+
+// FontStyle returns a Declaration of the css "font-style" property.
+func FontStyle(value string) Declaration {
+	return Declaration{Property: "font-style", Value: value}
+}
+
+// FontSynthesis returns a Declaration of the css "font-synthesis" property.
+func FontSynthesis(value string) Declaration {
+	return Declaration{Property: "font-synthesis", Value: value}
+}
+
+// FontVariant returns a Declaration of the css "font-variant" property.
+func FontVariant(value string) Declaration {
+	return Declaration{Property: "font-variant", Value: value}
+}
+
+// FontVariantAlternates returns a Declaration of the css "font-variant-alternates" property.
+func FontVariantAlternates(value string) Declaration {
+	return Declaration{Property: "font-variant-alternates", Value: value}
+}
+
+// FontVariantCaps returns a Declaration of the css "font-variant-caps" property.
+func FontVariantCaps(value string) Declaration {
+	return Declaration{Property: "font-variant-caps", Value: value}
+}
+
+// FontVariantEastAsian returns a Declaration of the css "font-variant-east-asian" property.
+func FontVariantEastAsian(value string) Declaration {
+	return Declaration{Property: "font-variant-east-asian", Value: value}
+}
+
+// FontVariantLigatures returns a Declaration of the css "font-variant-ligatures" property.
+func FontVariantLigatures(value string) Declaration {
+	return Declaration{Property: "font-variant-ligatures", Value: value}
+}
+
+// FontVariantNumeric returns a Declaration of the css "font-variant-numeric" property.
+func FontVariantNumeric(value string) Declaration {
+	return Declaration{Property: "font-variant-numeric", Value: value}
+}
+
+// FontVariantPosition returns a Declaration of the css "font-variant-position" property.
+func FontVariantPosition(value string) Declaration {
+	return Declaration{Property: "font-variant-position", Value: value}
+}
+
+// FontVariationSettings returns a Declaration of the css "font-variation-settings" property.
+func FontVariationSettings(value string) Declaration {
+	return Declaration{Property: "font-variation-settings", Value: value}
+}
+
+// FontWeight returns a Declaration of the css "font-weight" property.
+func FontWeight(value string) Declaration {
+	return Declaration{Property: "font-weight", Value: value}
+}
+
+// ForcedColorAdjust returns a Declaration of the css "forced-color-adjust" property.
+func ForcedColorAdjust(value string) Declaration {
+	return Declaration{Property: "forced-color-adjust", Value: value}
+}
+
+// Gap returns a Declaration of the css "gap" property.
+func Gap(value string) Declaration {
+	return Declaration{Property: "gap", Value: value}
+}
+
+// Grid returns a Declaration of the css "grid" property.
+func Grid(value string) Declaration {
+	return Declaration{Property: "grid", Value: value}
+}
+
+// GridArea returns a Declaration of the css "grid-area" property.
+func GridArea(value string) Declaration {
+	return Declaration{Property: "grid-area", Value: value}
+}
+
+// GridAutoColumns returns a Declaration of the css "grid-auto-columns" property.
+func GridAutoColumns(value string) Declaration {
+	return Declaration{Property: "grid-auto-columns", Value: value}
+}
+
+// GridAutoFlow returns a Declaration of the css "grid-auto-flow" property.
+func GridAutoFlow(value string) Declaration {
+	return Declaration{Property: "grid-auto-flow", Value: value}
+}
+
+// GridAutoRows returns a Declaration of the css "grid-auto-rows" property.
+func GridAutoRows(value string) Declaration {
+	return Declaration{Property: "grid-auto-rows", Value: value}
+}
+
+// GridColumn returns a Declaration of the css "grid-column" property.
+func GridColumn(value string) Declaration {
+	return Declaration{Property: "grid-column", Value: value}
+}
+
+// GridColumnEnd returns a Declaration of the css "grid-column-end" property.
+func GridColumnEnd(value string) Declaration {
+	return Declaration{Property: "grid-column-end", Value: value}
+}
+
+// GridColumnStart returns a Declaration of the css "grid-column-start" property.
+func GridColumnStart(value string) Declaration {
+	return Declaration{Property: "grid-column-start", Value: value}
+}
+
+// GridRow returns a Declaration of the css "grid-row" property.
+func GridRow(value string) Declaration {
+	return Declaration{Property: "grid-row", Value: value}
+}
+
+// GridRowEnd returns a Declaration of the css "grid-row-end" property.
+func GridRowEnd(value string) Declaration {
+	return Declaration{Property: "grid-row-end", Value: value}
+}
+
+// GridRowStart returns a Declaration of the css "grid-row-start" property.
+func GridRowStart(value string) Declaration {
+	return Declaration{Property: "grid-row-start", Value: value}
+}
+
+// GridTemplate returns a Declaration of the css "grid-template" property.
+func GridTemplate(value string) Declaration {
+	return Declaration{Property: "grid-template", Value: value}
+}
+
+// GridTemplateAreas returns a Declaration of the css "grid-template-areas" property.
+func GridTemplateAreas(value string) Declaration {
+	return Declaration{Property: "grid-template-areas", Value: value}
+}
+
+// GridTemplateColumns returns a Declaration of the css "grid-template-columns" property.
+func GridTemplateColumns(value string) Declaration {
+	return Declaration{Property: "grid-template-columns", Value: value}
+}
+
+// GridTemplateRows returns a Declaration of the css "grid-template-rows" property.
+func GridTemplateRows(value string) Declaration {
+	return Declaration{Property: "grid-template-rows", Value: value}
+}
+
+// HangingPunctuation returns a Declaration of the css "hanging-punctuation" property.
+func HangingPunctuation(value string) Declaration {
+	return Declaration{Property: "hanging-punctuation", Value: value}
+}
+
+// Height returns a Declaration of the css "height" property.
+func Height(value string) Declaration {
+	return Declaration{Property: "height", Value: value}
+}
+
+// Hyphens returns a Declaration of the css "hyphens" property.
+func Hyphens(value string) Declaration {
+	return Declaration{Property: "hyphens", Value: value}
+}
+
+// ImageOrientation returns a Declaration of the css "image-orientation" property.
+func ImageOrientation(value string) Declaration {
+	return Declaration{Property: "image-orientation", Value: value}
+}
+
+// ImageRendering returns a Declaration of the css "image-rendering" property.
+func ImageRendering(value string) Declaration {
+	return Declaration{Property: "image-rendering", Value: value}
+}
+
+// InlineSize returns a Declaration of the css "inline-size" property.
+func InlineSize(value string) Declaration {
+	return Declaration{Property: "inline-size", Value: value}
+}
+
+// Inset returns a Declaration of the css "inset" property.
+func Inset(value string) Declaration {
+	return Declaration{Property: "inset", Value: value}
+}
+
+// InsetBlock returns a Declaration of the css "inset-block" property.
+func InsetBlock(value string) Declaration {
+	return Declaration{Property: "inset-block", Value: value}
+}
+
+// InsetBlockEnd returns a Declaration of the css "inset-block-end" property.
+func InsetBlockEnd(value string) Declaration {
+	return Declaration{Property: "inset-block-end", Value: value}
+}
+
+// InsetBlockStart returns a Declaration of the css "inset-block-start" property.
+func InsetBlockStart(value string) Declaration {
+	return Declaration{Property: "inset-block-start", Value: value}
+}
+
+// InsetInline returns a Declaration of the css "inset-inline" property.
+func InsetInline(value string) Declaration {
+	return Declaration{Property: "inset-inline", Value: value}
+}
+
+// InsetInlineEnd returns a Declaration of the css "inset-inline-end" property.
+func InsetInlineEnd(value string) Declaration {
+	return Declaration{Property: "inset-inline-end", Value: value}
+}
+
+// InsetInlineStart returns a Declaration of the css "inset-inline-start" property.
+func InsetInlineStart(value string) Declaration {
+	return Declaration{Property: "inset-inline-start", Value: value}
+}
+
+// Isolation returns a Declaration of the css "isolation" property.
+func Isolation(value string) Declaration {
+	return Declaration{Property: "isolation", Value: value}
+}
+
+// JustifyContent returns a Declaration of the css "justify-content" property.
+func JustifyContent(value string) Declaration {
+	return Declaration{Property: "justify-content", Value: value}
+}
+
+// JustifyItems returns a Declaration of the css "justify-items" property.
+func JustifyItems(value string) Declaration {
+	return Declaration{Property: "justify-items", Value: value}
+}
+
+// JustifySelf returns a Declaration of the css "justify-self" property.
+func JustifySelf(value string) Declaration {
+	return Declaration{Property: "justify-self", Value: value}
+}
+
+// Left returns a Declaration of the css "left" property.
+func Left(value string) Declaration {
+	return Declaration{Property: "left", Value: value}
+}
+
+// LetterSpacing returns a Declaration of the css "letter-spacing" property.
+func LetterSpacing(value string) Declaration {
+	return Declaration{Property: "letter-spacing", Value: value}
+}
+
+// LightingColor returns a Declaration of the css "lighting-color" property.
+func LightingColor(value string) Declaration {
+	return Declaration{Property: "lighting-color", Value: value}
+}
+
+// LineBreak returns a Declaration of the css "line-break" property.
+func LineBreak(value string) Declaration {
+	return Declaration{Property: "line-break", Value: value}
+}
+
+// LineHeight returns a Declaration of the css "line-height" property.
+func LineHeight(value string) Declaration {
+	return Declaration{Property: "line-height", Value: value}
+}
+
+// ListStyle returns a Declaration of the css "list-style" property.
+func ListStyle(value string) Declaration {
+	return Declaration{Property: "list-style", Value: value}
+}
+
+// ListStyleImage returns a Declaration of the css "list-style-image" property.
+func ListStyleImage(value string) Declaration {
+	return Declaration{Property: "list-style-image", Value: value}
+}
+
+// ListStylePosition returns a Declaration of the css "list-style-position" property.
+func ListStylePosition(value string) Declaration {
+	return Declaration{Property: "list-style-position", Value: value}
+}
+
+// ListStyleType returns a Declaration of the css "list-style-type" property.
+func ListStyleType(value string) Declaration {
+	return Declaration{Property: "list-style-type", Value: value}
+}
+
+// Margin returns a Declaration of the css "margin" property.
+func Margin(value string) Declaration {
+	return Declaration{Property: "margin", Value: value}
+}
+
+// MarginBlock returns a Declaration of the css "margin-block" property.
+func MarginBlock(value string) Declaration {
+	return Declaration{Property: "margin-block", Value: value}
+}
+
+// MarginBlockEnd returns a Declaration of the css "margin-block-end" property.
+func MarginBlockEnd(value string) Declaration {
+	return Declaration{Property: "margin-block-end", Value: value}
+}
+
+// MarginBlockStart returns a Declaration of the css "margin-block-start" property.
+func MarginBlockStart(value string) Declaration {
+	return Declaration{Property: "margin-block-start", Value: value}
+}
+
+// MarginBottom returns a Declaration of the css "margin-bottom" property.
+func MarginBottom(value string) Declaration {
+	return Declaration{Property: "margin-bottom", Value: value}
+}
+
+// MarginInline returns a Declaration of the css "margin-inline" property.
+func MarginInline(value string) Declaration {
+	return Declaration{Property: "margin-inline", Value: value}
+}
+
+// MarginInlineEnd returns a Declaration of the css "margin-inline-end" property.
+func MarginInlineEnd(value string) Declaration {
+	return Declaration{Property: "margin-inline-end", Value: value}
+}
+
+// MarginInlineStart returns a Declaration of the css "margin-inline-start" property.
+func MarginInlineStart(value string) Declaration {
+	return Declaration{Property: "margin-inline-start", Value: value}
+}
+
+// MarginLeft returns a Declaration of the css "margin-left" property.
+func MarginLeft(value string) Declaration {
+	return Declaration{Property: "margin-left", Value: value}
+}
+
+// MarginRight returns a Declaration of the css "margin-right" property.
+func MarginRight(value string) Declaration {
+	return Declaration{Property: "margin-right", Value: value}
+}
+
+// MarginTop returns a Declaration of the css "margin-top" property.
+func MarginTop(value string) Declaration {
+	return Declaration{Property: "margin-top", Value: value}
+}
+
+// Marker returns a Declaration of the css "marker" property.
+func Marker(value string) Declaration {
+	return Declaration{Property: "marker", Value: value}
+}
+
+// MarkerEnd returns a Declaration of the css "marker-end" property.
+func MarkerEnd(value string) Declaration {
+	return Declaration{Property: "marker-end", Value: value}
+}
+
+// MarkerMid returns a Declaration of the css "marker-mid" property.
+func MarkerMid(value string) Declaration {
+	return Declaration{Property: "marker-mid", Value: value}
+}
+
+// MarkerStart returns a Declaration of the css "marker-start" property.
+func MarkerStart(value string) Declaration {
+	return Declaration{Property: "marker-start", Value: value}
+}
+
+// Mask returns a Declaration of the css "mask" property.
+func Mask(value string) Declaration {
+	return Declaration{Property: "mask", Value: value}
+}
+
+// MaskBorder returns a Declaration of the css "mask-border" property.
+func MaskBorder(value string) Declaration {
+	return Declaration{Property: "mask-border", Value: value}
+}
+
+// MaskClip returns a Declaration of the css "mask-clip" property.
+func MaskClip(value string) Declaration {
+	return Declaration{Property: "mask-clip", Value: value}
+}
+
+// MaskComposite returns a Declaration of the css "mask-composite" property.
+func MaskComposite(value string) Declaration {
+	return Declaration{Property: "mask-composite", Value: value}
+}
+
+// MaskImage returns a Declaration of the css "mask-image" property.
+func MaskImage(value string) Declaration {
+	return Declaration{Property: "mask-image", Value: value}
+}
+
+// MaskMode returns a Declaration of the css "mask-mode" property.
+func MaskMode(value string) Declaration {
+	return Declaration{Property: "mask-mode", Value: value}
+}
+
+// MaskOrigin returns a Declaration of the css "mask-origin" property.
+func MaskOrigin(value string) Declaration {
+	return Declaration{Property: "mask-origin", Value: value}
+}
+
+// MaskPosition returns a Declaration of the css "mask-position" property.
+func MaskPosition(value string) Declaration {
+	return Declaration{Property: "mask-position", Value: value}
+}
+
+// MaskRepeat returns a Declaration of the css "mask-repeat" property.
+func MaskRepeat(value string) Declaration {
+	return Declaration{Property: "mask-repeat", Value: value}
+}
+
+// MaskSize returns a Declaration of the css "mask-size" property.
+func MaskSize(value string) Declaration {
+	return Declaration{Property: "mask-size", Value: value}
+}
+
+// MaskType returns a Declaration of the css "mask-type" property.
+func MaskType(value string) Declaration {
+	return Declaration{Property: "mask-type", Value: value}
+}
+
+// MaxBlockSize returns a Declaration of the css "max-block-size" property.
+func MaxBlockSize(value string) Declaration {
+	return Declaration{Property: "max-block-size", Value: value}
+}
+
+// MaxHeight returns a Declaration of the css "max-height" property.
+func MaxHeight(value string) Declaration {
+	return Declaration{Property: "max-height", Value: value}
+}
+
+// MaxInlineSize returns a Declaration of the css "max-inline-size" property.
+func MaxInlineSize(value string) Declaration {
+	return Declaration{Property: "max-inline-size", Value: value}
+}
+
+// MaxWidth returns a Declaration of the css "max-width" property.
+func MaxWidth(value string) Declaration {
+	return Declaration{Property: "max-width", Value: value}
+}
+
+// MinBlockSize returns a Declaration of the css "min-block-size" property.
+func MinBlockSize(value string) Declaration {
+	return Declaration{Property: "min-block-size", Value: value}
+}
+
+// MinHeight returns a Declaration of the css "min-height" property.
+func MinHeight(value string) Declaration {
+	return Declaration{Property: "min-height", Value: value}
+}
+
+// MinInlineSize returns a Declaration of the css "min-inline-size" property.
+func MinInlineSize(value string) Declaration {
+	return Declaration{Property: "min-inline-size", Value: value}
+}
+
+// MinWidth returns a Declaration of the css "min-width" property.
+func MinWidth(value string) Declaration {
+	return Declaration{Property: "min-width", Value: value}
+}
+
+// MixBlendMode returns a Declaration of the css "mix-blend-mode" property.
+func MixBlendMode(value string) Declaration {
+	return Declaration{Property: "mix-blend-mode", Value: value}
+}
+
+// ObjectFit returns a Declaration of the css "object-fit" property.
+func ObjectFit(value string) Declaration {
+	return Declaration{Property: "object-fit", Value: value}
+}
+
+// ObjectPosition returns a Declaration of the css "object-position" property.
+func ObjectPosition(value string) Declaration {
+	return Declaration{Property: "object-position", Value: value}
+}
+
+// Offset returns a Declaration of the css "offset" property.
+func Offset(value string) Declaration {
+	return Declaration{Property: "offset", Value: value}
+}
+
+// OffsetAnchor returns a Declaration of the css "offset-anchor" property.
+func OffsetAnchor(value string) Declaration {
+	return Declaration{Property: "offset-anchor", Value: value}
+}
+
+// OffsetDistance returns a Declaration of the css "offset-distance" property.
+func OffsetDistance(value string) Declaration {
+	return Declaration{Property: "offset-distance", Value: value}
+}
+
+// OffsetPath returns a Declaration of the css "offset-path" property.
+func OffsetPath(value string) Declaration {
+	return Declaration{Property: "offset-path", Value: value}
+}
+
+// OffsetRotate returns a Declaration of the css "offset-rotate" property.
+func OffsetRotate(value string) Declaration {
+	return Declaration{Property: "offset-rotate", Value: value}
+}
+
+// Opacity returns a Declaration of the css "opacity" property.
+func Opacity(value string) Declaration {
+	return Declaration{Property: "opacity", Value: value}
+}
+
+// Order returns a Declaration of the css "order" property.
+func Order(value string) Declaration {
+	return Declaration{Property: "order", Value: value}
+}
+
+// Orphans returns a Declaration of the css "orphans" property.
+func Orphans(value string) Declaration {
+	return Declaration{Property: "orphans", Value: value}
+}
+
+// Outline returns a Declaration of the css "outline" property.
+func Outline(value string) Declaration {
+	return Declaration{Property: "outline", Value: value}
+}
+
+// OutlineColor returns a Declaration of the css "outline-color" property.
+func OutlineColor(value string) Declaration {
+	return Declaration{Property: "outline-color", Value: value}
+}
+
+// OutlineOffset returns a Declaration of the css "outline-offset" property.
+func OutlineOffset(value string) Declaration {
+	return Declaration{Property: "outline-offset", Value: value}
+}
+
+// OutlineStyle returns a Declaration of the css "outline-style" property.
+func OutlineStyle(value string) Declaration {
+	return Declaration{Property: "outline-style", Value: value}
+}
+
+// OutlineWidth returns a Declaration of the css "outline-width" property.
+func OutlineWidth(value string) Declaration {
+	return Declaration{Property: "outline-width", Value: value}
+}
+
+// Overflow returns a Declaration of the css "overflow" property.
+func Overflow(value string) Declaration {
+	return Declaration{Property: "overflow", Value: value}
+}
+
+// OverflowAnchor returns a Declaration of the css "overflow-anchor" property.
+func OverflowAnchor(value string) Declaration {
+	return Declaration{Property: "overflow-anchor", Value: value}
+}
+
+// OverflowBlock returns a Declaration of the css "overflow-block" property.
+func OverflowBlock(value string) Declaration {
+	return Declaration{Property: "overflow-block", Value: value}
+}
+
+// OverflowClipMargin returns a Declaration of the css "overflow-clip-margin" property.
+func OverflowClipMargin(value string) Declaration {
+	return Declaration{Property: "overflow-clip-margin", Value: value}
+}
+
+// OverflowInline returns a Declaration of the css "overflow-inline" property.
+func OverflowInline(value string) Declaration {
+	return Declaration{Property: "overflow-inline", Value: value}
+}
+
+// OverflowWrap returns a Declaration of the css "overflow-wrap" property.
+func OverflowWrap(value string) Declaration {
+	return Declaration{Property: "overflow-wrap", Value: value}
+}
+
+// OverflowX returns a Declaration of the css "overflow-x" property.
+func OverflowX(value string) Declaration {
+	return Declaration{Property: "overflow-x", Value: value}
+}
+
+// OverflowY returns a Declaration of the css "overflow-y" property.
+func OverflowY(value string) Declaration {
+	return Declaration{Property: "overflow-y", Value: value}
+}
+
+// OverscrollBehavior returns a Declaration of the css "overscroll-behavior" property.
+func OverscrollBehavior(value string) Declaration {
+	return Declaration{Property: "overscroll-behavior", Value: value}
+}
+
+// OverscrollBehaviorBlock returns a Declaration of the css "overscroll-behavior-block" property.
+func OverscrollBehaviorBlock(value string) Declaration {
+	return Declaration{Property: "overscroll-behavior-block", Value: value}
+}
+
+// OverscrollBehaviorInline returns a Declaration of the css "overscroll-behavior-inline" property.
+func OverscrollBehaviorInline(value string) Declaration {
+	return Declaration{Property: "overscroll-behavior-inline", Value: value}
+}
+
+// OverscrollBehaviorX returns a Declaration of the css "overscroll-behavior-x" property.
+func OverscrollBehaviorX(value string) Declaration {
+	return Declaration{Property: "overscroll-behavior-x", Value: value}
+}
+
+// OverscrollBehaviorY returns a Declaration of the css "overscroll-behavior-y" property.
+func OverscrollBehaviorY(value string) Declaration {
+	return Declaration{Property: "overscroll-behavior-y", Value: value}
+}
+
+// Padding returns a Declaration of the css "padding" property.
+func Padding(value string) Declaration {
+	return Declaration{Property: "padding", Value: value}
+}
+
+// PaddingBlock returns a Declaration of the css "padding-block" property.
+func PaddingBlock(value string) Declaration {
+	return Declaration{Property: "padding-block", Value: value}
+}
+
+// PaddingBlockEnd returns a Declaration of the css "padding-block-end" property.
+func PaddingBlockEnd(value string) Declaration {
+	return Declaration{Property: "padding-block-end", Value: value}
+}
+
+// PaddingBlockStart returns a Declaration of the css "padding-block-start" property.
+func PaddingBlockStart(value string) Declaration {
+	return Declaration{Property: "padding-block-start", Value: value}
+}
+
+// PaddingBottom returns a Declaration of the css "padding-bottom" property.
+func PaddingBottom(value string) Declaration {
+	return Declaration{Property: "padding-bottom", Value: value}
+}
+
+// PaddingInline returns a Declaration of the css "padding-inline" property.
+func PaddingInline(value string) Declaration {
+	return Declaration{Property: "padding-inline", Value: value}
+}
+
+// PaddingInlineEnd returns a Declaration of the css "padding-inline-end" property.
+func PaddingInlineEnd(value string) Declaration {
+	return Declaration{Property: "padding-inline-end", Value: value}
+}
+
+// PaddingInlineStart returns a Declaration of the css "padding-inline-start" property.
+func PaddingInlineStart(value string) Declaration {
+	return Declaration{Property: "padding-inline-start", Value: value}
+}
+
+// PaddingLeft returns a Declaration of the css "padding-left" property.
+func PaddingLeft(value string) Declaration {
+	return Declaration{Property: "padding-left", Value: value}
+}
+
+// PaddingRight returns a Declaration of the css "padding-right" property.
+func PaddingRight(value string) Declaration {
+	return Declaration{Property: "padding-right", Value: value}
+}
+
+// PaddingTop returns a Declaration of the css "padding-top" property.
+func PaddingTop(value string) Declaration {
+	return Declaration{Property: "padding-top", Value: value}
+}
+
+// PageBreakAfter returns a Declaration of the css "page-break-after" property.
+func PageBreakAfter(value string) Declaration {
+	return Declaration{Property: "page-break-after", Value: value}
+}
+
+// PageBreakBefore returns a Declaration of the css "page-break-before" property.
+func PageBreakBefore(value string) Declaration {
+	return Declaration{Property: "page-break-before", Value: value}
+}
+
+// PageBreakInside returns a Declaration of the css "page-break-inside" property.
+func PageBreakInside(value string) Declaration {
+	return Declaration{Property: "page-break-inside", Value: value}
+}
+
+// PaintOrder returns a Declaration of the css "paint-order" property.
+func PaintOrder(value string) Declaration {
+	return Declaration{Property: "paint-order", Value: value}
+}
+
+// Perspective returns a Declaration of the css "perspective" property.
+func Perspective(value string) Declaration {
+	return Declaration{Property: "perspective", Value: value}
+}
+
+// PerspectiveOrigin returns a Declaration of the css "perspective-origin" property.
+func PerspectiveOrigin(value string) Declaration {
+	return Declaration{Property: "perspective-origin", Value: value}
+}
+
+// PlaceContent returns a Declaration of the css "place-content" property.
+func PlaceContent(value string) Declaration {
+	return Declaration{Property: "place-content", Value: value}
+}
+
+// PlaceItems returns a Declaration of the css "place-items" property.
+func PlaceItems(value string) Declaration {
+	return Declaration{Property: "place-items", Value: value}
+}
+
+// PlaceSelf returns a Declaration of the css "place-self" property.
+func PlaceSelf(value string) Declaration {
+	return Declaration{Property: "place-self", Value: value}
+}
+
+// PointerEvents returns a Declaration of the css "pointer-events" property.
+func PointerEvents(value string) Declaration {
+	return Declaration{Property: "pointer-events", Value: value}
+}
+
+// Position returns a Declaration of the css "position" property.
+func Position(value string) Declaration {
+	return Declaration{Property: "position", Value: value}
+}
+
+// PrintColorAdjust returns a Declaration of the css "print-color-adjust" property.
+func PrintColorAdjust(value string) Declaration {
+	return Declaration{Property: "print-color-adjust", Value: value}
+}
+
+// Quotes returns a Declaration of the css "quotes" property.
+func Quotes(value string) Declaration {
+	return Declaration{Property: "quotes", Value: value}
+}
+
+// R returns a Declaration of the css "r" property.
+func R(value string) Declaration {
+	return Declaration{Property: "r", Value: value}
+}
+
+// Resize returns a Declaration of the css "resize" property.
+func Resize(value string) Declaration {
+	return Declaration{Property: "resize", Value: value}
+}
+
+// Right returns a Declaration of the css "right" property.
+func Right(value string) Declaration {
+	return Declaration{Property: "right", Value: value}
+}
+
+// Rotate returns a Declaration of the css "rotate" property.
+func Rotate(value string) Declaration {
+	return Declaration{Property: "rotate", Value: value}
+}
+
+// RowGap returns a Declaration of the css "row-gap" property.
+func RowGap(value string) Declaration {
+	return Declaration{Property: "row-gap", Value: value}
+}
+
+// RubyAlign returns a Declaration of the css "ruby-align" property.
+func RubyAlign(value string) Declaration {
+	return Declaration{Property: "ruby-align", Value: value}
+}
+
+// RubyPosition returns a Declaration of the css "ruby-position" property.
+func RubyPosition(value string) Declaration {
+	return Declaration{Property: "ruby-position", Value: value}
+}
+
+// Rx returns a Declaration of the css "rx" property.
+func Rx(value string) Declaration {
+	return Declaration{Property: "rx", Value: value}
+}
+
+// Ry returns a Declaration of the css "ry" property.
+func Ry(value string) Declaration {
+	return Declaration{Property: "ry", Value: value}
+}
+
+// Scale returns a Declaration of the css "scale" property.
+func Scale(value string) Declaration {
+	return Declaration{Property: "scale", Value: value}
+}
+
+// ScrollBehavior returns a Declaration of the css "scroll-behavior" property.
+func ScrollBehavior(value string) Declaration {
+	return Declaration{Property: "scroll-behavior", Value: value}
+}
+
+// ScrollMargin returns a Declaration of the css "scroll-margin" property.
+func ScrollMargin(value string) Declaration {
+	return Declaration{Property: "scroll-margin", Value: value}
+}
+
+// ScrollMarginBlock returns a Declaration of the css "scroll-margin-block" property.
+func ScrollMarginBlock(value string) Declaration {
+	return Declaration{Property: "scroll-margin-block", Value: value}
+}
+
+// ScrollMarginBlockEnd returns a Declaration of the css "scroll-margin-block-end" property.
+func ScrollMarginBlockEnd(value string) Declaration {
+	return Declaration{Property: "scroll-margin-block-end", Value: value}
+}
+
+// ScrollMarginBlockStart returns a Declaration of the css "scroll-margin-block-start" property.
+func ScrollMarginBlockStart(value string) Declaration {
+	return Declaration{Property: "scroll-margin-block-start", Value: value}
+}
+
+// ScrollMarginBottom returns a Declaration of the css "scroll-margin-bottom" property.
+func ScrollMarginBottom(value string) Declaration {
+	return Declaration{Property: "scroll-margin-bottom", Value: value}
+}
+
+// ScrollMarginInline returns a Declaration of the css "scroll-margin-inline" property.
+func ScrollMarginInline(value string) Declaration {
+	return Declaration{Property: "scroll-margin-inline", Value: value}
+}
+
+// ScrollMarginInlineEnd returns a Declaration of the css "scroll-margin-inline-end" property.
+func ScrollMarginInlineEnd(value string) Declaration {
+	return Declaration{Property: "scroll-margin-inline-end", Value: value}
+}
+
+// ScrollMarginInlineStart returns a Declaration of the css "scroll-margin-inline-start" property.
+func ScrollMarginInlineStart(value string) Declaration {
+	return Declaration{Property: "scroll-margin-inline-start", Value: value}
+}
+
+// ScrollMarginLeft returns a Declaration of the css "scroll-margin-left" property.
+func ScrollMarginLeft(value string) Declaration {
+	return Declaration{Property: "scroll-margin-left", Value: value}
+}
+
+// ScrollMarginRight returns a Declaration of the css "scroll-margin-right" property.
+func ScrollMarginRight(value string) Declaration {
+	return Declaration{Property: "scroll-margin-right", Value: value}
+}
+
+// ScrollMarginTop returns a Declaration of the css "scroll-margin-top" property.
+func ScrollMarginTop(value string) Declaration {
+	return Declaration{Property: "scroll-margin-top", Value: value}
+}
+
+// ScrollPadding returns a Declaration of the css "scroll-padding" property.
+func ScrollPadding(value string) Declaration {
+	return Declaration{Property: "scroll-padding", Value: value}
+}
+
+// ScrollPaddingBlock returns a Declaration of the css "scroll-padding-block" property.
+func ScrollPaddingBlock(value string) Declaration {
+	return Declaration{Property: "scroll-padding-block", Value: value}
+}
+
+// ScrollPaddingBlockEnd returns a Declaration of the css "scroll-padding-block-end" property.
+func ScrollPaddingBlockEnd(value string) Declaration {
+	return Declaration{Property: "scroll-padding-block-end", Value: value}
+}
+
+// ScrollPaddingBlockStart returns a Declaration of the css "scroll-padding-block-start" property.
+func ScrollPaddingBlockStart(value string) Declaration {
+	return Declaration{Property: "scroll-padding-block-start", Value: value}
+}
+
+// ScrollPaddingBottom returns a Declaration of the css "scroll-padding-bottom" property.
+func ScrollPaddingBottom(value string) Declaration {
+	return Declaration{Property: "scroll-padding-bottom", Value: value}
+}
+
+// ScrollPaddingInline returns a Declaration of the css "scroll-padding-inline" property.
+func ScrollPaddingInline(value string) Declaration {
+	return Declaration{Property: "scroll-padding-inline", Value: value}
+}
+
+// ScrollPaddingInlineEnd returns a Declaration of the css "scroll-padding-inline-end" property.
+func ScrollPaddingInlineEnd(value string) Declaration {
+	return Declaration{Property: "scroll-padding-inline-end", Value: value}
+}
+
+// ScrollPaddingInlineStart returns a Declaration of the css "scroll-padding-inline-start" property.
+func ScrollPaddingInlineStart(value string) Declaration {
+	return Declaration{Property: "scroll-padding-inline-start", Value: value}
+}
+
+// ScrollPaddingLeft returns a Declaration of the css "scroll-padding-left" property.
+func ScrollPaddingLeft(value string) Declaration {
+	return Declaration{Property: "scroll-padding-left", Value: value}
+}
+
+// ScrollPaddingRight returns a Declaration of the css "scroll-padding-right" property.
+func ScrollPaddingRight(value string) Declaration {
+	return Declaration{Property: "scroll-padding-right", Value: value}
+}
+
+// ScrollPaddingTop returns a Declaration of the css "scroll-padding-top" property.
+func ScrollPaddingTop(value string) Declaration {
+	return Declaration{Property: "scroll-padding-top", Value: value}
+}
+
+// ScrollSnapAlign returns a Declaration of the css "scroll-snap-align" property.
+func ScrollSnapAlign(value string) Declaration {
+	return Declaration{Property: "scroll-snap-align", Value: value}
+}
+
+// ScrollSnapStop returns a Declaration of the css "scroll-snap-stop" property.
+func ScrollSnapStop(value string) Declaration {
+	return Declaration{Property: "scroll-snap-stop", Value: value}
+}
+
+// ScrollSnapType returns a Declaration of the css "scroll-snap-type" property.
+func ScrollSnapType(value string) Declaration {
+	return Declaration{Property: "scroll-snap-type", Value: value}
+}
+
+// ScrollbarColor returns a Declaration of the css "scrollbar-color" property.
+func ScrollbarColor(value string) Declaration {
+	return Declaration{Property: "scrollbar-color", Value: value}
+}
+
+// ScrollbarGutter returns a Declaration of the css "scrollbar-gutter" property.
+func ScrollbarGutter(value string) Declaration {
+	return Declaration{Property: "scrollbar-gutter", Value: value}
+}
+
+// ScrollbarWidth returns a Declaration of the css "scrollbar-width" property.
+func ScrollbarWidth(value string) Declaration {
+	return Declaration{Property: "scrollbar-width", Value: value}
+}
+
+// ShapeImageThreshold returns a Declaration of the css "shape-image-threshold" property.
+func ShapeImageThreshold(value string) Declaration {
+	return Declaration{Property: "shape-image-threshold", Value: value}
+}
+
+// ShapeMargin returns a Declaration of the css "shape-margin" property.
+func ShapeMargin(value string) Declaration {
+	return Declaration{Property: "shape-margin", Value: value}
+}
+
+// ShapeOutside returns a Declaration of the css "shape-outside" property.
+func ShapeOutside(value string) Declaration {
+	return Declaration{Property: "shape-outside", Value: value}
+}
+
+// ShapeRendering returns a Declaration of the css "shape-rendering" property.
+func ShapeRendering(value string) Declaration {
+	return Declaration{Property: "shape-rendering", Value: value}
+}
+
+// StopColor returns a Declaration of the css "stop-color" property.
+func StopColor(value string) Declaration {
+	return Declaration{Property: "stop-color", Value: value}
+}
+
+// StopOpacity returns a Declaration of the css "stop-opacity" property.
+func StopOpacity(value string) Declaration {
+	return Declaration{Property: "stop-opacity", Value: value}
+}
+
+// Stroke returns a Declaration of the css "stroke" property.
+func Stroke(value string) Declaration {
+	return Declaration{Property: "stroke", Value: value}
+}
+
+// StrokeDasharray returns a Declaration of the css "stroke-dasharray" property.
+func StrokeDasharray(value string) Declaration {
+	return Declaration{Property: "stroke-dasharray", Value: value}
+}
+
+// StrokeDashoffset returns a Declaration of the css "stroke-dashoffset" property.
+func StrokeDashoffset(value string) Declaration {
+	return Declaration{Property: "stroke-dashoffset", Value: value}
+}
+
+// StrokeLinecap returns a Declaration of the css "stroke-linecap" property.
+func StrokeLinecap(value string) Declaration {
+	return Declaration{Property: "stroke-linecap", Value: value}
+}
+
+// StrokeLinejoin returns a Declaration of the css "stroke-linejoin" property.
+func StrokeLinejoin(value string) Declaration {
+	return Declaration{Property: "stroke-linejoin", Value: value}
+}
+
+// StrokeMiterlimit returns a Declaration of the css "stroke-miterlimit" property.
+func StrokeMiterlimit(value string) Declaration {
+	return Declaration{Property: "stroke-miterlimit", Value: value}
+}
+
+// StrokeOpacity returns a Declaration of the css "stroke-opacity" property.
+func StrokeOpacity(value string) Declaration {
+	return Declaration{Property: "stroke-opacity", Value: value}
+}
+
+// StrokeWidth returns a Declaration of the css "stroke-width" property.
+func StrokeWidth(value string) Declaration {
+	return Declaration{Property: "stroke-width", Value: value}
+}
+
+// TabSize returns a Declaration of the css "tab-size" property.
+func TabSize(value string) Declaration {
+	return Declaration{Property: "tab-size", Value: value}
+}
+
+// TableLayout returns a Declaration of the css "table-layout" property.
+func TableLayout(value string) Declaration {
+	return Declaration{Property: "table-layout", Value: value}
+}
+
+// TextAlign returns a Declaration of the css "text-align" property.
+func TextAlign(value string) Declaration {
+	return Declaration{Property: "text-align", Value: value}
+}
+
+// TextAlignLast returns a Declaration of the css "text-align-last" property.
+func TextAlignLast(value string) Declaration {
+	return Declaration{Property: "text-align-last", Value: value}
+}
+
+// TextAnchor returns a Declaration of the css "text-anchor" property.
+func TextAnchor(value string) Declaration {
+	return Declaration{Property: "text-anchor", Value: value}
+}
+
+// TextCombineUpright returns a Declaration of the css "text-combine-upright" property.
+func TextCombineUpright(value string) Declaration {
+	return Declaration{Property: "text-combine-upright", Value: value}
+}
+
+// TextDecoration returns a Declaration of the css "text-decoration" property.
+func TextDecoration(value string) Declaration {
+	return Declaration{Property: "text-decoration", Value: value}
+}
+
+// TextDecorationColor returns a Declaration of the css "text-decoration-color" property.
+func TextDecorationColor(value string) Declaration {
+	return Declaration{Property: "text-decoration-color", Value: value}
+}
+
+// TextDecorationLine returns a Declaration of the css "text-decoration-line" property.
+func TextDecorationLine(value string) Declaration {
+	return Declaration{Property: "text-decoration-line", Value: value}
+}
+
+// TextDecorationSkipInk returns a Declaration of the css "text-decoration-skip-ink" property.
+func TextDecorationSkipInk(value string) Declaration {
+	return Declaration{Property: "text-decoration-skip-ink", Value: value}
+}
+
+// TextDecorationStyle returns a Declaration of the css "text-decoration-style" property.
+func TextDecorationStyle(value string) Declaration {
+	return Declaration{Property: "text-decoration-style", Value: value}
+}
+
+// TextDecorationThickness returns a Declaration of the css "text-decoration-thickness" property.
+func TextDecorationThickness(value string) Declaration {
+	return Declaration{Property: "text-decoration-thickness", Value: value}
+}
+
+// TextEmphasis returns a Declaration of the css "text-emphasis" property.
+func TextEmphasis(value string) Declaration {
+	return Declaration{Property: "text-emphasis", Value: value}
+}
+
+// TextEmphasisColor returns a Declaration of the css "text-emphasis-color" property.
+func TextEmphasisColor(value string) Declaration {
+	return Declaration{Property: "text-emphasis-color", Value: value}
+}
+
+// TextEmphasisPosition returns a Declaration of the css "text-emphasis-position" property.
+func TextEmphasisPosition(value string) Declaration {
+	return Declaration{Property: "text-emphasis-position", Value: value}
+}
+
+// TextEmphasisStyle returns a Declaration of the css "text-emphasis-style" property.
+func TextEmphasisStyle(value string) Declaration {
+	return Declaration{Property: "text-emphasis-style", Value: value}
+}
+
+// TextIndent returns a Declaration of the css "text-indent" property.
+func TextIndent(value string) Declaration {
+	return Declaration{Property: "text-indent", Value: value}
+}
+
+// TextJustify returns a Declaration of the css "text-justify" property.
+func TextJustify(value string) Declaration {
+	return Declaration{Property: "text-justify", Value: value}
+}
+
+// TextOrientation returns a Declaration of the css "text-orientation" property.
+func TextOrientation(value string) Declaration {
+	return Declaration{Property: "text-orientation", Value: value}
+}
+
+// TextOverflow returns a Declaration of the css "text-overflow" property.
+func TextOverflow(value string) Declaration {
+	return Declaration{Property: "text-overflow", Value: value}
+}
+
+// TextRendering returns a Declaration of the css "text-rendering" property.
+func TextRendering(value string) Declaration {
+	return Declaration{Property: "text-rendering", Value: value}
+}
+
+// TextShadow returns a Declaration of the css "text-shadow" property.
+func TextShadow(value string) Declaration {
+	return Declaration{Property: "text-shadow", Value: value}
+}
+
+// TextTransform returns a Declaration of the css "text-transform" property.
+func TextTransform(value string) Declaration {
+	return Declaration{Property: "text-transform", Value: value}
+}
+
+// TextUnderlineOffset returns a Declaration of the css "text-underline-offset" property.
+func TextUnderlineOffset(value string) Declaration {
+	return Declaration{Property: "text-underline-offset", Value: value}
+}
+
+// TextUnderlinePosition returns a Declaration of the css "text-underline-position" property.
+func TextUnderlinePosition(value string) Declaration {
+	return Declaration{Property: "text-underline-position", Value: value}
+}
+
+// Top returns a Declaration of the css "top" property.
+func Top(value string) Declaration {
+	return Declaration{Property: "top", Value: value}
+}
+
+// TouchAction returns a Declaration of the css "touch-action" property.
+func TouchAction(value string) Declaration {
+	return Declaration{Property: "touch-action", Value: value}
+}
+
+// Transform returns a Declaration of the css "transform" property.
+func Transform(value string) Declaration {
+	return Declaration{Property: "transform", Value: value}
+}
+
+// TransformBox returns a Declaration of the css "transform-box" property.
+func TransformBox(value string) Declaration {
+	return Declaration{Property: "transform-box", Value: value}
+}
+
+// TransformOrigin returns a Declaration of the css "transform-origin" property.
+func TransformOrigin(value string) Declaration {
+	return Declaration{Property: "transform-origin", Value: value}
+}
+
+// TransformStyle returns a Declaration of the css "transform-style" property.
+func TransformStyle(value string) Declaration {
+	return Declaration{Property: "transform-style", Value: value}
+}
+
+// Transition returns a Declaration of the css "transition" property.
+func Transition(value string) Declaration {
+	return Declaration{Property: "transition", Value: value}
+}
+
+// TransitionDelay returns a Declaration of the css "transition-delay" property.
+func TransitionDelay(value string) Declaration {
+	return Declaration{Property: "transition-delay", Value: value}
+}
+
+// TransitionDuration returns a Declaration of the css "transition-duration" property.
+func TransitionDuration(value string) Declaration {
+	return Declaration{Property: "transition-duration", Value: value}
+}
+
+// TransitionProperty returns a Declaration of the css "transition-property" property.
+func TransitionProperty(value string) Declaration {
+	return Declaration{Property: "transition-property", Value: value}
+}
+
+// TransitionTimingFunction returns a Declaration of the css "transition-timing-function" property.
+func TransitionTimingFunction(value string) Declaration {
+	return Declaration{Property: "transition-timing-function", Value: value}
+}
+
+// Translate returns a Declaration of the css "translate" property.
+func Translate(value string) Declaration {
+	return Declaration{Property: "translate", Value: value}
+}
+
+// UnicodeBidi returns a Declaration of the css "unicode-bidi" property.
+func UnicodeBidi(value string) Declaration {
+	return Declaration{Property: "unicode-bidi", Value: value}
+}
+
+// UserSelect returns a Declaration of the css "user-select" property.
+func UserSelect(value string) Declaration {
+	return Declaration{Property: "user-select", Value: value}
+}
+
+// VectorEffect returns a Declaration of the css "vector-effect" property.
+func VectorEffect(value string) Declaration {
+	return Declaration{Property: "vector-effect", Value: value}
+}
+
+// VerticalAlign returns a Declaration of the css "vertical-align" property.
+func VerticalAlign(value string) Declaration {
+	return Declaration{Property: "vertical-align", Value: value}
+}
+
+// Visibility returns a Declaration of the css "visibility" property.
+func Visibility(value string) Declaration {
+	return Declaration{Property: "visibility", Value: value}
+}
+
+// WhiteSpace returns a Declaration of the css "white-space" property.
+func WhiteSpace(value string) Declaration {
+	return Declaration{Property: "white-space", Value: value}
+}
+
+// Widows returns a Declaration of the css "widows" property.
+func Widows(value string) Declaration {
+	return Declaration{Property: "widows", Value: value}
+}
+
+// Width returns a Declaration of the css "width" property.
+func Width(value string) Declaration {
+	return Declaration{Property: "width", Value: value}
+}
+
+// WillChange returns a Declaration of the css "will-change" property.
+func WillChange(value string) Declaration {
+	return Declaration{Property: "will-change", Value: value}
+}
+
+// WordBreak returns a Declaration of the css "word-break" property.
+func WordBreak(value string) Declaration {
+	return Declaration{Property: "word-break", Value: value}
+}
+
+// WordSpacing returns a Declaration of the css "word-spacing" property.
+func WordSpacing(value string) Declaration {
+	return Declaration{Property: "word-spacing", Value: value}
+}
+
+// WordWrap returns a Declaration of the css "word-wrap" property.
+func WordWrap(value string) Declaration {
+	return Declaration{Property: "word-wrap", Value: value}
+}
+
+// WritingMode returns a Declaration of the css "writing-mode" property.
+func WritingMode(value string) Declaration {
+	return Declaration{Property: "writing-mode", Value: value}
+}
+
+// X returns a Declaration of the css "x" property.
+func X(value string) Declaration {
+	return Declaration{Property: "x", Value: value}
+}
+
+// Y returns a Declaration of the css "y" property.
+func Y(value string) Declaration {
+	return Declaration{Property: "y", Value: value}
+}
+
+// ZIndex returns a Declaration of the css "z-index" property.
+func ZIndex(value string) Declaration {
+	return Declaration{Property: "z-index", Value: value}
+}
+
+// Zoom returns a Declaration of the css "zoom" property.
+func Zoom(value string) Declaration {
+	return Declaration{Property: "zoom", Value: value}
+}
@@ -0,0 +1,96 @@
+package css
+
+import (
+	"sort"
+	"text/template"
+
+	bcss "github.com/aymerick/douceur/css"
+)
+
+// TokenPrefix defines the prefix of the css custom properties of tokens.
+const TokenPrefix = "--gu-"
+
+// Theme defines a set of design tokens, such as colors, spacing and
+// typography, which are written as css custom properties for use by the
+// stylesheets of all components. Tokens are named by their group, where the
+// "primary" color is the "color-primary" token, and tokens within Tokens
+// are named as is.
+type Theme struct {
+	Name       string
+	Colors     map[string]string
+	Spacing    map[string]string
+	Typography map[string]string
+	Tokens     map[string]string
+}
+
+// Token returns the value of the token within the theme, or an empty string
+// if the theme has no such token.
+func (t *Theme) Token(name string) string {
+	return t.tokens()[name]
+}
+
+// Declarations returns the css custom properties of the tokens sorted by
+// name, such as "--gu-color-primary".
+func (t *Theme) Declarations() []Declaration {
+	tokens := t.tokens()
+
+	names := make([]string, 0, len(tokens))
+	for name := range tokens {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	declarations := make([]Declaration, 0, len(names))
+	for _, name := range names {
+		declarations = append(declarations, Prop(TokenPrefix+name, tokens[name]))
+	}
+
+	return declarations
+}
+
+// Stylesheet returns the stylesheet setting the custom properties of the
+// tokens on the :root element.
+func (t *Theme) Stylesheet() *bcss.Stylesheet {
+	items := make([]Item, 0, len(t.Colors)+len(t.Spacing)+len(t.Typography)+len(t.Tokens))
+	for _, declaration := range t.Declarations() {
+		items = append(items, declaration)
+	}
+
+	return &bcss.Stylesheet{Rules: buildBlock([]string{":root"}, nil, 0, items)}
+}
+
+// tokens returns the tokens of all groups by their names.
+func (t *Theme) tokens() map[string]string {
+	tokens := make(map[string]string)
+
+	groups := []struct {
+		prefix string
+		tokens map[string]string
+	}{
+		{"color-", t.Colors},
+		{"spacing-", t.Spacing},
+		{"font-", t.Typography},
+		{"", t.Tokens},
+	}
+
+	for _, group := range groups {
+		for name, value := range group.tokens {
+			tokens[group.prefix+name] = value
+		}
+	}
+
+	return tokens
+}
+
+// Var returns the css value referring to the custom property of the token,
+// such as "var(--gu-color-primary)", which follows the active theme.
+func Var(token string) string {
+	return "var(" + TokenPrefix + token + ")"
+}
+
+// funcs contains the functions available within rule templates, where
+// {{ token "color-primary" }} writes the value of Var.
+var funcs = template.FuncMap{
+	"token": Var,
+}
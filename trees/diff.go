@@ -0,0 +1,351 @@
+package trees
+
+// PatchOpType defines the type of a operation within a patch produced by Diff.
+type PatchOpType string
+
+// contains the operation types produced by Diff.
+const (
+	// InsertOp inserts the Markup of a new node at Index within Parent.
+	InsertOp PatchOpType = "insert"
+
+	// RemoveOp removes the node found at Index within Parent.
+	RemoveOp PatchOpType = "remove"
+
+	// MoveOp moves the node found at From within Parent to Index.
+	MoveOp PatchOpType = "move"
+
+	// SetAttrOp sets the attribute Name of the node to Value.
+	SetAttrOp PatchOpType = "set-attr"
+
+	// RemoveAttrOp removes the attribute Name of the node.
+	RemoveAttrOp PatchOpType = "remove-attr"
+
+	// SetStyleOp sets the style Name of the node to Value.
+	SetStyleOp PatchOpType = "set-style"
+
+	// RemoveStyleOp removes the style Name of the node.
+	RemoveStyleOp PatchOpType = "remove-style"
+
+	// SetTextOp sets the text content of the node to Value. Text nodes lack
+	// uids, hence they are addressed by their Index within Parent.
+	SetTextOp PatchOpType = "set-text"
+
+	// BindEventOp binds the Event to the node.
+	BindEventOp PatchOpType = "bind-event"
+
+	// UnbindEventOp unbinds the event Name from the node.
+	UnbindEventOp PatchOpType = "unbind-event"
+)
+
+// PatchOp defines a single operation which changes a rendered tree, addressing
+// nodes by their uid. Nodes lacking uids such as text nodes are addressed by
+// their Index within their Parent, which is the position among the parent's
+// children at the time the operation is applied.
+type PatchOp struct {
+	Type   PatchOpType `json:"type"`
+	UID    string      `json:"uid,omitempty"`
+	Parent string      `json:"parent,omitempty"`
+	Index  int         `json:"index"`
+	From   int         `json:"from,omitempty"`
+	Name   string      `json:"name,omitempty"`
+	Value  string      `json:"value,omitempty"`
+	Markup string      `json:"markup,omitempty"`
+	Event  *EventJSON  `json:"event,omitempty"`
+}
+
+// Diff returns the ordered list of operations which change the rendered old
+// tree into the new tree. Children are matched the same way Reconcile matches
+// them, by key when keyed and else by position, with moved children found
+// through the longest increasing subsequence of their old positions to keep
+// the total of moves minimal. Existing nodes are addressed by the uids from
+// the old tree, while inserted nodes carry the uids of the new tree. Children
+// marked as removed are ignored. Diff does not change either tree.
+func Diff(old, next *Markup) []PatchOp {
+	var ops []PatchOp
+
+	if old.Name() != next.Name() {
+		var parent string
+		var index int
+
+		if old.parent != nil {
+			parent = old.parent.UID()
+			index = childIndex(old.parent, old)
+		}
+
+		ops = append(ops, PatchOp{Type: RemoveOp, UID: nodeUID(old), Parent: parent, Index: index})
+		return insertOps(ops, next, parent, index)
+	}
+
+	return diffNode(ops, old, next)
+}
+
+// diffNode appends the operations changing the old node into the new node,
+// which are of the same tag.
+func diffNode(ops []PatchOp, old, next *Markup) []PatchOp {
+	if next.Name() == "text" || next.IsRawHTML() {
+		return ops
+	}
+
+	uid := old.UID()
+
+	ops = diffProperties(ops, SetAttrOp, RemoveAttrOp, uid, old.Attributes(), next.Attributes())
+	ops = diffProperties(ops, SetStyleOp, RemoveStyleOp, uid, old.Styles(), next.Styles())
+	ops = diffEvents(ops, uid, old.Events(), next.Events())
+
+	if text := next.TextContent(); text != old.TextContent() {
+		ops = append(ops, PatchOp{Type: SetTextOp, UID: uid, Value: text})
+	}
+
+	oldChildren := liveChildren(old)
+	newChildren := liveChildren(next)
+
+	var sources []int
+	if hasKeys(newChildren) || hasKeys(oldChildren) {
+		sources = matchKeyed(newChildren, oldChildren)
+	} else {
+		sources = matchPositioned(newChildren, oldChildren)
+	}
+
+	matched := make([]bool, len(oldChildren))
+	for _, source := range sources {
+		if source >= 0 {
+			matched[source] = true
+		}
+	}
+
+	// Remove unmatched children from the last, keeping the indexes of those
+	// before them valid.
+	for index := len(oldChildren) - 1; index >= 0; index-- {
+		if matched[index] {
+			continue
+		}
+
+		ops = append(ops, PatchOp{
+			Type:   RemoveOp,
+			UID:    nodeUID(oldChildren[index]),
+			Parent: uid,
+			Index:  index,
+		})
+	}
+
+	// current holds the children within the parent as operations are applied,
+	// where old children are stored by their index and new ones as -(index+1).
+	var current []int
+	for index := range oldChildren {
+		if matched[index] {
+			current = append(current, index)
+		}
+	}
+
+	stable := longestIncreasing(sources)
+
+	// Place children from the last, before the child which follows them.
+	for index := len(newChildren) - 1; index >= 0; index-- {
+		source := sources[index]
+		if source >= 0 && stable[index] {
+			continue
+		}
+
+		var from = -1
+		if source >= 0 {
+			from = slotIndex(current, source)
+			current = append(current[:from], current[from+1:]...)
+		}
+
+		at := len(current)
+		if index < len(newChildren)-1 {
+			follow := sources[index+1]
+			if follow < 0 {
+				follow = -(index + 2)
+			}
+
+			at = slotIndex(current, follow)
+		}
+
+		if source < 0 {
+			current = insertSlot(current, at, -(index + 1))
+			ops = insertOps(ops, newChildren[index], uid, at)
+			continue
+		}
+
+		current = insertSlot(current, at, source)
+		ops = append(ops, PatchOp{
+			Type:   MoveOp,
+			UID:    nodeUID(oldChildren[source]),
+			Parent: uid,
+			From:   from,
+			Index:  at,
+		})
+	}
+
+	for index, nch := range newChildren {
+		source := sources[index]
+		if source < 0 {
+			continue
+		}
+
+		och := oldChildren[source]
+
+		// Text turned into a comment or doctype, or back, is replaced.
+		if nch.Name() == "text" && (nch.IsComment() != och.IsComment() || nch.IsDoctype() != och.IsDoctype()) {
+			ops = append(ops, PatchOp{Type: RemoveOp, Parent: uid, Index: index})
+			ops = insertOps(ops, nch, uid, index)
+			continue
+		}
+
+		if nch.Name() == "text" {
+			if text := nch.TextContent(); text != och.TextContent() {
+				ops = append(ops, PatchOp{Type: SetTextOp, Parent: uid, Index: index, Value: text})
+			}
+
+			continue
+		}
+
+		// Raw html has no uid to address it, hence it's replaced.
+		if nch.IsRawHTML() {
+			if nch.TextContent() != och.TextContent() {
+				ops = append(ops, PatchOp{Type: RemoveOp, Parent: uid, Index: index})
+				ops = insertOps(ops, nch, uid, index)
+			}
+
+			continue
+		}
+
+		ops = diffNode(ops, och, nch)
+	}
+
+	return ops
+}
+
+// insertOps appends the operation inserting the provided node at the index
+// within the parent and the operations binding the node's events.
+func insertOps(ops []PatchOp, node *Markup, parent string, index int) []PatchOp {
+	ops = append(ops, PatchOp{
+		Type:   InsertOp,
+		UID:    nodeUID(node),
+		Parent: parent,
+		Index:  index,
+		Markup: node.HTML(),
+	})
+
+	node.EachEvent(func(event *Event, owner *Markup) {
+		ej := event.EventJSON()
+		ops = append(ops, PatchOp{Type: BindEventOp, UID: owner.UID(), Name: event.Type, Event: &ej})
+	})
+
+	return ops
+}
+
+// diffProperties appends the operations changing the old properties into the
+// new properties using the provided operation types.
+func diffProperties(ops []PatchOp, set, remove PatchOpType, uid string, old, next []Property) []PatchOp {
+	oldValues := make(map[string]string, len(old))
+	for _, property := range old {
+		name, value := property.Render()
+		oldValues[name] = value
+	}
+
+	newValues := make(map[string]bool, len(next))
+	for _, property := range next {
+		name, value := property.Render()
+		newValues[name] = true
+
+		if current, ok := oldValues[name]; ok && current == value {
+			continue
+		}
+
+		ops = append(ops, PatchOp{Type: set, UID: uid, Name: name, Value: value})
+	}
+
+	for _, property := range old {
+		if name, _ := property.Render(); !newValues[name] {
+			ops = append(ops, PatchOp{Type: remove, UID: uid, Name: name})
+		}
+	}
+
+	return ops
+}
+
+// diffEvents appends the operations changing the events bound to a node,
+// where events are matched by their type.
+func diffEvents(ops []PatchOp, uid string, old, next []Event) []PatchOp {
+	oldTypes := make(map[string]bool, len(old))
+	for _, event := range old {
+		oldTypes[event.Type] = true
+	}
+
+	newTypes := make(map[string]bool, len(next))
+	for _, event := range next {
+		newTypes[event.Type] = true
+
+		if oldTypes[event.Type] {
+			continue
+		}
+
+		ej := event.EventJSON()
+		ops = append(ops, PatchOp{Type: BindEventOp, UID: uid, Name: event.Type, Event: &ej})
+	}
+
+	for _, event := range old {
+		if !newTypes[event.Type] {
+			ops = append(ops, PatchOp{Type: UnbindEventOp, UID: uid, Name: event.Type})
+		}
+	}
+
+	return ops
+}
+
+// liveChildren returns the children of the markup which are not marked as
+// removed.
+func liveChildren(e *Markup) []*Markup {
+	var children []*Markup
+	for _, child := range e.children {
+		if !child.Removed() {
+			children = append(children, child)
+		}
+	}
+
+	return children
+}
+
+// childIndex returns the index of the child among the live children of the
+// parent.
+func childIndex(parent, child *Markup) int {
+	for index, item := range liveChildren(parent) {
+		if item == child {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// nodeUID returns the uid used to address the node, which is empty for text
+// nodes as they are not rendered with one.
+func nodeUID(e *Markup) string {
+	if e.Name() == "text" || e.IsRawHTML() {
+		return ""
+	}
+
+	return e.UID()
+}
+
+// slotIndex returns the position of the slot within the provided slots.
+func slotIndex(slots []int, slot int) int {
+	for index, item := range slots {
+		if item == slot {
+			return index
+		}
+	}
+
+	return len(slots)
+}
+
+// insertSlot returns the slots with the provided slot inserted at the index.
+func insertSlot(slots []int, index int, slot int) []int {
+	slots = append(slots, 0)
+	copy(slots[index+1:], slots[index:])
+	slots[index] = slot
+
+	return slots
+}
@@ -0,0 +1,168 @@
+package trees_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// applyListOps applies the structural operations of the provided patch to the
+// keys of a list, returning the resulting keys. The keys of inserted items are
+// taken from the new list by uid.
+func applyListOps(keys []string, uids map[string]string, ops []trees.PatchOp) []string {
+	current := append([]string(nil), keys...)
+
+	for _, op := range ops {
+		switch op.Type {
+		case trees.RemoveOp:
+			current = append(current[:op.Index], current[op.Index+1:]...)
+		case trees.InsertOp:
+			current = append(current[:op.Index], append([]string{uids[op.UID]}, current[op.Index:]...)...)
+		case trees.MoveOp:
+			key := current[op.From]
+			current = append(current[:op.From], current[op.From+1:]...)
+			current = append(current[:op.Index], append([]string{key}, current[op.Index:]...)...)
+		}
+	}
+
+	return current
+}
+
+// countOps returns the total of operations of the provided type.
+func countOps(ops []trees.PatchOp, kind trees.PatchOpType) int {
+	var total int
+	for _, op := range ops {
+		if op.Type == kind {
+			total++
+		}
+	}
+
+	return total
+}
+
+// TestDiffKeyedList validates the structural operations for keyed lists.
+func TestDiffKeyedList(t *testing.T) {
+	cases := []struct {
+		old   []string
+		new   []string
+		moves int
+	}{
+		{old: []string{"a", "b", "c"}, new: []string{"b", "c", "a"}, moves: 1},
+		{old: []string{"a", "b", "c", "d"}, new: []string{"e", "b", "c", "a"}, moves: 1},
+		{old: []string{"a", "b", "c"}, new: []string{"c", "b", "a"}, moves: 2},
+		{old: []string{"a", "b"}, new: []string{"x", "a", "b", "y"}, moves: 0},
+		{old: []string{"a", "b", "c"}, new: nil, moves: 0},
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		old := rng.Perm(12)[:8]
+		next := rng.Perm(12)[:9]
+
+		var oldKeys, newKeys []string
+		for _, item := range old {
+			oldKeys = append(oldKeys, fmt.Sprintf("%d", item))
+		}
+		for _, item := range next {
+			newKeys = append(newKeys, fmt.Sprintf("%d", item))
+		}
+
+		cases = append(cases, struct {
+			old   []string
+			new   []string
+			moves int
+		}{old: oldKeys, new: newKeys, moves: -1})
+	}
+
+	for _, item := range cases {
+		old := keyedList(item.old...)
+		next := keyedList(item.new...)
+
+		uids := make(map[string]string)
+		for _, child := range next.Children() {
+			uids[child.UID()] = child.Key()
+		}
+
+		ops := trees.Diff(old, next)
+		result := applyListOps(item.old, uids, ops)
+
+		if len(result) != 0 || len(item.new) != 0 {
+			if !reflect.DeepEqual(result, item.new) {
+				t.Fatalf("\t%s\t  Should have patched %+q into %+q: %+q", failed, item.old, item.new, result)
+			}
+		}
+
+		if item.moves >= 0 && countOps(ops, trees.MoveOp) != item.moves {
+			t.Fatalf("\t%s\t  Should have moved %d items patching %+q into %+q: %+v", failed, item.moves, item.old, item.new, ops)
+		}
+	}
+	t.Logf("\t%s\t  Should have patched keyed lists with minimal moves", success)
+}
+
+// TestDiffProperties validates the operations for attributes, styles, text and
+// events.
+func TestDiffProperties(t *testing.T) {
+	old := trees.NewMarkup("div", false)
+	trees.NewAttr("class", "box").Apply(old)
+	trees.NewAttr("title", "old").Apply(old)
+	trees.NewCSSStyle("width", "10px").Apply(old)
+	trees.NewText("hello").Apply(old)
+	trees.NewEvent("click", "", false, false, false, false).Apply(old)
+
+	next := trees.NewMarkup("div", false)
+	trees.NewAttr("class", "box wide").Apply(next)
+	trees.NewCSSStyle("height", "10px").Apply(next)
+	trees.NewText("world").Apply(next)
+	trees.NewEvent("keyup", "", false, false, false, false).Apply(next)
+
+	ops := trees.Diff(old, next)
+
+	expected := []trees.PatchOp{
+		{Type: trees.SetAttrOp, UID: old.UID(), Name: "class", Value: "box wide"},
+		{Type: trees.RemoveAttrOp, UID: old.UID(), Name: "title"},
+		{Type: trees.SetStyleOp, UID: old.UID(), Name: "height", Value: "10px"},
+		{Type: trees.RemoveStyleOp, UID: old.UID(), Name: "width"},
+		{Type: trees.UnbindEventOp, UID: old.UID(), Name: "click"},
+		{Type: trees.SetTextOp, Parent: old.UID(), Index: 0, Value: "world"},
+	}
+
+	for _, op := range expected {
+		var found bool
+		for _, item := range ops {
+			if item.Type == op.Type && item.UID == op.UID && item.Parent == op.Parent && item.Name == op.Name && item.Value == op.Value && item.Index == op.Index {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Fatalf("\t%s\t  Should have produced operation %+v: %+v", failed, op, ops)
+		}
+	}
+	t.Logf("\t%s\t  Should have produced property operations", success)
+
+	if countOps(ops, trees.BindEventOp) != 1 {
+		t.Fatalf("\t%s\t  Should have bound keyup event: %+v", failed, ops)
+	}
+	t.Logf("\t%s\t  Should have bound keyup event", success)
+
+	data, err := json.Marshal(ops)
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have encoded operations as JSON: %+q", failed, err)
+	}
+
+	var decoded []trees.PatchOp
+	if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(decoded, ops) {
+		t.Fatalf("\t%s\t  Should have decoded operations from JSON: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have round tripped operations through JSON", success)
+
+	if ops := trees.Diff(old, old.Clone()); len(ops) != 0 {
+		t.Fatalf("\t%s\t  Should have produced no operations for equal trees: %+v", failed, ops)
+	}
+	t.Logf("\t%s\t  Should have produced no operations for equal trees", success)
+}
@@ -157,8 +157,8 @@ import (
 	"github.com/gu-io/gu/trees/css"
 )
 
-// SpaceCharacter provides text markup which contains the '&nbsp' text for
-// a space element.
+// SpaceCharacter provides text markup which contains the provided count of
+// non-breaking space characters.
 func SpaceCharacter(count int) *trees.Markup {
 	if count < 1 {
 		count = 0
@@ -167,7 +167,7 @@ func SpaceCharacter(count int) *trees.Markup {
 	var spaces []string
 
 	for i := 0; i < count; i++ {
-		spaces = append(spaces, "&nbsp;")
+		spaces = append(spaces, "\u00a0")
 	}
 
 	return trees.NewText(strings.Join(spaces, ""))
@@ -186,6 +186,24 @@ func CustomElement(tag string, markup ...trees.Appliable) *trees.Markup {
 	return e
 }
 
+// Element returns a markup of the provided tagname with the markups applied,
+// for elements which have no function of their own.
+func Element(tag string, markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkup(tag, false)
+
+	for _, m := range markup {
+		if m == nil { continue }
+		m.Apply(e)
+	}
+	return e
+}
+
+// UnsafeRawHTML provides markup which writes the provided html without
+// escaping it, which must only be used with trusted html.
+func UnsafeRawHTML(html string) *trees.Markup {
+	return trees.UnsafeRawHTML(html)
+}
+
 // Text provides custom type for defining text nodes with the trees markup.
 func Text(content string, dl ...interface{}) *trees.Markup {
 	return trees.NewText(content, dl...)
@@ -291,6 +309,25 @@ func CSS(styles interface{}, bind interface{}) *trees.Markup {
 
 	return trees.CSSStylesheet(rs, bind)
 }
+
+// ScopedCSS provides a function that takes style rules which returns a stylesheet
+// scoped with a class derived from the rules and the type of the binding, which
+// is added to the provided element parent. Instances of the same component share
+// the same class and stylesheet.
+func ScopedCSS(styles interface{}, bind interface{}) *trees.Markup {
+	var rs *css.Rule
+
+	switch so := styles.(type) {
+	case string:
+		rs = css.New(so)
+	case *css.Rule:
+		rs = so
+	default:
+		panic("Invalid Acceptable type for css: Only string or *css.Rule")
+	}
+
+	return trees.ScopedCSSStylesheet(rs, bind)
+}
 `)
 
 	code := regexp.MustCompile("</?code>")
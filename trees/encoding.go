@@ -0,0 +1,162 @@
+package trees
+
+import "encoding/json"
+
+// PropertyJSON defines the structural form of a attribute or style. Classes
+// is set for ClassList attributes.
+type PropertyJSON struct {
+	Name    string   `json:"name"`
+	Value   string   `json:"value"`
+	Classes []string `json:"classes,omitempty"`
+}
+
+// NodeJSON defines the structural form of a Markup, which unlike MarkupJSON
+// keeps the uid, hash, key, scope class, removed, stylesheet, comment and
+// doctype states, properties, events and children of the markup, allowing it
+// be encoded and decoded without loss. Morphers, event handlers and text
+// functions can not be encoded, hence text functions are stored by their
+// current text.
+type NodeJSON struct {
+	Tag          string         `json:"tag"`
+	ID           string         `json:"id,omitempty"`
+	UID          string         `json:"uid"`
+	Hash         string         `json:"hash"`
+	Key          string         `json:"key,omitempty"`
+	Text         string         `json:"text,omitempty"`
+	ScopeClass   string         `json:"scope_class,omitempty"`
+	Removed      bool           `json:"removed,omitempty"`
+	AutoClose    bool           `json:"autoclose,omitempty"`
+	NoChildren   bool           `json:"no_children,omitempty"`
+	NoAttributes bool           `json:"no_attributes,omitempty"`
+	NoStyles     bool           `json:"no_styles,omitempty"`
+	NoEvents     bool           `json:"no_events,omitempty"`
+	Stylesheet   bool           `json:"stylesheet,omitempty"`
+	Comment      bool           `json:"comment,omitempty"`
+	Doctype      bool           `json:"doctype,omitempty"`
+	Attrs        []PropertyJSON `json:"attrs,omitempty"`
+	Styles       []PropertyJSON `json:"styles,omitempty"`
+	Events       []EventJSON    `json:"events,omitempty"`
+	Children     []NodeJSON     `json:"children,omitempty"`
+}
+
+// NodeJSON returns the structural form of the markup and it's children.
+func (e *Markup) NodeJSON() NodeJSON {
+	node := NodeJSON{
+		Tag:          e.tagname,
+		ID:           e.ID,
+		UID:          e.uid,
+		Hash:         e.hash,
+		Key:          e.key,
+		Text:         e.TextContent(),
+		ScopeClass:   e.scopeClass,
+		Removed:      e.removed,
+		AutoClose:    e.autoclose,
+		NoChildren:   !e.allowChildren,
+		NoAttributes: !e.allowAttributes,
+		NoStyles:     !e.allowStyles,
+		NoEvents:     !e.allowEvents,
+		Stylesheet:   e.stylesheet,
+		Comment:      e.comment,
+		Doctype:      e.doctype,
+	}
+
+	for _, attr := range e.attrs {
+		node.Attrs = append(node.Attrs, propertyJSON(attr))
+	}
+
+	for _, style := range e.styles {
+		node.Styles = append(node.Styles, propertyJSON(style))
+	}
+
+	for _, event := range e.events {
+		node.Events = append(node.Events, event.EventJSON())
+	}
+
+	for _, child := range e.children {
+		node.Children = append(node.Children, child.NodeJSON())
+	}
+
+	return node
+}
+
+// Markup returns the markup described by the node and it's children.
+func (n NodeJSON) Markup() *Markup {
+	e := &Markup{
+		ID:              n.ID,
+		uid:             n.UID,
+		hash:            n.Hash,
+		key:             n.Key,
+		tagname:         n.Tag,
+		textContent:     n.Text,
+		scopeClass:      n.ScopeClass,
+		removed:         n.Removed,
+		autoclose:       n.AutoClose,
+		allowChildren:   !n.NoChildren,
+		allowAttributes: !n.NoAttributes,
+		allowStyles:     !n.NoStyles,
+		allowEvents:     !n.NoEvents,
+		stylesheet:      n.Stylesheet,
+		comment:         n.Comment,
+		doctype:         n.Doctype,
+	}
+
+	for _, attr := range n.Attrs {
+		if attr.Classes != nil {
+			e.attrs = append(e.attrs, NewClassList(attr.Classes...))
+			continue
+		}
+
+		e.attrs = append(e.attrs, &Attribute{Name: attr.Name, Value: attr.Value})
+	}
+
+	for _, style := range n.Styles {
+		e.styles = append(e.styles, &CSSStyle{Name: style.Name, Value: style.Value})
+	}
+
+	for _, event := range n.Events {
+		e.events = append(e.events, Event{
+			Type:                     event.Event,
+			PreventDefault:           event.PreventDefault,
+			StopPropagation:          event.StopPropagation,
+			UseCapture:               event.UseCapture,
+			StopImmediatePropagation: event.StopImmediatePropagation,
+			Tree:                     e,
+		})
+	}
+
+	for _, childNode := range n.Children {
+		child := childNode.Markup()
+		child.parent = e
+		e.children = append(e.children, child)
+	}
+
+	return e
+}
+
+// EncodeNode returns the JSON encoding of the structural form of the markup.
+func EncodeNode(e *Markup) ([]byte, error) {
+	return json.Marshal(e.NodeJSON())
+}
+
+// DecodeNode returns the markup from the JSON encoding of it's structural form
+// as returned by EncodeNode.
+func DecodeNode(data []byte) (*Markup, error) {
+	var node NodeJSON
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+
+	return node.Markup(), nil
+}
+
+// propertyJSON returns the structural form of the property.
+func propertyJSON(property Property) PropertyJSON {
+	name, value := property.Render()
+
+	pjson := PropertyJSON{Name: name, Value: value}
+	if list, ok := property.(*ClassList); ok {
+		pjson.Classes = append([]string{}, list.list...)
+	}
+
+	return pjson
+}
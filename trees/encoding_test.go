@@ -0,0 +1,173 @@
+package trees_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/css"
+)
+
+// nodeMarkup returns a markup using the features of the structural encoding.
+func nodeMarkup() *trees.Markup {
+	root := trees.NewMarkup("section", false)
+	root.ID = "main"
+	trees.NewClassList("box", "wide").Apply(root)
+	trees.NewAttr("title", "Profile").Apply(root)
+	trees.NewCSSStyle("width", "100px").Apply(root)
+	trees.NewEvent("click", "", true, false, false, true).Apply(root)
+
+	list := trees.NewMarkup("ul", false)
+	list.Apply(root)
+
+	for _, key := range []string{"a", "b"} {
+		item := trees.NewMarkup("li", false)
+		item.SetKey(key)
+		trees.NewText("item %s", key).Apply(item)
+		item.Apply(list)
+	}
+
+	gone := trees.NewMarkup("li", false)
+	gone.Remove()
+	gone.Apply(list)
+
+	trees.NewMarkup("br", true).Apply(root)
+	trees.NewText("Hello <world>").Apply(root)
+	trees.NewComment(" note ").Apply(root)
+	trees.NewText("<!-- text -->").Apply(root)
+
+	return root
+}
+
+// TestNodeJSON validates the structural encoding round trip of markup.
+func TestNodeJSON(t *testing.T) {
+	root := nodeMarkup()
+
+	data, err := trees.EncodeNode(root)
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have encoded markup: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have encoded markup", success)
+
+	decoded, err := trees.DecodeNode(data)
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have decoded markup: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have decoded markup", success)
+
+	again, err := trees.EncodeNode(decoded)
+	if err != nil || !bytes.Equal(data, again) {
+		t.Fatalf("\t%s\t  Should have re-encoded markup exactly:\n%s\n%s", failed, data, again)
+	}
+	t.Logf("\t%s\t  Should have re-encoded markup exactly", success)
+
+	if decoded.HTML() != root.HTML() {
+		t.Fatalf("\t%s\t  Should have rendered decoded markup equally:\n%s\n%s", failed, root.HTML(), decoded.HTML())
+	}
+	t.Logf("\t%s\t  Should have rendered decoded markup equally", success)
+
+	list := decoded.Children()[0]
+	if list.Children()[1].Key() != "b" || !list.Children()[2].Removed() {
+		t.Fatalf("\t%s\t  Should have kept keys and removed state", failed)
+	}
+	t.Logf("\t%s\t  Should have kept keys and removed state", success)
+
+	if children := decoded.Children(); !children[3].IsComment() || children[4].IsComment() {
+		t.Fatalf("\t%s\t  Should have kept comments apart from text", failed)
+	}
+	t.Logf("\t%s\t  Should have kept comments apart from text", success)
+
+	events := decoded.Events()
+	if len(events) != 1 || events[0].Type != "click" || !events[0].PreventDefault || !events[0].UseCapture || events[0].Target() != root.EventID() {
+		t.Fatalf("\t%s\t  Should have kept events: %+v", failed, events)
+	}
+	t.Logf("\t%s\t  Should have kept events", success)
+
+	if ops := trees.Diff(root, decoded); len(ops) != 0 {
+		t.Fatalf("\t%s\t  Should have decoded markup equal to the original: %+v", failed, ops)
+	}
+	t.Logf("\t%s\t  Should have decoded markup equal to the original", success)
+}
+
+// TestNodeJSONDelimiters validates comments and doctypes decoded without their
+// delimiters are printed with them.
+func TestNodeJSONDelimiters(t *testing.T) {
+	cases := map[string]string{
+		`{"tag":"text","text":"x","doctype":true}`:  `<!DOCTYPE x>`,
+		`{"tag":"text","text":"x","comment":true}`:  `<!--x-->`,
+		`{"tag":"text","text":"","doctype":true}`:   `<!DOCTYPE >`,
+		`{"tag":"text","text":"--","comment":true}`: `<!-- - - -->`,
+	}
+
+	for data, expected := range cases {
+		decoded, err := trees.DecodeNode([]byte(data))
+		if err != nil {
+			t.Fatalf("\t%s\t  Should have decoded %s: %+q", failed, data, err)
+		}
+
+		if output := trees.CompactPrinter.Print(decoded); output != expected {
+			t.Fatalf("\t%s\t  Should have printed %s as %q: %q", failed, data, expected, output)
+		}
+
+		if output := decoded.HTML(); output != expected {
+			t.Fatalf("\t%s\t  Should have written %s as %q: %q", failed, data, expected, output)
+		}
+
+		binary, err := trees.DecodeNodeBinary(trees.EncodeNodeBinary(decoded))
+		if err != nil || trees.CompactPrinter.Print(binary) != expected {
+			t.Fatalf("\t%s\t  Should have printed %s decoded from binary as %q: %+q", failed, data, expected, err)
+		}
+	}
+	t.Logf("\t%s\t  Should have printed comments and doctypes lacking delimiters", success)
+}
+
+// stylesheetMarkup returns a markup holding the stylesheet of a component.
+func stylesheetMarkup() *trees.Markup {
+	root := trees.NewMarkup("div", false)
+	trees.CSSStylesheet(css.New(`& span { color: red; }`), nil).Apply(root)
+	trees.NewText("Hello").Apply(root)
+	return root
+}
+
+// TestNodeJSONStylesheet validates stylesheets remain stylesheets after the
+// structural encoding round trip.
+func TestNodeJSONStylesheet(t *testing.T) {
+	root := stylesheetMarkup()
+
+	data, err := trees.EncodeNode(root)
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have encoded markup: %+q", failed, err)
+	}
+
+	decoded, err := trees.DecodeNode(data)
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have decoded markup: %+q", failed, err)
+	}
+
+	if !decoded.Children()[0].IsStylesheet() || decoded.Children()[1].IsStylesheet() {
+		t.Fatalf("\t%s\t  Should have kept the stylesheet state: %s", failed, data)
+	}
+
+	sheets, expected := trees.Stylesheets(decoded), trees.Stylesheets(root)
+	if len(sheets) != 1 || sheets[0] != expected[0] {
+		t.Fatalf("\t%s\t  Should have found the decoded stylesheet: %+q", failed, sheets)
+	}
+	t.Logf("\t%s\t  Should have kept the stylesheet state", success)
+
+	scoped := trees.NewMarkup("div", false)
+	sheet := trees.ScopedCSSStylesheet(css.New(`& span { color: red; }`), scoped)
+	sheet.Apply(scoped)
+
+	if data, err = trees.EncodeNode(scoped); err != nil {
+		t.Fatalf("\t%s\t  Should have encoded markup: %+q", failed, err)
+	}
+
+	if decoded, err = trees.DecodeNode(data); err != nil {
+		t.Fatalf("\t%s\t  Should have decoded markup: %+q", failed, err)
+	}
+
+	if class := decoded.Children()[0].ScopeClass(); class == "" || class != sheet.ScopeClass() {
+		t.Fatalf("\t%s\t  Should have kept the scope class: %q", failed, class)
+	}
+	t.Logf("\t%s\t  Should have kept the scope class", success)
+}
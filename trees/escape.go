@@ -0,0 +1,244 @@
+package trees
+
+import (
+	"regexp"
+	"strings"
+)
+
+// RawHTMLTag defines the tagname of markups holding trusted raw html.
+const RawHTMLTag = "#raw-html"
+
+// UnsafeURL defines the url written in place of urls whose scheme is not safe
+// such as "javascript:".
+const UnsafeURL = "about:invalid#gu-unsafe"
+
+// UnsafeStyle defines the value written in place of css values which can run
+// scripts or break out of their declaration.
+const UnsafeStyle = "gu-unsafe"
+
+// UnsafeRawHTML returns a markup holding the provided html, which all printers
+// write as is without any escaping. It must only be used with trusted html
+// and never with content provided by users, has the html is able to run
+// scripts within the page. When patched live the html should hold a single
+// element or text.
+func UnsafeRawHTML(html string) *Markup {
+	em := NewMarkup(RawHTMLTag, false)
+	em.allowChildren = false
+	em.allowAttributes = false
+	em.allowStyles = false
+	em.allowEvents = false
+	em.attrs = nil
+	em.textContent = html
+
+	return em
+}
+
+// IsRawHTML returns true/false if the markup holds trusted raw html created
+// with UnsafeRawHTML.
+func (e *Markup) IsRawHTML() bool {
+	return e.tagname == RawHTMLTag
+}
+
+//==============================================================================
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&#34;", "'", "&#39;")
+	rawTextEnd  = regexp.MustCompile(`(?i)</(script|style)`)
+	cssURL      = regexp.MustCompile(`(?i)url\(\s*['"]?([^'")]*)`)
+	cssIgnored  = regexp.MustCompile(`/\*.*?\*/|\\|\s`)
+)
+
+// safeSchemes contains the url schemes allowed within url attributes.
+var safeSchemes = map[string]bool{
+	"http": true, "https": true, "mailto": true, "tel": true, "ftp": true,
+}
+
+// urlAttrs contains the attributes whose values are urls.
+var urlAttrs = map[string]bool{
+	"href": true, "src": true, "action": true, "formaction": true,
+	"cite": true, "poster": true, "background": true, "longdesc": true,
+	"usemap": true, "data": true, "codebase": true, "manifest": true,
+	"icon": true, "profile": true, "xlink:href": true, "srcset": true,
+}
+
+// unsafeStyles contains the css content which can run scripts.
+var unsafeStyles = []string{"expression(", "javascript:", "vbscript:", "behavior:", "-moz-binding", "@import"}
+
+// EscapeText returns the text escaped for use as html text content.
+func EscapeText(text string) string {
+	return textEscaper.Replace(text)
+}
+
+// EscapeAttr returns the value escaped for use as the quoted value of the
+// attribute, where urls with unsafe schemes are replaced with UnsafeURL and
+// unsafe styles with UnsafeStyle.
+func EscapeAttr(name string, value string) string {
+	return attrEscaper.Replace(FilterAttr(name, value))
+}
+
+// FilterAttr returns the value of the attribute with unsafe urls and styles
+// replaced, without escaping it.
+func FilterAttr(name string, value string) string {
+	name = strings.ToLower(name)
+
+	switch {
+	case urlAttrs[name]:
+		return FilterURL(value)
+	case name == "style":
+		return FilterStyle(value)
+	}
+
+	return value
+}
+
+// FilterURL returns the url if it's relative or of a safe scheme like http,
+// https and mailto, or data urls of images, else returning UnsafeURL.
+func FilterURL(url string) string {
+	trimmed := strings.TrimFunc(url, func(char rune) bool { return char <= ' ' })
+
+	colon := strings.IndexByte(trimmed, ':')
+	if colon < 0 || strings.ContainsAny(trimmed[:colon], "/?#") {
+		return url
+	}
+
+	scheme := strings.ToLower(strings.Map(func(char rune) rune {
+		if char <= ' ' {
+			return -1
+		}
+		return char
+	}, trimmed[:colon]))
+
+	if safeSchemes[scheme] {
+		return url
+	}
+
+	if scheme == "data" && strings.HasPrefix(strings.ToLower(trimmed[colon+1:]), "image/") && !strings.HasPrefix(strings.ToLower(trimmed[colon+1:]), "image/svg") {
+		return url
+	}
+
+	return UnsafeURL
+}
+
+// FilterStyleValue returns the css value of a single property if it is unable
+// to run scripts or break out of it's declaration, such as with a ";" adding
+// declarations of it's own, else returning UnsafeStyle.
+func FilterStyleValue(value string) string {
+	if strings.Contains(value, ";") {
+		return UnsafeStyle
+	}
+
+	return FilterStyle(value)
+}
+
+// FilterStyle returns the css declarations, such as those of a style
+// attribute, if they are unable to run scripts or break out of the
+// attribute, else returning UnsafeStyle. Single property values are
+// filtered with FilterStyleValue.
+func FilterStyle(style string) string {
+	if strings.ContainsAny(style, "<>{}") {
+		return UnsafeStyle
+	}
+
+	lower := strings.ToLower(cssIgnored.ReplaceAllString(style, ""))
+	for _, unsafe := range unsafeStyles {
+		if strings.Contains(lower, unsafe) {
+			return UnsafeStyle
+		}
+	}
+
+	for _, match := range cssURL.FindAllStringSubmatch(style, -1) {
+		if FilterURL(match[1]) == UnsafeURL {
+			return UnsafeStyle
+		}
+	}
+
+	return style
+}
+
+// isAttrName returns true/false if the name can be written as a attribute
+// name without breaking the markup.
+func isAttrName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	return !strings.ContainsAny(name, " \t\n\f\r\"'<>/=`")
+}
+
+// isStyleName returns true/false if the name can be written as a css
+// property name without breaking the declarations.
+func isStyleName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	return !strings.ContainsAny(name, " \t\n\f\r\"'<>:;{}()")
+}
+
+// escapeRawText returns the content of a script or style element with end
+// tags which would close the element escaped.
+func escapeRawText(text string) string {
+	return rawTextEnd.ReplaceAllString(text, `<\/$1`)
+}
+
+// isRawTextElement returns true/false if the text of the element is written
+// without escaping.
+func isRawTextElement(tag string) bool {
+	tag = strings.ToLower(tag)
+	return tag == "script" || tag == "style"
+}
+
+// printableText returns the content of the text markup escaped for it's
+// context, keeping comments and doctypes with their content made unable to
+// end them early.
+func printableText(e *Markup) string {
+	text := e.TextContent()
+
+	switch {
+	case e.IsComment():
+		return safeComment(text)
+	case e.IsDoctype():
+		return "<!DOCTYPE " + strings.Trim(strings.Map(func(char rune) rune {
+			if char == '<' || char == '>' {
+				return -1
+			}
+			return char
+		}, doctypeContent(text)), " ") + ">"
+	case e.parent != nil && isRawTextElement(e.parent.tagname):
+		return escapeRawText(text)
+	}
+
+	return EscapeText(text)
+}
+
+// commentContent returns the content of the comment without the "<!--" and
+// "-->" delimiters, which decoded markup may lack.
+func commentContent(comment string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(comment, "<!--"), "-->")
+}
+
+// doctypeContent returns the content of the doctype declaration without the
+// "<!DOCTYPE" and ">" delimiters, which decoded markup may lack.
+func doctypeContent(doctype string) string {
+	if len(doctype) >= 9 && strings.EqualFold(doctype[:9], "<!DOCTYPE") {
+		doctype = doctype[9:]
+	}
+
+	return strings.TrimSuffix(doctype, ">")
+}
+
+// safeComment returns the comment with content able to end it early, such as
+// "-->", broken apart.
+func safeComment(comment string) string {
+	content := commentContent(comment)
+	for strings.Contains(content, "--") {
+		content = strings.Replace(content, "--", "- -", -1)
+	}
+
+	if strings.HasPrefix(content, ">") || strings.HasPrefix(content, "->") || strings.HasSuffix(content, "-") {
+		content = " " + content + " "
+	}
+
+	return "<!--" + content + "-->"
+}
@@ -0,0 +1,159 @@
+package trees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// escapeDocument returns a tree holding user provided content in each
+// context.
+func escapeDocument(input string) *trees.Markup {
+	root := trees.NewMarkup("div", false)
+
+	link := trees.NewMarkup("a", false)
+	trees.NewAttr("href", input).Apply(link)
+	trees.NewAttr("title", input).Apply(link)
+	trees.NewCSSStyle("background", input).Apply(link)
+	trees.NewText(input).Apply(link)
+	link.Apply(root)
+
+	script := trees.NewMarkup("script", false)
+	trees.NewText(input).Apply(script)
+	script.Apply(root)
+
+	trees.NewComment(input).Apply(root)
+
+	return root
+}
+
+// TestEscaping validates user content can not inject markup in any printer.
+func TestEscaping(t *testing.T) {
+	inputs := []string{
+		`"><script>alert(1)</script>`,
+		`javascript:alert(1)`,
+		` JaVa	Script:alert(1)`,
+		`--><img src=x onerror=alert(1)>`,
+		`</script><script>alert(1)</script>`,
+		`url(javascript:alert(1))`,
+		`expression(alert(1))`,
+	}
+
+	printers := map[string]func(*trees.Markup) string{
+		"html":     (*trees.Markup).HTML,
+		"pretty":   trees.PrettyPrinter.Print,
+		"minified": trees.MinifiedPrinter.Print,
+		"xhtml":    trees.XHTMLPrinter.Print,
+	}
+
+	for _, input := range inputs {
+		for name, print := range printers {
+			output := print(escapeDocument(input))
+
+			parsed, err := trees.ParseTreeErr(output)
+			if err != nil {
+				t.Fatalf("\t%s\t  Should have printed well-formed %s markup for %q: %+q\n%s", failed, name, input, err, output)
+			}
+
+			if len(parsed) != 1 || len(trees.Query.QueryAll(parsed[0], "script")) != 1 || trees.Query.Query(parsed[0], "img") != nil {
+				t.Fatalf("\t%s\t  Should have escaped %q in %s markup:\n%s", failed, input, name, output)
+			}
+
+			lower := strings.ToLower(output)
+			if strings.Contains(lower, "javascript:") && !strings.Contains(lower, "<script") {
+				t.Fatalf("\t%s\t  Should have filtered unsafe url %q in %s markup:\n%s", failed, input, name, output)
+			}
+
+			if href, ok := attrOf(trees.Query.Query(parsed[0], "a"), "href"); !ok || strings.Contains(strings.ToLower(strings.Replace(href, "\t", "", -1)), "script:") {
+				t.Fatalf("\t%s\t  Should have replaced unsafe url %q in %s markup: %q", failed, input, name, href)
+			}
+		}
+	}
+	t.Logf("\t%s\t  Should have escaped user content in all printers", success)
+
+	link := trees.NewMarkup("a", false)
+	trees.NewAttr("href", "/search?q=a&b=<c>").Apply(link)
+	trees.NewText("Fish & Chips").Apply(link)
+
+	if output := trees.CompactPrinter.Print(link); output != `<a data-gen="gu" href="/search?q=a&amp;b=&lt;c&gt;">Fish &amp; Chips</a>` {
+		t.Fatalf("\t%s\t  Should have escaped safe content: %s", failed, output)
+	}
+	t.Logf("\t%s\t  Should have escaped safe content", success)
+
+	styled := trees.NewMarkup("p", false)
+	trees.NewCSSStyle("color", "red; position: fixed; top: 0").Apply(styled)
+
+	for _, output := range []string{styled.HTML(), trees.CompactPrinter.Print(styled), trees.MinifiedPrinter.Print(styled)} {
+		if strings.Contains(output, "position") || !strings.Contains(output, trees.UnsafeStyle) {
+			t.Fatalf("\t%s\t  Should have replaced style values adding declarations: %s", failed, output)
+		}
+	}
+
+	if value := trees.FilterStyleValue("red; position: fixed"); value != trees.UnsafeStyle {
+		t.Fatalf("\t%s\t  Should have filtered style values adding declarations: %q", failed, value)
+	}
+
+	if value := trees.FilterStyle("color: red; margin: 0"); value != "color: red; margin: 0" {
+		t.Fatalf("\t%s\t  Should have kept safe declarations: %q", failed, value)
+	}
+	t.Logf("\t%s\t  Should have replaced style values adding declarations", success)
+}
+
+// TestCommentText validates text shaped like comments and doctypes is
+// escaped has text, while comments and doctypes are written as such.
+func TestCommentText(t *testing.T) {
+	root := trees.NewMarkup("div", false)
+	trees.NewText("<!-- x -->").Apply(root)
+	trees.NewText("<!DOCTYPE html>").Apply(root)
+	trees.NewComment(" y ").Apply(root)
+
+	expected := `<div data-gen="gu">&lt;!-- x --&gt;&lt;!DOCTYPE html&gt;<!-- y --></div>`
+
+	if output := trees.CompactPrinter.Print(root); output != expected {
+		t.Fatalf("\t%s\t  Should have escaped text shaped like comments: %s", failed, output)
+	}
+	t.Logf("\t%s\t  Should have escaped text shaped like comments", success)
+
+	trees.UGCPolicy().Sanitize(root)
+
+	if output := trees.CompactPrinter.Print(root); output != `<div data-gen="gu">&lt;!-- x --&gt;&lt;!DOCTYPE html&gt;</div>` {
+		t.Fatalf("\t%s\t  Should have only sanitized comments: %s", failed, output)
+	}
+	t.Logf("\t%s\t  Should have only sanitized comments", success)
+}
+
+// TestUnsafeRawHTML validates trusted raw html is written as is.
+func TestUnsafeRawHTML(t *testing.T) {
+	root := trees.NewMarkup("div", false)
+	trees.UnsafeRawHTML(`<b class="x">Trusted &amp; bold</b>`).Apply(root)
+	trees.NewText(`<b>Text</b>`).Apply(root)
+
+	expected := `<div data-gen="gu"><b class="x">Trusted &amp; bold</b>&lt;b&gt;Text&lt;/b&gt;</div>`
+
+	if output := trees.CompactPrinter.Print(root); output != expected {
+		t.Fatalf("\t%s\t  Should have written raw html as is: %s", failed, output)
+	}
+
+	if output := trees.MinifiedPrinter.Print(root); output != expected {
+		t.Fatalf("\t%s\t  Should have written minified raw html as is: %s", failed, output)
+	}
+	t.Logf("\t%s\t  Should have written raw html as is", success)
+
+	if !root.Children()[0].IsRawHTML() || trees.Query.Query(root, "*") != nil {
+		t.Fatalf("\t%s\t  Should have kept raw html out of queries", failed)
+	}
+	t.Logf("\t%s\t  Should have kept raw html out of queries", success)
+
+	old := trees.NewMarkup("div", false)
+	trees.UnsafeRawHTML(`<b>Old</b>`).Apply(old)
+
+	next := trees.NewMarkup("div", false)
+	trees.UnsafeRawHTML(`<i>New</i>`).Apply(next)
+
+	ops := trees.Diff(old, next)
+	if len(ops) != 2 || ops[0].Type != trees.RemoveOp || ops[1].Type != trees.InsertOp || ops[1].Markup != `<i>New</i>` || ops[1].Parent != old.UID() {
+		t.Fatalf("\t%s\t  Should have replaced changed raw html: %+v", failed, ops)
+	}
+	t.Logf("\t%s\t  Should have replaced changed raw html", success)
+}
@@ -0,0 +1,533 @@
+package trees
+
+import (
+	"strings"
+)
+
+// Format defines the output format of a Printer.
+type Format int
+
+const (
+	// CompactFormat prints markup on a single line, as ElementWriter does.
+	CompactFormat Format = iota
+
+	// PrettyFormat prints markup indented with each element on it's own line,
+	// keeping elements with only text on a single line.
+	PrettyFormat
+
+	// MinifiedFormat prints the smallest markup rendering the same document,
+	// collapsing whitespace and dropping comments and default attributes.
+	MinifiedFormat
+
+	// XHTMLFormat prints well-formed XHTML, escaping text and attributes,
+	// closing all elements and declaring the namespaces of html, SVG and
+	// MathML elements.
+	XHTMLFormat
+)
+
+// contains the namespaces declared by the XHTMLFormat.
+const (
+	XHTMLNamespace  = "http://www.w3.org/1999/xhtml"
+	SVGNamespace    = "http://www.w3.org/2000/svg"
+	MathMLNamespace = "http://www.w3.org/1998/Math/MathML"
+	XLinkNamespace  = "http://www.w3.org/1999/xlink"
+)
+
+// defaultIndent defines the indentation used by the PrettyFormat when the
+// Printer does not set one.
+const defaultIndent = "  "
+
+// contains the Printers for each format.
+var (
+	CompactPrinter  = Printer{Format: CompactFormat}
+	PrettyPrinter   = Printer{Format: PrettyFormat}
+	MinifiedPrinter = Printer{Format: MinifiedFormat}
+	XHTMLPrinter    = Printer{Format: XHTMLFormat}
+)
+
+// Printer defines a printer for markup selected per call, unlike the
+// ElementWriter which follows the mode set with SetMode. Management
+// prints the uid and hash of elements along with removed elements, Indent
+// sets the indentation of the PrettyFormat and Nonce sets the nonce attribute
+// of inline scripts and styles.
+type Printer struct {
+	Format     Format
+	Indent     string
+	Management bool
+	Nonce      string
+}
+
+// Write prints the giving *Markup as a string else returns an error.
+func (p Printer) Write(e *Markup) (string, error) {
+	return p.Print(e), nil
+}
+
+// Print returns the string representation of the markup in the printer's
+// format.
+func (p Printer) Print(e *Markup) string {
+	var out strings.Builder
+	p.print(&out, e, printContext{namespace: parentNamespace(e)})
+	return out.String()
+}
+
+// Format returns the string representation of the markup in the provided
+// format.
+func (e *Markup) Format(format Format) string {
+	return Printer{Format: format}.Print(e)
+}
+
+//==============================================================================
+
+// printWriter defines the writer the Printer writes into.
+type printWriter interface {
+	WriteString(string) (int, error)
+}
+
+// printContext defines the state of the element being printed.
+type printContext struct {
+	depth     int
+	namespace string
+	raw       bool
+	preserve  bool
+	flush     func()
+}
+
+// print writes the markup into the writer.
+func (p Printer) print(w printWriter, e *Markup, ctx printContext) {
+	if e.removed && !p.Management {
+		return
+	}
+
+	if e.IsRawHTML() {
+		w.WriteString(e.TextContent())
+		return
+	}
+
+	if e.tagname == "text" {
+		switch {
+		case e.IsComment():
+			p.printComment(w, e.TextContent())
+		case e.IsDoctype():
+			w.WriteString(printableText(e))
+		default:
+			p.printText(w, e.TextContent(), ctx)
+		}
+
+		return
+	}
+
+	name := e.tagname
+	namespace := ctx.namespace
+
+	if namespace == XHTMLNamespace {
+		switch strings.ToLower(name) {
+		case "svg":
+			namespace = SVGNamespace
+		case "math":
+			namespace = MathMLNamespace
+		}
+	}
+
+	if p.Format == XHTMLFormat && namespace == XHTMLNamespace {
+		name = strings.ToLower(name)
+	}
+
+	w.WriteString("<")
+	w.WriteString(name)
+
+	if p.Management {
+		p.printAttr(w, e, "hash", e.Hash())
+		p.printAttr(w, e, "uid", e.UID())
+	}
+
+	if p.Format == XHTMLFormat {
+		p.printNamespaces(w, e, namespace, ctx.namespace, ctx.depth == 0)
+	}
+
+	for _, attr := range e.attrs {
+		attrName, value := attr.Render()
+		if p.Format == XHTMLFormat && namespace == XHTMLNamespace {
+			attrName = strings.ToLower(attrName)
+		}
+
+		p.printAttr(w, e, attrName, value)
+	}
+
+	if needsNonce(e, p.Nonce) {
+		p.printAttr(w, e, "nonce", p.Nonce)
+	}
+
+	p.printStyles(w, e)
+
+	tag := strings.ToLower(name)
+	void := namespace == XHTMLNamespace && isVoidElement(tag)
+
+	child := printContext{
+		depth:     ctx.depth + 1,
+		namespace: namespace,
+		raw:       namespace == XHTMLNamespace && isRawTextElement(tag),
+		preserve:  ctx.preserve || tag == "pre" || tag == "textarea",
+		flush:     ctx.flush,
+	}
+
+	children := p.printableChildren(e)
+	text := e.TextContent()
+
+	switch p.Format {
+	case MinifiedFormat:
+		if void {
+			w.WriteString(">")
+			return
+		}
+
+	case XHTMLFormat:
+		if len(children) == 0 && text == "" && (void || namespace != XHTMLNamespace) {
+			w.WriteString("/>")
+			return
+		}
+
+	default:
+		if e.autoclose {
+			w.WriteString("/>")
+			return
+		}
+	}
+
+	w.WriteString(">")
+
+	if p.Format == PrettyFormat && !child.raw && !child.preserve && hasElementChildren(children) {
+		indent := p.Indent
+		if indent == "" {
+			indent = defaultIndent
+		}
+
+		if text != "" {
+			p.newline(w, indent, child.depth)
+			p.printText(w, text, child)
+		}
+
+		for _, item := range children {
+			if item.tagname == "text" && strings.TrimSpace(item.TextContent()) == "" {
+				continue
+			}
+
+			p.newline(w, indent, child.depth)
+			p.print(w, item, child)
+			p.flush(item, ctx)
+		}
+
+		p.newline(w, indent, ctx.depth)
+	} else {
+		p.printText(w, text, child)
+
+		for _, item := range children {
+			p.print(w, item, child)
+			p.flush(item, ctx)
+		}
+	}
+
+	w.WriteString("</")
+	w.WriteString(name)
+	w.WriteString(">")
+}
+
+// flush calls the flush function of the context after the child was written
+// if the child is a element within the flush depth.
+func (p Printer) flush(child *Markup, ctx printContext) {
+	if ctx.flush != nil && ctx.depth < flushDepth && child.tagname != "text" {
+		ctx.flush()
+	}
+}
+
+// printText writes the text into the writer, escaped for it's context.
+func (p Printer) printText(w printWriter, text string, ctx printContext) {
+	if text == "" {
+		return
+	}
+
+	switch p.Format {
+	case PrettyFormat:
+		if !ctx.raw && !ctx.preserve {
+			text = strings.TrimSpace(text)
+		}
+
+	case MinifiedFormat:
+		if !ctx.raw && !ctx.preserve {
+			text = collapseSpace(text)
+		}
+
+	case XHTMLFormat:
+		if ctx.raw {
+			text = escapeRawText(text)
+			if strings.ContainsAny(text, "<&") {
+				text = "<![CDATA[" + strings.Replace(text, "]]>", "]]]]><![CDATA[>", -1) + "]]>"
+			}
+
+			w.WriteString(text)
+			return
+		}
+
+		w.WriteString(xmlEscaper.Replace(text))
+		return
+	}
+
+	if ctx.raw {
+		w.WriteString(escapeRawText(text))
+		return
+	}
+
+	w.WriteString(EscapeText(text))
+}
+
+// printComment writes the comment into the writer, where minified output
+// only keeps conditional comments.
+func (p Printer) printComment(w printWriter, comment string) {
+	if p.Format == MinifiedFormat && !strings.HasPrefix(comment, "<!--[if") {
+		return
+	}
+
+	w.WriteString(safeComment(comment))
+}
+
+// printAttr writes the attribute into the writer.
+func (p Printer) printAttr(w printWriter, e *Markup, name string, value string) {
+	if !isAttrName(name) {
+		return
+	}
+
+	value = FilterAttr(name, value)
+
+	switch p.Format {
+	case MinifiedFormat:
+		lower := strings.ToLower(name)
+
+		if (lower == "class" || lower == "style") && strings.TrimSpace(value) == "" {
+			return
+		}
+
+		if isDefaultAttr(strings.ToLower(e.tagname), lower, value) {
+			return
+		}
+
+		if isBooleanAttr(lower) && (value == "" || strings.EqualFold(value, lower)) {
+			w.WriteString(" ")
+			w.WriteString(name)
+			return
+		}
+
+		if lower == "class" {
+			value = collapseSpace(strings.TrimSpace(value))
+		}
+
+	case XHTMLFormat:
+		if value == "" && isBooleanAttr(name) {
+			value = name
+		}
+
+		value = xmlEscaper.Replace(value)
+	}
+
+	if p.Format != XHTMLFormat {
+		value = attrEscaper.Replace(value)
+	}
+
+	w.WriteString(" ")
+	w.WriteString(name)
+	w.WriteString(`="`)
+	w.WriteString(value)
+	w.WriteString(`"`)
+}
+
+// printStyles writes the inline styles of the markup into the writer.
+func (p Printer) printStyles(w printWriter, e *Markup) {
+	if len(e.styles) == 0 {
+		return
+	}
+
+	var styles []string
+	for _, style := range e.styles {
+		name, value := style.Render()
+		if !isStyleName(name) {
+			continue
+		}
+
+		value = FilterStyleValue(value)
+
+		switch p.Format {
+		case MinifiedFormat:
+			styles = append(styles, name+":"+strings.TrimSpace(value))
+		default:
+			styles = append(styles, name+": "+value+";")
+		}
+	}
+
+	switch p.Format {
+	case MinifiedFormat:
+		p.printAttr(w, e, "style", strings.Join(styles, ";"))
+	default:
+		p.printAttr(w, e, "style", strings.Join(styles, " "))
+	}
+}
+
+// printNamespaces writes the namespace declarations needed by the markup
+// into the writer, where parent is the namespace of the markup's parent and
+// root marks the first markup printed.
+func (p Printer) printNamespaces(w printWriter, e *Markup, namespace string, parent string, root bool) {
+	if !root && namespace == parent {
+		return
+	}
+
+	if _, ok := attrValue(e, "xmlns"); !ok {
+		p.printAttr(w, e, "xmlns", namespace)
+	}
+
+	if namespace == SVGNamespace && usesXLink(e) {
+		if _, ok := attrValue(e, "xmlns:xlink"); !ok {
+			p.printAttr(w, e, "xmlns:xlink", XLinkNamespace)
+		}
+	}
+}
+
+// printableChildren returns the children of the markup which are printed.
+func (p Printer) printableChildren(e *Markup) []*Markup {
+	if p.Management {
+		return e.children
+	}
+
+	var children []*Markup
+	for _, child := range e.children {
+		if !child.removed {
+			children = append(children, child)
+		}
+	}
+
+	return children
+}
+
+// newline writes a new line indented to the depth into the writer.
+func (p Printer) newline(w printWriter, indent string, depth int) {
+	w.WriteString("\n")
+	for i := 0; i < depth; i++ {
+		w.WriteString(indent)
+	}
+}
+
+//==============================================================================
+
+// xmlEscaper escapes text and attribute values for XHTML.
+var xmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;", "'", "&#39;")
+
+// voidElements contains the html elements which can not have children.
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"param": true, "source": true, "track": true, "wbr": true,
+}
+
+// booleanAttrs contains the html attributes whose presence sets them.
+var booleanAttrs = map[string]bool{
+	"allowfullscreen": true, "async": true, "autofocus": true, "autoplay": true,
+	"checked": true, "controls": true, "default": true, "defer": true,
+	"disabled": true, "formnovalidate": true, "hidden": true, "ismap": true,
+	"loop": true, "multiple": true, "muted": true, "nomodule": true,
+	"novalidate": true, "open": true, "readonly": true, "required": true,
+	"reversed": true, "selected": true,
+}
+
+// defaultAttrs contains the attributes whose value is the default of the
+// element, keyed by element and attribute name.
+var defaultAttrs = map[string]string{
+	"script type":   "text/javascript",
+	"style type":    "text/css",
+	"link type":     "text/css",
+	"form method":   "get",
+	"input type":    "text",
+	"button type":   "submit",
+	"area shape":    "rect",
+	"form enctype":  "application/x-www-form-urlencoded",
+	"style media":   "all",
+	"link media":    "all",
+	"textarea wrap": "soft",
+}
+
+// isVoidElement returns true/false if the html element can not have children.
+func isVoidElement(tag string) bool {
+	return voidElements[tag]
+}
+
+// isBooleanAttr returns true/false if the html attribute is a boolean.
+func isBooleanAttr(name string) bool {
+	return booleanAttrs[name]
+}
+
+// isDefaultAttr returns true/false if the attribute holds the default value of
+// the element.
+func isDefaultAttr(tag string, name string, value string) bool {
+	def, ok := defaultAttrs[tag+" "+name]
+	return ok && strings.EqualFold(strings.TrimSpace(value), def)
+}
+
+// parentNamespace returns the namespace of the parent of the markup, which
+// is the namespace of the nearest svg or math ancestor if any.
+func parentNamespace(e *Markup) string {
+	for parent := e.parent; parent != nil; parent = parent.parent {
+		switch strings.ToLower(parent.tagname) {
+		case "svg":
+			return SVGNamespace
+		case "math":
+			return MathMLNamespace
+		case "foreignobject":
+			return XHTMLNamespace
+		}
+	}
+
+	return XHTMLNamespace
+}
+
+// hasElementChildren returns true/false if any of the children is a element.
+func hasElementChildren(children []*Markup) bool {
+	for _, child := range children {
+		if child.tagname != "text" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// usesXLink returns true/false if the markup or any of it's children uses
+// xlink attributes.
+func usesXLink(e *Markup) bool {
+	return !Walk(e, func(item *Markup) WalkAction {
+		for _, attr := range item.attrs {
+			if name, _ := attr.Render(); strings.HasPrefix(name, "xlink:") {
+				return WalkStop
+			}
+		}
+
+		return WalkContinue
+	})
+}
+
+// collapseSpace returns the text with each run of whitespace replaced by a
+// single space.
+func collapseSpace(text string) string {
+	var out strings.Builder
+	var spaced bool
+
+	for i := 0; i < len(text); i++ {
+		if isSpace(text[i]) {
+			if !spaced {
+				out.WriteByte(' ')
+			}
+
+			spaced = true
+			continue
+		}
+
+		spaced = false
+		out.WriteByte(text[i])
+	}
+
+	return out.String()
+}
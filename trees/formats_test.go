@@ -0,0 +1,158 @@
+package trees_test
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// formatDocument returns the tree the printer tests run against.
+func formatDocument() *trees.Markup {
+	root := trees.ParseAsRoot("div", `
+    <form method="GET" class="  search   box ">
+      <input type="text" name="q" disabled="disabled"/>
+      <br/>
+      <button type="submit">Find   it</button>
+    </form>
+    <pre>  keep
+   this  </pre>
+  `)
+
+	trees.NewCSSStyle("color", "red").Apply(root)
+	trees.NewComment("search field").Apply(trees.Query.Query(root, "form"))
+	return root
+}
+
+// TestPrettyFormat validates the indented printer.
+func TestPrettyFormat(t *testing.T) {
+	expected := strings.Join([]string{
+		`<div data-gen="gu" style="color: red;">`,
+		`  <form data-gen="gu" method="GET" class="  search   box ">`,
+		`    <input data-gen="gu" type="text" name="q" disabled="disabled"/>`,
+		`    <br data-gen="gu"/>`,
+		`    <button data-gen="gu" type="submit">Find   it</button>`,
+		`    <!--search field-->`,
+		`  </form>`,
+		`  <pre data-gen="gu">  keep`,
+		`   this  </pre>`,
+		`</div>`,
+	}, "\n")
+
+	if output := trees.PrettyPrinter.Print(formatDocument()); output != expected {
+		t.Fatalf("\t%s\t  Should have printed indented markup:\n%s", failed, output)
+	}
+	t.Logf("\t%s\t  Should have printed indented markup", success)
+
+	tabbed := trees.Printer{Format: trees.PrettyFormat, Indent: "\t"}.Print(formatDocument())
+	if !strings.Contains(tabbed, "\n\t\t<br data-gen=\"gu\"/>") {
+		t.Fatalf("\t%s\t  Should have printed with provided indent:\n%s", failed, tabbed)
+	}
+	t.Logf("\t%s\t  Should have printed with provided indent", success)
+}
+
+// TestMinifiedFormat validates the minified printer.
+func TestMinifiedFormat(t *testing.T) {
+	expected := `<div data-gen="gu" style="color:red"><form data-gen="gu" class="search box"><input data-gen="gu" name="q" disabled><br data-gen="gu"><button data-gen="gu">Find it</button></form><pre data-gen="gu">  keep
+   this  </pre></div>`
+
+	if output := trees.MinifiedPrinter.Print(formatDocument()); output != expected {
+		t.Fatalf("\t%s\t  Should have printed minified markup:\n%s", failed, output)
+	}
+	t.Logf("\t%s\t  Should have printed minified markup", success)
+
+	if output := formatDocument().Format(trees.MinifiedFormat); output != expected {
+		t.Fatalf("\t%s\t  Should have printed minified markup from markup:\n%s", failed, output)
+	}
+	t.Logf("\t%s\t  Should have printed minified markup from markup", success)
+}
+
+// TestXHTMLFormat validates the XHTML printer produces well-formed XML with
+// namespaces for SVG and MathML.
+func TestXHTMLFormat(t *testing.T) {
+	root := trees.ParseAsRoot("html", `
+    <body>
+      <p title="a < b & 'c'">Fish & Chips <b>x < y</b></p>
+      <input type="checkbox" checked="" />
+      <script>if (a < b && c) {}</script>
+      <svg viewBox="0 0 10 10"><use xlink:href="#icon"></use><circle r="1"></circle></svg>
+      <math><mi>x</mi></math>
+    </body>
+  `)
+
+	output := trees.XHTMLPrinter.Print(root)
+
+	decoder := xml.NewDecoder(strings.NewReader(output))
+	decoder.Strict = true
+
+	namespaces := make(map[string]string)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("\t%s\t  Should have printed well-formed XHTML: %+q\n%s", failed, err, output)
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			namespaces[start.Name.Local] = start.Name.Space
+		}
+	}
+	t.Logf("\t%s\t  Should have printed well-formed XHTML", success)
+
+	for tag, namespace := range map[string]string{
+		"html":   trees.XHTMLNamespace,
+		"p":      trees.XHTMLNamespace,
+		"svg":    trees.SVGNamespace,
+		"circle": trees.SVGNamespace,
+		"math":   trees.MathMLNamespace,
+		"mi":     trees.MathMLNamespace,
+	} {
+		if namespaces[tag] != namespace {
+			t.Fatalf("\t%s\t  Should have placed %q in namespace %q: %q", failed, tag, namespace, namespaces[tag])
+		}
+	}
+	t.Logf("\t%s\t  Should have placed elements in their namespaces", success)
+
+	for _, part := range []string{
+		`checked="checked"`,
+		`type="checkbox" checked="checked"/>`,
+		`xlink:href="#icon"`,
+		`xmlns:xlink="http://www.w3.org/1999/xlink"`,
+		`r="1"/>`,
+		`Fish &amp; Chips`,
+		`<![CDATA[if (a < b && c) {}]]>`,
+	} {
+		if !strings.Contains(output, part) {
+			t.Fatalf("\t%s\t  Should have printed %q:\n%s", failed, part, output)
+		}
+	}
+	t.Logf("\t%s\t  Should have printed escaped and closed XHTML", success)
+}
+
+// TestPrinterManagement validates printing uids, hashes and removed markups
+// without changing the mode used by ElementWriter.
+func TestPrinterManagement(t *testing.T) {
+	root := formatDocument()
+	trees.Query.Query(root, "pre").Remove()
+
+	if output := trees.CompactPrinter.Print(root); strings.Contains(output, "<pre") || strings.Contains(output, "uid=") {
+		t.Fatalf("\t%s\t  Should have skipped removed markup and uids: %s", failed, output)
+	}
+	t.Logf("\t%s\t  Should have skipped removed markup and uids", success)
+
+	managed := trees.Printer{Format: trees.CompactFormat, Management: true}.Print(root)
+	if !strings.Contains(managed, "<pre") || !strings.Contains(managed, `uid="`+root.UID()+`"`) {
+		t.Fatalf("\t%s\t  Should have printed removed markup and uids: %s", failed, managed)
+	}
+	t.Logf("\t%s\t  Should have printed removed markup and uids", success)
+
+	if trees.GetMode() != trees.Normal {
+		t.Fatalf("\t%s\t  Should have left the printer mode unchanged", failed)
+	}
+	t.Logf("\t%s\t  Should have left the printer mode unchanged", success)
+}
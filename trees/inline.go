@@ -0,0 +1,242 @@
+package trees
+
+import (
+	"sort"
+	"strings"
+
+	bcss "github.com/aymerick/douceur/css"
+	"github.com/aymerick/douceur/parser"
+)
+
+// inlineDeclaration defines a declaration of a stylesheet matching a element,
+// along with the specificity of it's selector and it's position within the
+// stylesheets.
+type inlineDeclaration struct {
+	declaration *bcss.Declaration
+	specificity Specificity
+	order       int
+}
+
+// InlineStyles moves the rules of the stylesheets within the markup, both
+// those of CSSStylesheet and plain style elements, into the styles of the
+// elements they match and removes the stylesheets, producing markup which
+// needs no style element, such as that of emails. Declarations are applied
+// in cascade order, where the !important declarations of the style attribute
+// of the element win over !important declarations of the stylesheets, which
+// win over the styles of the element, which win over other declarations, and
+// declarations of equal importance are ordered by the specificity of their
+// selectors and then by their position within the stylesheets. Uid attribute
+// selectors, such as those of CSSStylesheet, match the uid of the markup.
+// At-rules and rules with selectors Query can not compile, such as those using
+// :hover, can not be inlined and are dropped.
+func InlineStyles(root *Markup) error {
+	var sheets []*Markup
+
+	Walk(root, func(e *Markup) WalkAction {
+		if e.removed {
+			return WalkSkip
+		}
+
+		if e.stylesheet || strings.EqualFold(e.tagname, "style") {
+			sheets = append(sheets, e)
+			return WalkSkip
+		}
+
+		return WalkContinue
+	})
+
+	matches := make(map[*Markup][]inlineDeclaration)
+	var matched []*Markup
+	var order int
+
+	uids := addUIDs(root)
+	defer removeUIDs(uids)
+
+	for _, sheet := range sheets {
+		content, err := parser.Parse(styleContent(sheet))
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range content.Rules {
+			if rule.Kind != bcss.QualifiedRule {
+				continue
+			}
+
+			for _, selector := range rule.Selectors {
+				compiled, err := Query.Compile(selector)
+				if err != nil {
+					continue
+				}
+
+				targets := compiled.QueryAll(root)
+				if compiled.Match(root) {
+					targets = append([]*Markup{root}, targets...)
+				}
+
+				for _, target := range targets {
+					if _, ok := matches[target]; !ok {
+						matched = append(matched, target)
+					}
+
+					for _, declaration := range rule.Declarations {
+						matches[target] = append(matches[target], inlineDeclaration{
+							declaration: declaration,
+							specificity: compiled.Specificity(),
+							order:       order,
+						})
+
+						order++
+					}
+				}
+			}
+		}
+	}
+
+	for _, target := range matched {
+		inlineDeclarations(target, matches[target])
+	}
+
+	removeStyles(root)
+	return nil
+}
+
+// addUIDs adds a uid attribute holding the uid of the markup to the elements
+// without one, so uid attribute selectors match them, and returns the added
+// attributes.
+func addUIDs(root *Markup) map[*Markup]Property {
+	uids := make(map[*Markup]Property)
+
+	Walk(root, func(e *Markup) WalkAction {
+		if e.uid == "" {
+			return WalkContinue
+		}
+
+		if _, ok := attrValue(e, "uid"); !ok {
+			uid := NewAttr("uid", e.uid)
+			e.attrs = append(e.attrs, uid)
+			uids[e] = uid
+		}
+
+		return WalkContinue
+	})
+
+	return uids
+}
+
+// removeUIDs removes the uid attributes added by addUIDs.
+func removeUIDs(uids map[*Markup]Property) {
+	for e, uid := range uids {
+		for index, attr := range e.attrs {
+			if attr == uid {
+				e.attrs = append(e.attrs[:index], e.attrs[index+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// styleContent returns the stylesheet held by the style element.
+func styleContent(style *Markup) string {
+	if style.stylesheet || len(style.children) == 0 {
+		return style.TextContent()
+	}
+
+	var content []string
+	for _, child := range style.children {
+		content = append(content, child.TextContent())
+	}
+
+	return strings.Join(content, "")
+}
+
+// inlineDeclarations sets the styles of the element from the declarations
+// matching it along with it's own styles, including those of it's style
+// attribute which is removed, where the !important declarations of the style
+// attribute are applied last.
+func inlineDeclarations(target *Markup, declarations []inlineDeclaration) {
+	sort.SliceStable(declarations, func(i, j int) bool {
+		if declarations[i].specificity != declarations[j].specificity {
+			return declarations[i].specificity.Less(declarations[j].specificity)
+		}
+
+		return declarations[i].order < declarations[j].order
+	})
+
+	var styles []Property
+	index := make(map[string]int)
+
+	set := func(name, value string) {
+		style := NewCSSStyle(name, value)
+
+		if at, ok := index[style.Name]; ok {
+			styles[at] = style
+			return
+		}
+
+		index[style.Name] = len(styles)
+		styles = append(styles, style)
+	}
+
+	for _, item := range declarations {
+		if !item.declaration.Important {
+			set(item.declaration.Property, item.declaration.Value)
+		}
+	}
+
+	var attrs []Property
+	var important []*bcss.Declaration
+
+	for _, attr := range target.attrs {
+		name, value := attr.Render()
+		if !strings.EqualFold(name, "style") {
+			attrs = append(attrs, attr)
+			continue
+		}
+
+		// The trailing ";" keeps the value of the last declaration.
+		if own, err := parser.ParseDeclarations(value + ";"); err == nil {
+			for _, declaration := range own {
+				if declaration.Important {
+					important = append(important, declaration)
+					continue
+				}
+
+				set(declaration.Property, declaration.Value)
+			}
+		}
+	}
+
+	for _, style := range target.styles {
+		name, value := style.Render()
+		set(name, value)
+	}
+
+	for _, item := range declarations {
+		if item.declaration.Important {
+			set(item.declaration.Property, item.declaration.Value)
+		}
+	}
+
+	for _, declaration := range important {
+		set(declaration.Property, declaration.Value)
+	}
+
+	target.attrs = attrs
+	target.styles = styles
+}
+
+// removeStyles removes the stylesheets and style elements from the markup.
+func removeStyles(root *Markup) {
+	Walk(root, func(e *Markup) WalkAction {
+		var children []*Markup
+		for _, child := range e.children {
+			if !child.stylesheet && !strings.EqualFold(child.tagname, "style") {
+				children = append(children, child)
+			}
+		}
+
+		e.children = children
+		return WalkContinue
+	})
+}
@@ -0,0 +1,174 @@
+package trees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/css"
+	"github.com/gu-io/gu/trees/elems"
+)
+
+// styleOf returns the value of the style of the markup.
+func styleOf(item *trees.Markup, name string) string {
+	for _, style := range item.Styles() {
+		if styleName, value := style.Render(); styleName == name {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// TestSpecificity validates the specificity of selectors.
+func TestSpecificity(t *testing.T) {
+	cases := map[string]trees.Specificity{
+		"*":                        {0, 0, 0},
+		"p":                        {0, 0, 1},
+		"div p::before":            {0, 0, 3},
+		".note[type]:first-child":  {0, 3, 0},
+		"#main p.note":             {1, 1, 1},
+		"p:not(#main, .note)":      {1, 0, 1},
+		":where(#main) p":          {0, 0, 1},
+		"li:nth-child(2n of .odd)": {0, 2, 1},
+		"a, #main":                 {1, 0, 0},
+	}
+
+	for selector, expected := range cases {
+		compiled, err := trees.Query.Compile(selector)
+		if err != nil {
+			t.Fatalf("\t%s\t  Should have compiled %q: %+q", failed, selector, err)
+		}
+
+		if specificity := compiled.Specificity(); specificity != expected {
+			t.Fatalf("\t%s\t  Should have %q with specificity %v: %v", failed, selector, expected, specificity)
+		}
+	}
+	t.Logf("\t%s\t  Should have the specificity of selectors", success)
+
+	if !(trees.Specificity{0, 9, 9}).Less(trees.Specificity{1, 0, 0}) {
+		t.Fatalf("\t%s\t  Should have ordered ids over classes and types", failed)
+	}
+	t.Logf("\t%s\t  Should have ordered ids over classes and types", success)
+}
+
+// TestInlineStyles validates rules are inlined in cascade order.
+func TestInlineStyles(t *testing.T) {
+	parsed, err := trees.ParseTreeErr(`<div id="main">
+		<style>
+			#main p { color: green; }
+			p { color: red; margin: 0 !important; border: 0 !important; }
+			.note { color: blue; padding: 2px; }
+			p:hover { color: black; }
+			@media (max-width: 400px) { p { color: black; } }
+		</style>
+		<p class="note" style="margin: 4px; padding: 8px; border: 1px !important">Hello</p>
+		<p>World</p>
+	</div>`)
+	if err != nil || len(parsed) != 1 {
+		t.Fatalf("\t%s\t  Should have parsed the markup: %+q", failed, err)
+	}
+
+	root := parsed[0]
+	if err := trees.InlineStyles(root); err != nil {
+		t.Fatalf("\t%s\t  Should have inlined the styles: %+q", failed, err)
+	}
+
+	if trees.Query.Query(root, "style") != nil {
+		t.Fatalf("\t%s\t  Should have removed the style: %s", failed, root.HTML())
+	}
+	t.Logf("\t%s\t  Should have removed the style", success)
+
+	items := trees.Query.QueryAll(root, "p")
+	if len(items) != 2 {
+		t.Fatalf("\t%s\t  Should have kept the paragraphs: %s", failed, root.HTML())
+	}
+
+	note, plain := items[0], items[1]
+
+	if color := styleOf(note, "color"); color != "green" {
+		t.Fatalf("\t%s\t  Should have applied the most specific color: %q", failed, color)
+	}
+
+	if color := styleOf(plain, "color"); color != "green" {
+		t.Fatalf("\t%s\t  Should have ignored dynamic rules and at-rules: %q", failed, color)
+	}
+	t.Logf("\t%s\t  Should have applied declarations by specificity", success)
+
+	if padding := styleOf(note, "padding"); padding != "8px" {
+		t.Fatalf("\t%s\t  Should have kept the styles of the element: %q", failed, padding)
+	}
+
+	if margin := styleOf(note, "margin"); margin != "0" {
+		t.Fatalf("\t%s\t  Should have applied !important over the styles of the element: %q", failed, margin)
+	}
+
+	if border := styleOf(note, "border"); border != "1px" {
+		t.Fatalf("\t%s\t  Should have applied !important of the element over !important of the stylesheets: %q", failed, border)
+	}
+
+	if border := styleOf(plain, "border"); border != "0" {
+		t.Fatalf("\t%s\t  Should have applied !important of the stylesheets: %q", failed, border)
+	}
+
+	if _, ok := attrOf(note, "style"); ok {
+		t.Fatalf("\t%s\t  Should have merged the style attribute: %s", failed, note.HTML())
+	}
+	t.Logf("\t%s\t  Should have merged the styles of the element", success)
+}
+
+// TestInlineStylesheets validates the rules of component stylesheets are
+// inlined into their owners.
+func TestInlineStylesheets(t *testing.T) {
+	rule := css.New(`
+		& {
+			color: {{ .Color }};
+		}
+
+		& span {
+			font-weight: bold;
+		}
+	`)
+
+	root := elems.Div(
+		elems.Div(
+			elems.CSS(rule, struct{ Color string }{"red"}),
+			elems.Span(elems.Text("Hello")),
+		),
+		elems.Div(
+			elems.ScopedCSS(rule, struct{ Color string }{"blue"}),
+			elems.Span(elems.Text("World")),
+		),
+	)
+
+	if err := trees.InlineStyles(root); err != nil {
+		t.Fatalf("\t%s\t  Should have inlined the styles: %+q", failed, err)
+	}
+
+	html := root.HTML()
+	if trees.Query.Query(root, "style") != nil || strings.Contains(html, "<style") {
+		t.Fatalf("\t%s\t  Should have removed the stylesheets: %s", failed, html)
+	}
+	t.Logf("\t%s\t  Should have removed the stylesheets", success)
+
+	owners := root.Children()
+	if color := styleOf(owners[0], "color"); color != "red" {
+		t.Fatalf("\t%s\t  Should have inlined the uid scoped rules: %s", failed, html)
+	}
+
+	if color := styleOf(owners[1], "color"); color != "blue" {
+		t.Fatalf("\t%s\t  Should have inlined the class scoped rules: %s", failed, html)
+	}
+
+	for _, span := range trees.Query.QueryAll(root, "span") {
+		if weight := styleOf(span, "font-weight"); weight != "bold" {
+			t.Fatalf("\t%s\t  Should have inlined the rules of descendants: %s", failed, html)
+		}
+	}
+	t.Logf("\t%s\t  Should have inlined the rules of component stylesheets", success)
+
+	if _, ok := attrOf(owners[0], "uid"); ok {
+		t.Fatalf("\t%s\t  Should have left out the uid attributes used to match the rules: %s", failed, html)
+	}
+	t.Logf("\t%s\t  Should have left out the uid attributes used to match the rules", success)
+}
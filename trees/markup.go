@@ -23,9 +23,14 @@ type Markup struct {
 
 	uid           string
 	hash          string
+	key           string
 	tagname       string
 	textContent   string
 	idSelector    string
+	scopeClass    string
+	stylesheet    bool
+	comment       bool
+	doctype       bool
 	textContentFn func(*Markup) string
 
 	events         []Event
@@ -55,6 +60,35 @@ func NewText(txt string, dl ...interface{}) *Markup {
 	return em
 }
 
+// NewComment returns a new text markup holding a html comment of the provided
+// content.
+func NewComment(comment string) *Markup {
+	em := NewText("<!--" + comment + "-->")
+	em.comment = true
+	return em
+}
+
+// NewDoctype returns a new text markup holding a doctype declaration of the
+// provided content, such as "html".
+func NewDoctype(doctype string) *Markup {
+	em := NewText("<!DOCTYPE " + doctype + ">")
+	em.doctype = true
+	return em
+}
+
+// IsComment returns true/false if the markup is a text markup holding a html
+// comment created with NewComment. Text which only looks like a comment is
+// not one and is escaped has any other text.
+func (e *Markup) IsComment() bool {
+	return e.tagname == "text" && e.comment
+}
+
+// IsDoctype returns true/false if the markup is a text markup holding a
+// doctype declaration created with NewDoctype.
+func (e *Markup) IsDoctype() bool {
+	return e.tagname == "text" && e.doctype
+}
+
 // CSSStylesheet returns a new instance of a CSSStylesheet.
 func CSSStylesheet(rule *css.Rule, bind interface{}) *Markup {
 	content := NewMarkup("style", false)
@@ -62,6 +96,7 @@ func CSSStylesheet(rule *css.Rule, bind interface{}) *Markup {
 	content.allowAttributes = false
 	content.allowStyles = false
 	content.allowEvents = false
+	content.stylesheet = true
 	content.textContentFn = func(owner *Markup) string {
 		sheet, err := rule.Stylesheet(bind, owner.IDSelector(true))
 		if err != nil {
@@ -74,6 +109,40 @@ func CSSStylesheet(rule *css.Rule, bind interface{}) *Markup {
 	return content
 }
 
+// ScopedCSSStylesheet returns a new instance of a CSSStylesheet which scopes
+// it's rules with a class derived from the rule and the type of the binding,
+// usually the component rendering it, rather than the uid of it's parent. The
+// class is added to the markup the stylesheet is added to, hence all
+// instances of a component share the same stylesheet. Bound values should
+// therefore be the same for all instances of the type.
+func ScopedCSSStylesheet(rule *css.Rule, bind interface{}) *Markup {
+	class := rule.ScopeClass(fmt.Sprintf("%T", bind))
+
+	content := NewMarkup("style", false)
+	content.allowChildren = false
+	content.allowAttributes = false
+	content.allowStyles = false
+	content.allowEvents = false
+	content.scopeClass = class
+	content.stylesheet = true
+	content.textContentFn = func(owner *Markup) string {
+		sheet, err := rule.Stylesheet(bind, "."+class)
+		if err != nil {
+			return err.Error()
+		}
+
+		return sheet.String()
+	}
+
+	return content
+}
+
+// ScopeClass returns the class a scoped stylesheet adds to it's parent, or
+// an empty string for other markups.
+func (e *Markup) ScopeClass() string {
+	return e.scopeClass
+}
+
 //==============================================================================
 
 // NewMarkup returns a new element instance giving the specificed name which is
@@ -372,6 +441,29 @@ func (e *Markup) Removed() bool {
 	return !!e.removed
 }
 
+// SetKey sets the key which identifies the markup among it's siblings during
+// reconciliation.
+func (e *Markup) SetKey(key string) {
+	e.key = key
+}
+
+// Key returns the key which identifies the markup among it's siblings during
+// reconciliation, using the value of the markup's "key" attribute if no key
+// was set.
+func (e *Markup) Key() string {
+	if e.key != "" {
+		return e.key
+	}
+
+	for _, attr := range e.attrs {
+		if name, value := attr.Render(); name == "key" {
+			return value
+		}
+	}
+
+	return ""
+}
+
 // SwapUID swaps the uid of the internal Element.
 func (e *Markup) SwapUID(uid string) {
 	e.uid = uid
@@ -405,6 +497,8 @@ func (e *Markup) UpdateHash() {
 // here because they are the most volatile of the set and will periodically be
 // either changed and returned to normal values eg display: none to display: block
 // and vise-versa, so only attributes are used in the check process.
+// Children with keys (see SetKey) are matched by key instead of position, which
+// allows reordered, inserted and removed children keep their uid and hash.
 func (e *Markup) Reconcile(em *Markup) bool {
 	if e == em {
 		return false
@@ -429,7 +523,7 @@ func (e *Markup) Reconcile(em *Markup) bool {
 
 	// if we have a special case for text element then we do things differently
 	if e.Name() == "text" {
-		if e.TextContent() == em.TextContent() {
+		if e.TextContent() == em.TextContent() && e.comment == em.comment && e.doctype == em.doctype {
 			e.SwapHash(oldHash)
 			return false
 		}
@@ -462,6 +556,26 @@ func (e *Markup) Reconcile(em *Markup) bool {
 
 	var childChanged bool
 
+	if hasKeys(newChildren) || hasKeys(oldChildren) {
+		childChanged = e.reconcileKeyed(newChildren, oldChildren)
+	} else {
+		childChanged = e.reconcilePositioned(newChildren, oldChildren)
+	}
+
+	if !childChanged && equalAttr && equalStyle {
+		e.SwapHash(oldHash)
+		return false
+	}
+
+	return true
+}
+
+// reconcilePositioned reconciles the new children against the old children
+// by position, returning true if any child changed.
+func (e *Markup) reconcilePositioned(newChildren, oldChildren []*Markup) bool {
+	var childChanged bool
+	maxSize := len(newChildren)
+
 	for n, och := range oldChildren {
 		if maxSize > n {
 
@@ -486,12 +600,7 @@ func (e *Markup) Reconcile(em *Markup) bool {
 		childChanged = true
 	}
 
-	if !childChanged && equalAttr && equalStyle {
-		e.SwapHash(oldHash)
-		return false
-	}
-
-	return true
+	return childChanged
 }
 
 // FirstChild returns the first child in the markup children list.
@@ -528,9 +637,29 @@ func (e *Markup) AddChild(child ...*Markup) {
 
 		ch.parent = e
 		e.children = append(e.children, ch)
+
+		if ch.scopeClass != "" {
+			e.addClass(ch.scopeClass)
+		}
 	}
 }
 
+// addClass adds the class to the class attribute of the element if not
+// already contained.
+func (e *Markup) addClass(class string) {
+	value, ok := attrValue(e, "class")
+	if containsString(strings.Fields(value), class) {
+		return
+	}
+
+	if !ok {
+		NewClassList(class).Apply(e)
+		return
+	}
+
+	ReplaceORAddAttribute(e, "class", strings.TrimSpace(value+" "+class))
+}
+
 // Children returns the children list for the element
 func (e *Markup) Children() []*Markup {
 	return e.children
@@ -569,6 +698,11 @@ func (e *Markup) Clone() *Markup {
 	co.ID = e.ID
 	co.hash = e.hash
 	co.uid = e.uid
+	co.key = e.key
+	co.scopeClass = e.scopeClass
+	co.stylesheet = e.stylesheet
+	co.comment = e.comment
+	co.doctype = e.doctype
 
 	//copy over the attribute lockers
 	co.allowChildren = e.allowChildren
@@ -576,7 +710,7 @@ func (e *Markup) Clone() *Markup {
 	co.allowAttributes = e.allowAttributes
 
 	if e.Removed() {
-		co.Removed()
+		co.removed = true
 	}
 
 	//clone the internal styles
@@ -1,6 +1,11 @@
 package trees
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"bytes"
@@ -9,62 +14,135 @@ import (
 	"golang.org/x/net/html"
 )
 
+// ErrNotSingleRoot is returned when markup expected to contain a single root
+// element contains none or more than one.
+var ErrNotSingleRoot = errors.New("Markup must only returned single item in tree")
+
+// ParseError defines a error found while parsing markup, pointing to the line
+// and column of the markup where it occured.
+type ParseError struct {
+	Line   int
+	Column int
+	Reason string
+}
+
+// Error returns the error message of the ParseError.
+func (p *ParseError) Error() string {
+	return fmt.Sprintf("Parse error at line %d, column %d: %s", p.Line, p.Column, p.Reason)
+}
+
+// ParseErrors defines the list of errors found while parsing a markup.
+type ParseErrors []*ParseError
+
+// Error returns the messages of all errors, one per line.
+func (p ParseErrors) Error() string {
+	messages := make([]string, 0, len(p))
+	for _, err := range p {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+//==============================================================================
+
 // ParseTemplateInto parses the provided string has a template which
 // is processed with the provided binding and passed into the root.
 func ParseTemplateInto(root *Markup, markup string, binding interface{}) {
-	var bu bytes.Buffer
+	ParseTemplateIntoErr(root, markup, binding)
+}
 
-	tmpl := template.Must(template.New("Parsed").Parse(markup))
-	if err := tmpl.Execute(&bu, binding); err != nil {
-		return
+// ParseTemplateIntoErr parses the provided string has a template which
+// is processed with the provided binding and passed into the root, returning
+// the template or markup errors found.
+func ParseTemplateIntoErr(root *Markup, markup string, binding interface{}) error {
+	content, err := executeTemplate(markup, binding)
+	if err != nil {
+		return err
 	}
 
-	ParseToRoot(root, bu.String())
+	return ParseToRootErr(root, content)
 }
 
 // ParseTemplate parses the provided string has a template which
 // is processed with the provided binding.
 func ParseTemplate(markup string, binding interface{}) []*Markup {
-	var bu bytes.Buffer
-
-	tmpl := template.Must(template.New("Parsed").Parse(markup))
-	if err := tmpl.Execute(&bu, binding); err != nil {
+	trees, err := ParseTemplateErr(markup, binding)
+	if _, ok := err.(ParseErrors); err != nil && !ok {
 		return nil
 	}
 
-	return ParseTree(bu.String())
+	return trees
+}
+
+// ParseTemplateErr parses the provided string has a template which is
+// processed with the provided binding. Template errors are returned as a
+// *ParseError with the position in the template, while markup errors are
+// returned with the markup parsed.
+func ParseTemplateErr(markup string, binding interface{}) ([]*Markup, error) {
+	content, err := executeTemplate(markup, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseTreeErr(content)
 }
 
 // ParseToRoot passes the markup generated from the markup added to the provided
 // root.
 func ParseToRoot(root *Markup, markup string) {
-	trees := ParseTree(markup)
+	ParseToRootErr(root, markup)
+}
+
+// ParseToRootErr passes the markup generated from the markup added to the
+// provided root, returning the errors found in the markup.
+func ParseToRootErr(root *Markup, markup string) error {
+	trees, err := ParseTreeErr(markup)
 	for _, child := range trees {
 		child.Apply(root)
 	}
+
+	return err
 }
 
 // ParseAndFirst expects the markup provided to only have one root element which
 // will be returned.
 func ParseAndFirst(markup string) *Markup {
-	trees := ParseTree(markup)
+	tree, err := ParseAndFirstErr(markup)
+	if err == ErrNotSingleRoot {
+		panic(err.Error())
+	}
+
+	return tree
+}
+
+// ParseAndFirstErr expects the markup provided to only have one root element
+// which will be returned, else returning ErrNotSingleRoot.
+func ParseAndFirstErr(markup string) (*Markup, error) {
+	trees, err := ParseTreeErr(markup)
 	if len(trees) != 1 {
-		panic("Markup must only returned single item in tree")
+		return nil, ErrNotSingleRoot
 	}
 
-	return trees[0]
+	return trees[0], err
 }
 
 // ParseAsRoot returns the markup generated from the provided markup,
 // returning them as children of the provided root.
 func ParseAsRoot(root string, markup string) *Markup {
-	tokens := html.NewTokenizer(strings.NewReader(markup))
+	rootElem, _ := ParseAsRootErr(root, markup)
+	return rootElem
+}
 
+// ParseAsRootErr returns the markup generated from the provided markup,
+// returning them as children of the provided root with the errors found in
+// the markup.
+func ParseAsRootErr(root string, markup string) (*Markup, error) {
 	var sel *Selector
 	if sels := Query.ParseSelector(root); sels != nil {
 		sel = sels[0]
 	} else {
-		sel.Tag = root
+		sel = &Selector{Tag: root}
 	}
 
 	rootElem := NewMarkup(sel.Tag, false)
@@ -77,81 +155,348 @@ func ParseAsRoot(root string, markup string) *Markup {
 		(&ClassList{list: sel.Classes}).Apply(rootElem)
 	}
 
-	pullNode(tokens, rootElem)
-
-	return rootElem
+	return rootElem, parseInto(rootElem, markup)
 }
 
 // ParseTree takes a string markup and returns a *Markup which
 // contains the full structure transpiled
 // into the gutrees markup block structure.
 func ParseTree(markup string) []*Markup {
-	tokens := html.NewTokenizer(strings.NewReader(markup))
+	trees, _ := ParseTreeErr(markup)
+	return trees
+}
 
+// ParseTreeErr takes a string markup and returns the markups transpiled from
+// it with the errors found in the markup, such as unclosed elements or
+// unexpected end tags. The markup is parsed as far as possible, so the trees
+// are returned even when errors are.
+func ParseTreeErr(markup string) ([]*Markup, error) {
 	rootElem := NewMarkup("div", false)
-	pullNode(tokens, rootElem)
+	err := parseInto(rootElem, markup)
 
-	return rootElem.Children()
+	children := rootElem.Children()
+	for _, child := range children {
+		child.parent = nil
+	}
+
+	return children, err
 }
 
-func pullNode(tokens *html.Tokenizer, root *Markup) {
-	var node *Markup
+//==============================================================================
+
+// templateError matches the position within the errors of text/template.
+var templateError = regexp.MustCompile(`^template: [^:]*:(\d+)(?::(\d+))?: (.*)$`)
+
+// executeTemplate executes the markup as a template with the binding,
+// returning template errors as a *ParseError.
+func executeTemplate(markup string, binding interface{}) (string, error) {
+	tmpl, err := template.New("Parsed").Parse(markup)
+	if err != nil {
+		return "", templateParseError(err)
+	}
+
+	var bu bytes.Buffer
+	if err := tmpl.Execute(&bu, binding); err != nil {
+		return "", templateParseError(err)
+	}
+
+	return bu.String(), nil
+}
+
+// templateParseError returns the *ParseError for a text/template error.
+func templateParseError(err error) *ParseError {
+	perr := &ParseError{Line: 1, Column: 1, Reason: err.Error()}
+
+	if match := templateError.FindStringSubmatch(err.Error()); match != nil {
+		perr.Line, _ = strconv.Atoi(match[1])
+		if match[2] != "" {
+			perr.Column, _ = strconv.Atoi(match[2])
+		}
+
+		perr.Reason = match[3]
+	}
+
+	return perr
+}
+
+//==============================================================================
+
+// optionalEndTags contains the elements whose end tags can be omitted.
+var optionalEndTags = map[string]bool{
+	"li": true, "dt": true, "dd": true, "p": true, "option": true,
+	"optgroup": true, "tr": true, "td": true, "th": true, "thead": true,
+	"tbody": true, "tfoot": true, "rt": true, "rp": true, "colgroup": true,
+	"caption": true, "html": true, "head": true, "body": true,
+}
+
+// impliedEndTags contains the open elements implicitly closed by the start
+// of a element.
+var impliedEndTags = map[string][]string{
+	"li":       {"li", "p"},
+	"dt":       {"dt", "dd", "p"},
+	"dd":       {"dt", "dd", "p"},
+	"option":   {"option"},
+	"optgroup": {"option", "optgroup"},
+	"tr":       {"td", "th", "tr"},
+	"td":       {"td", "th"},
+	"th":       {"td", "th"},
+	"thead":    {"td", "th", "tr", "thead", "tbody", "tfoot"},
+	"tbody":    {"td", "th", "tr", "thead", "tbody", "tfoot"},
+	"tfoot":    {"td", "th", "tr", "thead", "tbody", "tfoot"},
+	"rt":       {"rt", "rp"},
+	"rp":       {"rt", "rp"},
+}
+
+// paragraphClosers contains the block elements which implicitly close a open
+// paragraph.
+var paragraphClosers = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true,
+	"details": true, "div": true, "dl": true, "fieldset": true,
+	"figcaption": true, "figure": true, "footer": true, "form": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "main": true, "menu": true, "nav": true,
+	"ol": true, "p": true, "pre": true, "section": true, "table": true,
+	"ul": true,
+}
+
+// preservedSpaceElements contains the elements whose whitespace only text is
+// kept.
+var preservedSpaceElements = map[string]bool{
+	"pre": true, "textarea": true, "script": true, "style": true,
+	"title": true, "listing": true,
+}
+
+// svgTagNames contains the case sensitive svg element names lowercased by
+// the tokenizer.
+var svgTagNames = caseTable(
+	"altGlyph", "altGlyphDef", "altGlyphItem", "animateColor",
+	"animateMotion", "animateTransform", "clipPath", "feBlend",
+	"feColorMatrix", "feComponentTransfer", "feComposite",
+	"feConvolveMatrix", "feDiffuseLighting", "feDisplacementMap",
+	"feDistantLight", "feDropShadow", "feFlood", "feFuncA", "feFuncB",
+	"feFuncG", "feFuncR", "feGaussianBlur", "feImage", "feMerge",
+	"feMergeNode", "feMorphology", "feOffset", "fePointLight",
+	"feSpecularLighting", "feSpotLight", "feTile", "feTurbulence",
+	"foreignObject", "glyphRef", "linearGradient", "radialGradient",
+	"textPath",
+)
+
+// svgAttrNames contains the case sensitive svg attribute names lowercased by
+// the tokenizer.
+var svgAttrNames = caseTable(
+	"attributeName", "attributeType", "baseFrequency", "baseProfile",
+	"calcMode", "clipPathUnits", "diffuseConstant", "edgeMode",
+	"filterUnits", "glyphRef", "gradientTransform", "gradientUnits",
+	"kernelMatrix", "kernelUnitLength", "keyPoints", "keySplines",
+	"keyTimes", "lengthAdjust", "limitingConeAngle", "markerHeight",
+	"markerUnits", "markerWidth", "maskContentUnits", "maskUnits",
+	"numOctaves", "pathLength", "patternContentUnits", "patternTransform",
+	"patternUnits", "pointsAtX", "pointsAtY", "pointsAtZ", "preserveAlpha",
+	"preserveAspectRatio", "primitiveUnits", "refX", "refY",
+	"repeatCount", "repeatDur", "requiredExtensions", "requiredFeatures",
+	"specularConstant", "specularExponent", "spreadMethod",
+	"startOffset", "stdDeviation", "stitchTiles", "surfaceScale",
+	"systemLanguage", "tableValues", "targetX", "targetY", "textLength",
+	"viewBox", "viewTarget", "xChannelSelector", "yChannelSelector",
+	"zoomAndPan",
+)
+
+// caseTable returns a map of the lowercased names to the names.
+func caseTable(names ...string) map[string]string {
+	table := make(map[string]string, len(names))
+	for _, name := range names {
+		table[strings.ToLower(name)] = name
+	}
 
+	return table
+}
+
+// openElement defines a element yet to be closed with the position of it's
+// start tag.
+type openElement struct {
+	node   *Markup
+	line   int
+	column int
+	svg    bool
+}
+
+// treeBuilder builds markups from the tokens of a html tokenizer, tracking
+// the open elements and the position within the markup.
+type treeBuilder struct {
+	tokens *html.Tokenizer
+	stack  []openElement
+	errs   ParseErrors
+	line   int
+	column int
+}
+
+// parseInto parses the markup into the provided root, returning the errors
+// found.
+func parseInto(root *Markup, markup string) error {
+	builder := treeBuilder{
+		tokens: html.NewTokenizer(strings.NewReader(markup)),
+		stack:  []openElement{{node: root}},
+		line:   1,
+		column: 1,
+	}
+
+	builder.build()
+
+	if len(builder.errs) == 0 {
+		return nil
+	}
+
+	return builder.errs
+}
+
+// build consumes all tokens from the tokenizer.
+func (b *treeBuilder) build() {
 	for {
-		token := tokens.Next()
+		token := b.tokens.Next()
+		line, column := b.line, b.column
+		b.advance(b.tokens.Raw())
 
 		switch token {
 		case html.ErrorToken:
+			if err := b.tokens.Err(); err != io.EOF {
+				b.fail(line, column, err.Error())
+			}
+
+			b.closeAll()
 			return
 
-		case html.TextToken, html.CommentToken, html.DoctypeToken:
-			text := strings.TrimSpace(string(tokens.Text()))
+		case html.CommentToken:
+			NewComment(string(b.tokens.Text())).Apply(b.current())
+
+		case html.DoctypeToken:
+			NewDoctype(string(b.tokens.Text())).Apply(b.current())
 
-			if text == "" {
+		case html.TextToken:
+			text := string(b.tokens.Text())
+			if strings.TrimSpace(text) == "" && !preservedSpaceElements[b.current().tagname] {
 				continue
 			}
 
-			if token == html.CommentToken {
-				text = "<!--" + text + "-->"
-			}
+			NewText(text).Apply(b.current())
 
-			if node != nil {
-				NewText(text).Apply(node)
-				continue
-			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			b.start(token == html.SelfClosingTagToken, line, column)
+
+		case html.EndTagToken:
+			name, _ := b.tokens.TagName()
+			b.end(string(name), line, column)
+		}
+	}
+}
+
+// start adds the element of the current start tag.
+func (b *treeBuilder) start(selfClosing bool, line int, column int) {
+	name, hasAttr := b.tokens.TagName()
+	tag := string(name)
+
+	b.closeImplied(tag)
+
+	parent := b.stack[len(b.stack)-1]
+	svg := parent.svg || tag == "svg"
+
+	autoclose := selfClosing || isVoidElement(tag)
+
+	node := NewMarkup(tag, autoclose)
+	if original, ok := svgTagNames[tag]; ok && svg {
+		node.tagname = original
+	}
+
+	node.Apply(parent.node)
+
+	for hasAttr {
+		var key, val []byte
+		key, val, hasAttr = b.tokens.TagAttr()
+
+		if original, ok := svgAttrNames[string(key)]; ok && svg {
+			(&Attribute{Name: original, Value: string(val)}).Apply(node)
+			continue
+		}
+
+		NewAttr(string(key), string(val)).Apply(node)
+	}
+
+	if autoclose {
+		return
+	}
+
+	b.stack = append(b.stack, openElement{node: node, line: line, column: column, svg: svg && tag != "foreignobject"})
+}
 
-			NewText(text).Apply(root)
+// end closes the nearest open element of the end tag, reporting the elements
+// left unclosed within it.
+func (b *treeBuilder) end(tag string, line int, column int) {
+	for index := len(b.stack) - 1; index > 0; index-- {
+		if !strings.EqualFold(b.stack[index].node.tagname, tag) {
 			continue
+		}
 
-		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
-			if token == html.EndTagToken {
-				node = nil
-				return
-			}
+		for len(b.stack) > index+1 {
+			b.pop()
+		}
 
-			tagName, hasAttr := tokens.TagName()
+		b.stack = b.stack[:index]
+		return
+	}
 
-			node = NewMarkup(string(tagName), token == html.SelfClosingTagToken)
-			node.Apply(root)
+	b.fail(line, column, fmt.Sprintf("unexpected end tag </%s>", tag))
+}
 
-			if hasAttr {
-			attrLoop:
-				for {
-					key, val, more := tokens.TagAttr()
+// closeImplied closes the open elements implicitly closed by the start of
+// the provided tag.
+func (b *treeBuilder) closeImplied(tag string) {
+	if paragraphClosers[tag] && len(b.stack) > 1 && b.current().tagname == "p" {
+		b.stack = b.stack[:len(b.stack)-1]
+	}
 
-					NewAttr(string(key), string(val)).Apply(node)
+	implied := impliedEndTags[tag]
 
-					if !more {
-						break attrLoop
-					}
-				}
-			}
+	for len(b.stack) > 1 && containsString(implied, b.current().tagname) {
+		b.stack = b.stack[:len(b.stack)-1]
+	}
+}
 
-			if token == html.SelfClosingTagToken {
-				continue
-			}
+// closeAll closes all open elements once the markup ends.
+func (b *treeBuilder) closeAll() {
+	for len(b.stack) > 1 {
+		b.pop()
+	}
+}
+
+// pop closes the current element, reporting it as unclosed unless it's end
+// tag is optional.
+func (b *treeBuilder) pop() {
+	open := b.stack[len(b.stack)-1]
+	b.stack = b.stack[:len(b.stack)-1]
+
+	if !optionalEndTags[open.node.tagname] {
+		b.fail(open.line, open.column, fmt.Sprintf("unclosed element <%s>", open.node.tagname))
+	}
+}
+
+// current returns the markup of the current open element.
+func (b *treeBuilder) current() *Markup {
+	return b.stack[len(b.stack)-1].node
+}
 
-			pullNode(tokens, node)
+// fail records a error at the provided position.
+func (b *treeBuilder) fail(line int, column int, reason string) {
+	b.errs = append(b.errs, &ParseError{Line: line, Column: column, Reason: reason})
+}
+
+// advance moves the position past the raw content of a token.
+func (b *treeBuilder) advance(raw []byte) {
+	for _, char := range string(raw) {
+		if char == '\n' {
+			b.line++
+			b.column = 1
+			continue
 		}
+
+		b.column++
 	}
 }
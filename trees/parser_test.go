@@ -39,3 +39,105 @@ func TestParser(t *testing.T) {
 
 	t.Logf("\t%s\t Parser should have produced markup for html: %q", success, strings.Join(html, ""))
 }
+
+// TestParserFidelity validates comments, doctype and raw text are kept.
+func TestParserFidelity(t *testing.T) {
+	result, err := trees.ParseTreeErr(`<!DOCTYPE html>
+<!-- header  -->
+<div>Fish &amp; <b>Chips</b> today<br>
+  <script>if (a < b && c) { "</div>" }</script>
+  <textarea>  some <b>text</b></textarea>
+  <ul><li>One<li>Two</ul>
+  <svg viewBox="0 0 10 10"><clipPath id="c"></clipPath></svg>
+</div>`)
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have parsed markup without errors: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have parsed markup without errors", success)
+
+	if len(result) != 3 || !result[0].IsDoctype() || !result[1].IsComment() {
+		t.Fatalf("\t%s\t  Should have kept doctype and comment: %d", failed, len(result))
+	}
+
+	if result[0].TextContent() != "<!DOCTYPE html>" || result[1].TextContent() != "<!-- header  -->" {
+		t.Fatalf("\t%s\t  Should have kept doctype and comment content: %q %q", failed, result[0].TextContent(), result[1].TextContent())
+	}
+	t.Logf("\t%s\t  Should have kept doctype and comment", success)
+
+	div := result[2]
+
+	if script := trees.Query.Query(div, "script"); script == nil || script.Children()[0].TextContent() != `if (a < b && c) { "</div>" }` {
+		t.Fatalf("\t%s\t  Should have kept script content raw", failed)
+	}
+
+	if textarea := trees.Query.Query(div, "textarea"); textarea == nil || textarea.Children()[0].TextContent() != "  some <b>text</b>" {
+		t.Fatalf("\t%s\t  Should have kept textarea content raw", failed)
+	}
+	t.Logf("\t%s\t  Should have kept raw text content", success)
+
+	if text := div.Children()[0].TextContent(); text != "Fish & " {
+		t.Fatalf("\t%s\t  Should have kept text spacing: %q", failed, text)
+	}
+
+	if br := trees.Query.Query(div, "br"); br == nil || br.Parent() != div {
+		t.Fatalf("\t%s\t  Should have closed void element", failed)
+	}
+
+	if items := trees.Query.QueryAll(div, "ul > li"); len(items) != 2 {
+		t.Fatalf("\t%s\t  Should have closed implied list items: %d", failed, len(items))
+	}
+	t.Logf("\t%s\t  Should have closed void and implied elements", success)
+
+	if svg := trees.Query.Query(div, "svg"); svg == nil || !strings.Contains(svg.HTML(), `viewBox="0 0 10 10"`) || !strings.Contains(svg.HTML(), "<clipPath") {
+		t.Fatalf("\t%s\t  Should have kept svg names case", failed)
+	}
+	t.Logf("\t%s\t  Should have kept svg names case", success)
+
+	if name, _ := trees.NewAttr("viewBox", "").Render(); name != "viewBox" {
+		t.Fatalf("\t%s\t  Should have kept svg names case for new attributes: %q", failed, name)
+	}
+
+	if name, _ := trees.NewAttr("ClassName", "").Render(); name != "classname" {
+		t.Fatalf("\t%s\t  Should have lowercased html names of new attributes: %q", failed, name)
+	}
+	t.Logf("\t%s\t  Should have kept svg names case for new attributes", success)
+}
+
+// TestParserErrors validates the positions of the errors found in markup.
+func TestParserErrors(t *testing.T) {
+	result, err := trees.ParseTreeErr("<div>\n  <span>Hello</div>\n</section>")
+
+	errs, ok := err.(trees.ParseErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("\t%s\t  Should have returned markup errors: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have returned markup errors", success)
+
+	if errs[0].Line != 2 || errs[0].Column != 3 || errs[0].Reason != "unclosed element <span>" {
+		t.Fatalf("\t%s\t  Should have positioned unclosed element: %s", failed, errs[0])
+	}
+
+	if errs[1].Line != 3 || errs[1].Column != 1 || errs[1].Reason != "unexpected end tag </section>" {
+		t.Fatalf("\t%s\t  Should have positioned unexpected end tag: %s", failed, errs[1])
+	}
+	t.Logf("\t%s\t  Should have positioned markup errors", success)
+
+	if len(result) != 1 || trees.Query.Query(result[0], "span") == nil {
+		t.Fatalf("\t%s\t  Should have returned the parsed markup", failed)
+	}
+	t.Logf("\t%s\t  Should have returned the parsed markup", success)
+
+	if _, err := trees.ParseTemplateErr("<div>\n{{.Name}</div>", nil); err == nil || err.(*trees.ParseError).Line != 2 {
+		t.Fatalf("\t%s\t  Should have returned template error: %+q", failed, err)
+	}
+
+	if trees.ParseTemplate("<div>{{.Name.Missing}}</div>", struct{ Name string }{}) != nil {
+		t.Fatalf("\t%s\t  Should have returned nil for template error", failed)
+	}
+	t.Logf("\t%s\t  Should have returned template errors", success)
+
+	if _, err := trees.ParseAndFirstErr("<a></a><b></b>"); err != trees.ErrNotSingleRoot {
+		t.Fatalf("\t%s\t  Should have returned single root error: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have returned single root error", success)
+}
@@ -2,6 +2,7 @@ package trees
 
 import (
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 )
@@ -72,7 +73,11 @@ func (m AttrWriter) Print(a []Property) string {
 
 	for _, ar := range a {
 		name, val := ar.Render()
-		attrs = append(attrs, fmt.Sprintf(attrformt, name, val))
+		if !isAttrName(name) {
+			continue
+		}
+
+		attrs = append(attrs, fmt.Sprintf(attrformt, name, EscapeAttr(name, val)))
 	}
 
 	return strings.Join(attrs, " ")
@@ -103,7 +108,11 @@ func (m StyleWriter) Print(s []Property) string {
 
 	for _, cs := range s {
 		name, val := cs.Render()
-		css = append(css, fmt.Sprintf(styleformt, name, val))
+		if !isStyleName(name) {
+			continue
+		}
+
+		css = append(css, fmt.Sprintf(styleformt, name, FilterStyleValue(val)))
 	}
 
 	return strings.Join(css, " ")
@@ -122,9 +131,10 @@ type TextWriter struct{}
 // SimpleTextWriter provides a basic text writer
 var SimpleTextWriter TextWriter
 
-// Print returns the string representation of the text object
+// Print returns the string representation of the text object, escaped for
+// it's context within the markup.
 func (m TextWriter) Print(t *Markup) string {
-	return t.TextContent()
+	return printableText(t)
 }
 
 //==============================================================================
@@ -134,6 +144,7 @@ type ElementWriter struct {
 	attrWriter  AttrPrinter
 	styleWriter StylePrinter
 	text        TextPrinter
+	nonce       string
 }
 
 // SimpleElementWriter provides a default writer using the basic attribute and style writers
@@ -153,15 +164,40 @@ func (m *ElementWriter) Write(ma *Markup) (string, error) {
 	return m.Print(ma), nil
 }
 
+// WriteTo writes the giving *Markup into the writer without building the
+// whole markup as a string, returning the total of bytes written. If the
+// writer can be flushed, it is flushed after each element within the
+// children of the markup, such as each view within a app's head and body.
+func (m *ElementWriter) WriteTo(w io.Writer, e *Markup) (int64, error) {
+	sw := newStreamWriter(w)
+	m.write(sw, e, 0, sw.Flush)
+	return sw.n, sw.err
+}
+
 // Print returns the string representation of the element
 func (m *ElementWriter) Print(e *Markup) string {
+	var out strings.Builder
+	m.write(&out, e, 0, nil)
+	return out.String()
+}
+
+// write writes the string representation of the element into the writer,
+// calling flush after each element written at most at the flush depth.
+func (m *ElementWriter) write(w printWriter, e *Markup, depth int, flush func()) {
 	if e.Removed() && GetMode() > Normal {
-		return ""
+		return
+	}
+
+	//trusted raw html is written as is
+	if e.IsRawHTML() {
+		w.WriteString(e.TextContent())
+		return
 	}
 
 	//if we are dealing with a text type just return the content
 	if e.Name() == "text" {
-		return m.text.Print(e)
+		w.WriteString(m.text.Print(e))
+		return
 	}
 
 	// Management attributes.
@@ -174,51 +210,51 @@ func (m *ElementWriter) Print(e *Markup) string {
 		mido = append(mido, hash, uid)
 	}
 
+	//lets write the elements markup now
+	w.WriteString(fmt.Sprintf("<%s", e.Name()))
+
 	//write out the hash and uid as attributes
-	hashes := m.attrWriter.Print(mido)
+	w.WriteString(m.attrWriter.Print(mido))
 
 	//write out the elements attributes using the AttrWriter
-	attrs := m.attrWriter.Print(e.Attributes())
+	w.WriteString(m.attrWriter.Print(e.Attributes()))
 
-	//write out the elements inline-styles using the StyleWriter
-	style := m.styleWriter.Print(e.Styles())
+	//write out the nonce of inline scripts and styles
+	if needsNonce(e, m.nonce) {
+		w.WriteString(m.attrWriter.Print([]Property{&Attribute{Name: "nonce", Value: m.nonce}}))
+	}
 
-	var closer string
-	var beginbrack string
+	//write out the elements inline-styles using the StyleWriter
+	if style := m.styleWriter.Print(e.Styles()); len(style) != 0 {
+		w.WriteString(fmt.Sprintf(` style="%s"`, attrEscaper.Replace(style)))
+	}
 
 	if e.AutoClosed() {
-		closer = "/>"
-	} else {
-		beginbrack = ">"
-		closer = fmt.Sprintf("</%s>", e.Name())
+		w.WriteString("/>")
+		return
 	}
 
-	var children = []string{}
+	w.WriteString(">")
+
+	if isRawTextElement(e.Name()) {
+		w.WriteString(escapeRawText(e.TextContent()))
+	} else {
+		w.WriteString(EscapeText(e.TextContent()))
+	}
 
 	for _, ch := range e.Children() {
 		if ch.UID() == e.UID() {
 			continue
 		}
 
-		children = append(children, m.Print(ch))
+		m.write(w, ch, depth+1, flush)
+
+		if flush != nil && depth < flushDepth && ch.Name() != "text" {
+			flush()
+		}
 	}
 
-	//lets create the elements markup now
-	return strings.Join([]string{
-		fmt.Sprintf("<%s", e.Name()),
-		hashes,
-		attrs,
-		(func() string {
-			if len(style) != 0 {
-				return fmt.Sprintf(` style="%s"`, style)
-			}
-			return ""
-		}()),
-		beginbrack,
-		e.TextContent(),
-		strings.Join(children, ""),
-		closer,
-	}, "")
+	w.WriteString(fmt.Sprintf("</%s>", e.Name()))
 }
 
 //==============================================================================
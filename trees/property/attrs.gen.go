@@ -0,0 +1,1073 @@
+// Code generated by generate.go; DO NOT EDIT.
+
+//go:generate go run generate.go
+
+// Attribute source: "HTML Living Standard" by WHATWG, https://html.spec.whatwg.org/multipage/indices.html#attributes-3,
+// and "Accessible Rich Internet Applications (WAI-ARIA) 1.2" by W3C, https://www.w3.org/TR/wai-aria-1.2/.
+
+package property
+
+import "github.com/gu-io/gu/trees"
+
+// AbbrAttr defines attributes of type "abbr" for the th elements.
+func AbbrAttr(val string) trees.Property {
+	return trees.NewAttr("abbr", val)
+}
+
+// AcceptAttr defines attributes of type "accept" for the input elements.
+func AcceptAttr(val string) trees.Property {
+	return trees.NewAttr("accept", val)
+}
+
+// AcceptCharsetAttr defines attributes of type "accept-charset" for the form elements.
+func AcceptCharsetAttr(val string) trees.Property {
+	return trees.NewAttr("accept-charset", val)
+}
+
+// AccessKeyAttr defines attributes of type "accesskey" for html element types.
+func AccessKeyAttr(val string) trees.Property {
+	return trees.NewAttr("accesskey", val)
+}
+
+// ActionAttr defines attributes of type "action" for the form elements.
+func ActionAttr(val string) trees.Property {
+	return trees.NewAttr("action", val)
+}
+
+// AllowAttr defines attributes of type "allow" for the iframe elements.
+func AllowAttr(val string) trees.Property {
+	return trees.NewAttr("allow", val)
+}
+
+// AllowFullscreenAttr defines boolean attributes of type "allowfullscreen" for the iframe elements, which are set only when on is true.
+func AllowFullscreenAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("allowfullscreen", ""))
+}
+
+// AltAttr defines attributes of type "alt" for the area, img, input elements.
+func AltAttr(val string) trees.Property {
+	return trees.NewAttr("alt", val)
+}
+
+// AsAttr defines attributes of type "as" for the link elements.
+func AsAttr(val string) trees.Property {
+	return trees.NewAttr("as", val)
+}
+
+// AsyncAttr defines boolean attributes of type "async" for the script elements, which are set only when on is true.
+func AsyncAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("async", ""))
+}
+
+// AutoCapitalizeAttr defines attributes of type "autocapitalize" for html element types.
+func AutoCapitalizeAttr(val string) trees.Property {
+	return trees.NewAttr("autocapitalize", val)
+}
+
+// AutoCompleteAttr defines attributes of type "autocomplete" for the form, input, select, textarea elements.
+func AutoCompleteAttr(val string) trees.Property {
+	return trees.NewAttr("autocomplete", val)
+}
+
+// AutofocusBoolAttr defines boolean attributes of type "autofocus" for html element types, which are set only when on is true.
+func AutofocusBoolAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("autofocus", ""))
+}
+
+// AutoPlayAttr defines boolean attributes of type "autoplay" for the audio, video elements, which are set only when on is true.
+func AutoPlayAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("autoplay", ""))
+}
+
+// BlockingAttr defines attributes of type "blocking" for the link, script, style elements.
+func BlockingAttr(val string) trees.Property {
+	return trees.NewAttr("blocking", val)
+}
+
+// CharsetAttr defines attributes of type "charset" for the meta elements.
+func CharsetAttr(val string) trees.Property {
+	return trees.NewAttr("charset", val)
+}
+
+// CheckedBoolAttr defines boolean attributes of type "checked" for the input elements, which are set only when on is true.
+func CheckedBoolAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("checked", ""))
+}
+
+// CiteAttr defines attributes of type "cite" for the blockquote, del, ins, q elements.
+func CiteAttr(val string) trees.Property {
+	return trees.NewAttr("cite", val)
+}
+
+// ColsAttr defines attributes of type "cols" for the textarea elements.
+func ColsAttr(val string) trees.Property {
+	return trees.NewAttr("cols", val)
+}
+
+// ColSpanAttr defines attributes of type "colspan" for the td, th elements.
+func ColSpanAttr(val string) trees.Property {
+	return trees.NewAttr("colspan", val)
+}
+
+// ContentAttr defines attributes of type "content" for the meta elements.
+func ContentAttr(val string) trees.Property {
+	return trees.NewAttr("content", val)
+}
+
+// ContentEditableAttr defines attributes of type "contenteditable" for html element types.
+func ContentEditableAttr(val string) trees.Property {
+	return trees.NewAttr("contenteditable", val)
+}
+
+// ControlsAttr defines boolean attributes of type "controls" for the audio, video elements, which are set only when on is true.
+func ControlsAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("controls", ""))
+}
+
+// CoordsAttr defines attributes of type "coords" for the area elements.
+func CoordsAttr(val string) trees.Property {
+	return trees.NewAttr("coords", val)
+}
+
+// CrossOriginAttr defines attributes of type "crossorigin" for the audio, img, link, script, video elements.
+func CrossOriginAttr(val string) trees.Property {
+	return trees.NewAttr("crossorigin", val)
+}
+
+// ObjectDataAttr defines attributes of type "data" for the object elements.
+func ObjectDataAttr(val string) trees.Property {
+	return trees.NewAttr("data", val)
+}
+
+// DateTimeAttr defines attributes of type "datetime" for the del, ins, time elements.
+func DateTimeAttr(val string) trees.Property {
+	return trees.NewAttr("datetime", val)
+}
+
+// DecodingAttr defines attributes of type "decoding" for the img elements.
+func DecodingAttr(val string) trees.Property {
+	return trees.NewAttr("decoding", val)
+}
+
+// DefaultAttr defines boolean attributes of type "default" for the track elements, which are set only when on is true.
+func DefaultAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("default", ""))
+}
+
+// DeferAttr defines boolean attributes of type "defer" for the script elements, which are set only when on is true.
+func DeferAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("defer", ""))
+}
+
+// DirAttr defines attributes of type "dir" for html element types.
+func DirAttr(val string) trees.Property {
+	return trees.NewAttr("dir", val)
+}
+
+// DirNameAttr defines attributes of type "dirname" for the input, textarea elements.
+func DirNameAttr(val string) trees.Property {
+	return trees.NewAttr("dirname", val)
+}
+
+// DisabledAttr defines boolean attributes of type "disabled" for the button, fieldset, input, link, optgroup, option, select, textarea elements, which are set only when on is true.
+func DisabledAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("disabled", ""))
+}
+
+// DownloadAttr defines attributes of type "download" for the a, area elements.
+func DownloadAttr(val string) trees.Property {
+	return trees.NewAttr("download", val)
+}
+
+// DraggableAttr defines attributes of type "draggable" for html element types.
+func DraggableAttr(val string) trees.Property {
+	return trees.NewAttr("draggable", val)
+}
+
+// EncTypeAttr defines attributes of type "enctype" for the form elements.
+func EncTypeAttr(val string) trees.Property {
+	return trees.NewAttr("enctype", val)
+}
+
+// EnterKeyHintAttr defines attributes of type "enterkeyhint" for html element types.
+func EnterKeyHintAttr(val string) trees.Property {
+	return trees.NewAttr("enterkeyhint", val)
+}
+
+// FetchPriorityAttr defines attributes of type "fetchpriority" for the img, link, script elements.
+func FetchPriorityAttr(val string) trees.Property {
+	return trees.NewAttr("fetchpriority", val)
+}
+
+// ForAttr defines attributes of type "for" for the label, output elements.
+func ForAttr(val string) trees.Property {
+	return trees.NewAttr("for", val)
+}
+
+// FormAttr defines attributes of type "form" for the button, fieldset, input, object, output, select, textarea elements.
+func FormAttr(val string) trees.Property {
+	return trees.NewAttr("form", val)
+}
+
+// FormActionAttr defines attributes of type "formaction" for the button, input elements.
+func FormActionAttr(val string) trees.Property {
+	return trees.NewAttr("formaction", val)
+}
+
+// FormEncTypeAttr defines attributes of type "formenctype" for the button, input elements.
+func FormEncTypeAttr(val string) trees.Property {
+	return trees.NewAttr("formenctype", val)
+}
+
+// FormMethodAttr defines attributes of type "formmethod" for the button, input elements.
+func FormMethodAttr(val string) trees.Property {
+	return trees.NewAttr("formmethod", val)
+}
+
+// FormNoValidateAttr defines boolean attributes of type "formnovalidate" for the button, input elements, which are set only when on is true.
+func FormNoValidateAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("formnovalidate", ""))
+}
+
+// FormTargetAttr defines attributes of type "formtarget" for the button, input elements.
+func FormTargetAttr(val string) trees.Property {
+	return trees.NewAttr("formtarget", val)
+}
+
+// HeadersAttr defines attributes of type "headers" for the td, th elements.
+func HeadersAttr(val string) trees.Property {
+	return trees.NewAttr("headers", val)
+}
+
+// HeightAttr defines attributes of type "height" for the canvas, embed, iframe, img, input, object, source, video elements.
+func HeightAttr(val string) trees.Property {
+	return trees.NewAttr("height", val)
+}
+
+// HiddenAttr defines boolean attributes of type "hidden" for html element types, which are set only when on is true.
+func HiddenAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("hidden", ""))
+}
+
+// HighAttr defines attributes of type "high" for the meter elements.
+func HighAttr(val string) trees.Property {
+	return trees.NewAttr("high", val)
+}
+
+// HrefLangAttr defines attributes of type "hreflang" for the a, link elements.
+func HrefLangAttr(val string) trees.Property {
+	return trees.NewAttr("hreflang", val)
+}
+
+// HTTPEquivAttr defines attributes of type "http-equiv" for the meta elements.
+func HTTPEquivAttr(val string) trees.Property {
+	return trees.NewAttr("http-equiv", val)
+}
+
+// ImageSizesAttr defines attributes of type "imagesizes" for the link elements.
+func ImageSizesAttr(val string) trees.Property {
+	return trees.NewAttr("imagesizes", val)
+}
+
+// ImageSrcSetAttr defines attributes of type "imagesrcset" for the link elements.
+func ImageSrcSetAttr(val string) trees.Property {
+	return trees.NewAttr("imagesrcset", val)
+}
+
+// InertAttr defines boolean attributes of type "inert" for html element types, which are set only when on is true.
+func InertAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("inert", ""))
+}
+
+// InputModeAttr defines attributes of type "inputmode" for html element types.
+func InputModeAttr(val string) trees.Property {
+	return trees.NewAttr("inputmode", val)
+}
+
+// IntegrityAttr defines attributes of type "integrity" for the link, script elements.
+func IntegrityAttr(val string) trees.Property {
+	return trees.NewAttr("integrity", val)
+}
+
+// IsAttr defines attributes of type "is" for html element types.
+func IsAttr(val string) trees.Property {
+	return trees.NewAttr("is", val)
+}
+
+// IsMapAttr defines boolean attributes of type "ismap" for the img elements, which are set only when on is true.
+func IsMapAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("ismap", ""))
+}
+
+// ItemIDAttr defines attributes of type "itemid" for html element types.
+func ItemIDAttr(val string) trees.Property {
+	return trees.NewAttr("itemid", val)
+}
+
+// ItemPropAttr defines attributes of type "itemprop" for html element types.
+func ItemPropAttr(val string) trees.Property {
+	return trees.NewAttr("itemprop", val)
+}
+
+// ItemRefAttr defines attributes of type "itemref" for html element types.
+func ItemRefAttr(val string) trees.Property {
+	return trees.NewAttr("itemref", val)
+}
+
+// ItemScopeAttr defines boolean attributes of type "itemscope" for html element types, which are set only when on is true.
+func ItemScopeAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("itemscope", ""))
+}
+
+// ItemTypeAttr defines attributes of type "itemtype" for html element types.
+func ItemTypeAttr(val string) trees.Property {
+	return trees.NewAttr("itemtype", val)
+}
+
+// KindAttr defines attributes of type "kind" for the track elements.
+func KindAttr(val string) trees.Property {
+	return trees.NewAttr("kind", val)
+}
+
+// LabelAttr defines attributes of type "label" for the optgroup, option, track elements.
+func LabelAttr(val string) trees.Property {
+	return trees.NewAttr("label", val)
+}
+
+// LangAttr defines attributes of type "lang" for html element types.
+func LangAttr(val string) trees.Property {
+	return trees.NewAttr("lang", val)
+}
+
+// ListAttr defines attributes of type "list" for the input elements.
+func ListAttr(val string) trees.Property {
+	return trees.NewAttr("list", val)
+}
+
+// LoadingAttr defines attributes of type "loading" for the iframe, img elements.
+func LoadingAttr(val string) trees.Property {
+	return trees.NewAttr("loading", val)
+}
+
+// LoopAttr defines boolean attributes of type "loop" for the audio, video elements, which are set only when on is true.
+func LoopAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("loop", ""))
+}
+
+// LowAttr defines attributes of type "low" for the meter elements.
+func LowAttr(val string) trees.Property {
+	return trees.NewAttr("low", val)
+}
+
+// MaxAttr defines attributes of type "max" for the input, meter, progress elements.
+func MaxAttr(val string) trees.Property {
+	return trees.NewAttr("max", val)
+}
+
+// MaxLengthAttr defines attributes of type "maxlength" for the input, textarea elements.
+func MaxLengthAttr(val string) trees.Property {
+	return trees.NewAttr("maxlength", val)
+}
+
+// MediaAttr defines attributes of type "media" for the link, meta, source, style elements.
+func MediaAttr(val string) trees.Property {
+	return trees.NewAttr("media", val)
+}
+
+// MethodAttr defines attributes of type "method" for the form elements.
+func MethodAttr(val string) trees.Property {
+	return trees.NewAttr("method", val)
+}
+
+// MinAttr defines attributes of type "min" for the input, meter elements.
+func MinAttr(val string) trees.Property {
+	return trees.NewAttr("min", val)
+}
+
+// MinLengthAttr defines attributes of type "minlength" for the input, textarea elements.
+func MinLengthAttr(val string) trees.Property {
+	return trees.NewAttr("minlength", val)
+}
+
+// MultipleAttr defines boolean attributes of type "multiple" for the input, select elements, which are set only when on is true.
+func MultipleAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("multiple", ""))
+}
+
+// MutedAttr defines boolean attributes of type "muted" for the audio, video elements, which are set only when on is true.
+func MutedAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("muted", ""))
+}
+
+// NoModuleAttr defines boolean attributes of type "nomodule" for the script elements, which are set only when on is true.
+func NoModuleAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("nomodule", ""))
+}
+
+// NonceAttr defines attributes of type "nonce" for html element types.
+func NonceAttr(val string) trees.Property {
+	return trees.NewAttr("nonce", val)
+}
+
+// NoValidateAttr defines boolean attributes of type "novalidate" for the form elements, which are set only when on is true.
+func NoValidateAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("novalidate", ""))
+}
+
+// OpenAttr defines boolean attributes of type "open" for the details, dialog elements, which are set only when on is true.
+func OpenAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("open", ""))
+}
+
+// OptimumAttr defines attributes of type "optimum" for the meter elements.
+func OptimumAttr(val string) trees.Property {
+	return trees.NewAttr("optimum", val)
+}
+
+// PatternAttr defines attributes of type "pattern" for the input elements.
+func PatternAttr(val string) trees.Property {
+	return trees.NewAttr("pattern", val)
+}
+
+// PingAttr defines attributes of type "ping" for the a, area elements.
+func PingAttr(val string) trees.Property {
+	return trees.NewAttr("ping", val)
+}
+
+// PlaysInlineAttr defines boolean attributes of type "playsinline" for the video elements, which are set only when on is true.
+func PlaysInlineAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("playsinline", ""))
+}
+
+// PopoverAttr defines attributes of type "popover" for html element types.
+func PopoverAttr(val string) trees.Property {
+	return trees.NewAttr("popover", val)
+}
+
+// PopoverTargetAttr defines attributes of type "popovertarget" for the button, input elements.
+func PopoverTargetAttr(val string) trees.Property {
+	return trees.NewAttr("popovertarget", val)
+}
+
+// PopoverTargetActionAttr defines attributes of type "popovertargetaction" for the button, input elements.
+func PopoverTargetActionAttr(val string) trees.Property {
+	return trees.NewAttr("popovertargetaction", val)
+}
+
+// PosterAttr defines attributes of type "poster" for the video elements.
+func PosterAttr(val string) trees.Property {
+	return trees.NewAttr("poster", val)
+}
+
+// PreloadAttr defines attributes of type "preload" for the audio, video elements.
+func PreloadAttr(val string) trees.Property {
+	return trees.NewAttr("preload", val)
+}
+
+// ReadOnlyAttr defines boolean attributes of type "readonly" for the input, textarea elements, which are set only when on is true.
+func ReadOnlyAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("readonly", ""))
+}
+
+// ReferrerPolicyAttr defines attributes of type "referrerpolicy" for the a, area, iframe, img, link, script elements.
+func ReferrerPolicyAttr(val string) trees.Property {
+	return trees.NewAttr("referrerpolicy", val)
+}
+
+// RequiredAttr defines boolean attributes of type "required" for the input, select, textarea elements, which are set only when on is true.
+func RequiredAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("required", ""))
+}
+
+// ReversedAttr defines boolean attributes of type "reversed" for the ol elements, which are set only when on is true.
+func ReversedAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("reversed", ""))
+}
+
+// RoleAttr defines attributes of type "role" for html element types.
+func RoleAttr(val string) trees.Property {
+	return trees.NewAttr("role", val)
+}
+
+// RowsAttr defines attributes of type "rows" for the textarea elements.
+func RowsAttr(val string) trees.Property {
+	return trees.NewAttr("rows", val)
+}
+
+// RowSpanAttr defines attributes of type "rowspan" for the td, th elements.
+func RowSpanAttr(val string) trees.Property {
+	return trees.NewAttr("rowspan", val)
+}
+
+// SandboxAttr defines attributes of type "sandbox" for the iframe elements.
+func SandboxAttr(val string) trees.Property {
+	return trees.NewAttr("sandbox", val)
+}
+
+// ScopeAttr defines attributes of type "scope" for the th elements.
+func ScopeAttr(val string) trees.Property {
+	return trees.NewAttr("scope", val)
+}
+
+// SelectedAttr defines boolean attributes of type "selected" for the option elements, which are set only when on is true.
+func SelectedAttr(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr("selected", ""))
+}
+
+// ShapeAttr defines attributes of type "shape" for the area elements.
+func ShapeAttr(val string) trees.Property {
+	return trees.NewAttr("shape", val)
+}
+
+// SizeAttr defines attributes of type "size" for the input, select elements.
+func SizeAttr(val string) trees.Property {
+	return trees.NewAttr("size", val)
+}
+
+// SizesAttr defines attributes of type "sizes" for the img, link, source elements.
+func SizesAttr(val string) trees.Property {
+	return trees.NewAttr("sizes", val)
+}
+
+// SlotAttr defines attributes of type "slot" for html element types.
+func SlotAttr(val string) trees.Property {
+	return trees.NewAttr("slot", val)
+}
+
+// SpanAttr defines attributes of type "span" for the col, colgroup elements.
+func SpanAttr(val string) trees.Property {
+	return trees.NewAttr("span", val)
+}
+
+// SpellCheckAttr defines attributes of type "spellcheck" for html element types.
+func SpellCheckAttr(val string) trees.Property {
+	return trees.NewAttr("spellcheck", val)
+}
+
+// SrcDocAttr defines attributes of type "srcdoc" for the iframe elements.
+func SrcDocAttr(val string) trees.Property {
+	return trees.NewAttr("srcdoc", val)
+}
+
+// SrcLangAttr defines attributes of type "srclang" for the track elements.
+func SrcLangAttr(val string) trees.Property {
+	return trees.NewAttr("srclang", val)
+}
+
+// SrcSetAttr defines attributes of type "srcset" for the img, source elements.
+func SrcSetAttr(val string) trees.Property {
+	return trees.NewAttr("srcset", val)
+}
+
+// StartAttr defines attributes of type "start" for the ol elements.
+func StartAttr(val string) trees.Property {
+	return trees.NewAttr("start", val)
+}
+
+// StepAttr defines attributes of type "step" for the input elements.
+func StepAttr(val string) trees.Property {
+	return trees.NewAttr("step", val)
+}
+
+// TabIndexAttr defines attributes of type "tabindex" for html element types.
+func TabIndexAttr(val string) trees.Property {
+	return trees.NewAttr("tabindex", val)
+}
+
+// TargetAttr defines attributes of type "target" for the a, area, base, form elements.
+func TargetAttr(val string) trees.Property {
+	return trees.NewAttr("target", val)
+}
+
+// TitleAttr defines attributes of type "title" for html element types.
+func TitleAttr(val string) trees.Property {
+	return trees.NewAttr("title", val)
+}
+
+// TranslateAttr defines attributes of type "translate" for html element types.
+func TranslateAttr(val string) trees.Property {
+	return trees.NewAttr("translate", val)
+}
+
+// UseMapAttr defines attributes of type "usemap" for the img elements.
+func UseMapAttr(val string) trees.Property {
+	return trees.NewAttr("usemap", val)
+}
+
+// WidthAttr defines attributes of type "width" for the canvas, embed, iframe, img, input, object, source, video elements.
+func WidthAttr(val string) trees.Property {
+	return trees.NewAttr("width", val)
+}
+
+// WrapAttr defines attributes of type "wrap" for the textarea elements.
+func WrapAttr(val string) trees.Property {
+	return trees.NewAttr("wrap", val)
+}
+
+// AriaActiveDescendantAttr defines attributes of type "aria-activedescendant" for html element types.
+func AriaActiveDescendantAttr(val string) trees.Property {
+	return trees.NewAttr("aria-activedescendant", val)
+}
+
+// AriaAtomicAttr defines attributes of type "aria-atomic" for html element types.
+func AriaAtomicAttr(val string) trees.Property {
+	return trees.NewAttr("aria-atomic", val)
+}
+
+// AriaAutoCompleteAttr defines attributes of type "aria-autocomplete" for html element types.
+func AriaAutoCompleteAttr(val string) trees.Property {
+	return trees.NewAttr("aria-autocomplete", val)
+}
+
+// AriaBrailleLabelAttr defines attributes of type "aria-braillelabel" for html element types.
+func AriaBrailleLabelAttr(val string) trees.Property {
+	return trees.NewAttr("aria-braillelabel", val)
+}
+
+// AriaBrailleRoleDescriptionAttr defines attributes of type "aria-brailleroledescription" for html element types.
+func AriaBrailleRoleDescriptionAttr(val string) trees.Property {
+	return trees.NewAttr("aria-brailleroledescription", val)
+}
+
+// AriaBusyAttr defines attributes of type "aria-busy" for html element types.
+func AriaBusyAttr(val string) trees.Property {
+	return trees.NewAttr("aria-busy", val)
+}
+
+// AriaCheckedAttr defines attributes of type "aria-checked" for html element types.
+func AriaCheckedAttr(val string) trees.Property {
+	return trees.NewAttr("aria-checked", val)
+}
+
+// AriaColCountAttr defines attributes of type "aria-colcount" for html element types.
+func AriaColCountAttr(val string) trees.Property {
+	return trees.NewAttr("aria-colcount", val)
+}
+
+// AriaColIndexAttr defines attributes of type "aria-colindex" for html element types.
+func AriaColIndexAttr(val string) trees.Property {
+	return trees.NewAttr("aria-colindex", val)
+}
+
+// AriaColIndexTextAttr defines attributes of type "aria-colindextext" for html element types.
+func AriaColIndexTextAttr(val string) trees.Property {
+	return trees.NewAttr("aria-colindextext", val)
+}
+
+// AriaColSpanAttr defines attributes of type "aria-colspan" for html element types.
+func AriaColSpanAttr(val string) trees.Property {
+	return trees.NewAttr("aria-colspan", val)
+}
+
+// AriaControlsAttr defines attributes of type "aria-controls" for html element types.
+func AriaControlsAttr(val string) trees.Property {
+	return trees.NewAttr("aria-controls", val)
+}
+
+// AriaCurrentAttr defines attributes of type "aria-current" for html element types.
+func AriaCurrentAttr(val string) trees.Property {
+	return trees.NewAttr("aria-current", val)
+}
+
+// AriaDescribedByAttr defines attributes of type "aria-describedby" for html element types.
+func AriaDescribedByAttr(val string) trees.Property {
+	return trees.NewAttr("aria-describedby", val)
+}
+
+// AriaDescriptionAttr defines attributes of type "aria-description" for html element types.
+func AriaDescriptionAttr(val string) trees.Property {
+	return trees.NewAttr("aria-description", val)
+}
+
+// AriaDetailsAttr defines attributes of type "aria-details" for html element types.
+func AriaDetailsAttr(val string) trees.Property {
+	return trees.NewAttr("aria-details", val)
+}
+
+// AriaDisabledAttr defines attributes of type "aria-disabled" for html element types.
+func AriaDisabledAttr(val string) trees.Property {
+	return trees.NewAttr("aria-disabled", val)
+}
+
+// AriaErrorMessageAttr defines attributes of type "aria-errormessage" for html element types.
+func AriaErrorMessageAttr(val string) trees.Property {
+	return trees.NewAttr("aria-errormessage", val)
+}
+
+// AriaExpandedAttr defines attributes of type "aria-expanded" for html element types.
+func AriaExpandedAttr(val string) trees.Property {
+	return trees.NewAttr("aria-expanded", val)
+}
+
+// AriaFlowToAttr defines attributes of type "aria-flowto" for html element types.
+func AriaFlowToAttr(val string) trees.Property {
+	return trees.NewAttr("aria-flowto", val)
+}
+
+// AriaHasPopupAttr defines attributes of type "aria-haspopup" for html element types.
+func AriaHasPopupAttr(val string) trees.Property {
+	return trees.NewAttr("aria-haspopup", val)
+}
+
+// AriaHiddenAttr defines attributes of type "aria-hidden" for html element types.
+func AriaHiddenAttr(val string) trees.Property {
+	return trees.NewAttr("aria-hidden", val)
+}
+
+// AriaInvalidAttr defines attributes of type "aria-invalid" for html element types.
+func AriaInvalidAttr(val string) trees.Property {
+	return trees.NewAttr("aria-invalid", val)
+}
+
+// AriaKeyShortcutsAttr defines attributes of type "aria-keyshortcuts" for html element types.
+func AriaKeyShortcutsAttr(val string) trees.Property {
+	return trees.NewAttr("aria-keyshortcuts", val)
+}
+
+// AriaLabelAttr defines attributes of type "aria-label" for html element types.
+func AriaLabelAttr(val string) trees.Property {
+	return trees.NewAttr("aria-label", val)
+}
+
+// AriaLabelledByAttr defines attributes of type "aria-labelledby" for html element types.
+func AriaLabelledByAttr(val string) trees.Property {
+	return trees.NewAttr("aria-labelledby", val)
+}
+
+// AriaLevelAttr defines attributes of type "aria-level" for html element types.
+func AriaLevelAttr(val string) trees.Property {
+	return trees.NewAttr("aria-level", val)
+}
+
+// AriaLiveAttr defines attributes of type "aria-live" for html element types.
+func AriaLiveAttr(val string) trees.Property {
+	return trees.NewAttr("aria-live", val)
+}
+
+// AriaModalAttr defines attributes of type "aria-modal" for html element types.
+func AriaModalAttr(val string) trees.Property {
+	return trees.NewAttr("aria-modal", val)
+}
+
+// AriaMultiLineAttr defines attributes of type "aria-multiline" for html element types.
+func AriaMultiLineAttr(val string) trees.Property {
+	return trees.NewAttr("aria-multiline", val)
+}
+
+// AriaMultiSelectableAttr defines attributes of type "aria-multiselectable" for html element types.
+func AriaMultiSelectableAttr(val string) trees.Property {
+	return trees.NewAttr("aria-multiselectable", val)
+}
+
+// AriaOrientationAttr defines attributes of type "aria-orientation" for html element types.
+func AriaOrientationAttr(val string) trees.Property {
+	return trees.NewAttr("aria-orientation", val)
+}
+
+// AriaOwnsAttr defines attributes of type "aria-owns" for html element types.
+func AriaOwnsAttr(val string) trees.Property {
+	return trees.NewAttr("aria-owns", val)
+}
+
+// AriaPlaceholderAttr defines attributes of type "aria-placeholder" for html element types.
+func AriaPlaceholderAttr(val string) trees.Property {
+	return trees.NewAttr("aria-placeholder", val)
+}
+
+// AriaPosInSetAttr defines attributes of type "aria-posinset" for html element types.
+func AriaPosInSetAttr(val string) trees.Property {
+	return trees.NewAttr("aria-posinset", val)
+}
+
+// AriaPressedAttr defines attributes of type "aria-pressed" for html element types.
+func AriaPressedAttr(val string) trees.Property {
+	return trees.NewAttr("aria-pressed", val)
+}
+
+// AriaReadOnlyAttr defines attributes of type "aria-readonly" for html element types.
+func AriaReadOnlyAttr(val string) trees.Property {
+	return trees.NewAttr("aria-readonly", val)
+}
+
+// AriaRelevantAttr defines attributes of type "aria-relevant" for html element types.
+func AriaRelevantAttr(val string) trees.Property {
+	return trees.NewAttr("aria-relevant", val)
+}
+
+// AriaRequiredAttr defines attributes of type "aria-required" for html element types.
+func AriaRequiredAttr(val string) trees.Property {
+	return trees.NewAttr("aria-required", val)
+}
+
+// AriaRoleDescriptionAttr defines attributes of type "aria-roledescription" for html element types.
+func AriaRoleDescriptionAttr(val string) trees.Property {
+	return trees.NewAttr("aria-roledescription", val)
+}
+
+// AriaRowCountAttr defines attributes of type "aria-rowcount" for html element types.
+func AriaRowCountAttr(val string) trees.Property {
+	return trees.NewAttr("aria-rowcount", val)
+}
+
+// AriaRowIndexAttr defines attributes of type "aria-rowindex" for html element types.
+func AriaRowIndexAttr(val string) trees.Property {
+	return trees.NewAttr("aria-rowindex", val)
+}
+
+// AriaRowIndexTextAttr defines attributes of type "aria-rowindextext" for html element types.
+func AriaRowIndexTextAttr(val string) trees.Property {
+	return trees.NewAttr("aria-rowindextext", val)
+}
+
+// AriaRowSpanAttr defines attributes of type "aria-rowspan" for html element types.
+func AriaRowSpanAttr(val string) trees.Property {
+	return trees.NewAttr("aria-rowspan", val)
+}
+
+// AriaSelectedAttr defines attributes of type "aria-selected" for html element types.
+func AriaSelectedAttr(val string) trees.Property {
+	return trees.NewAttr("aria-selected", val)
+}
+
+// AriaSetSizeAttr defines attributes of type "aria-setsize" for html element types.
+func AriaSetSizeAttr(val string) trees.Property {
+	return trees.NewAttr("aria-setsize", val)
+}
+
+// AriaSortAttr defines attributes of type "aria-sort" for html element types.
+func AriaSortAttr(val string) trees.Property {
+	return trees.NewAttr("aria-sort", val)
+}
+
+// AriaValueMaxAttr defines attributes of type "aria-valuemax" for html element types.
+func AriaValueMaxAttr(val string) trees.Property {
+	return trees.NewAttr("aria-valuemax", val)
+}
+
+// AriaValueMinAttr defines attributes of type "aria-valuemin" for html element types.
+func AriaValueMinAttr(val string) trees.Property {
+	return trees.NewAttr("aria-valuemin", val)
+}
+
+// AriaValueNowAttr defines attributes of type "aria-valuenow" for html element types.
+func AriaValueNowAttr(val string) trees.Property {
+	return trees.NewAttr("aria-valuenow", val)
+}
+
+// AriaValueTextAttr defines attributes of type "aria-valuetext" for html element types.
+func AriaValueTextAttr(val string) trees.Property {
+	return trees.NewAttr("aria-valuetext", val)
+}
+
+// AttrFuncs maps the html attributes to the names of the functions setting
+// them, for use by tools writing code with the package such as gu html2go.
+var AttrFuncs = map[string]string{
+	"abbr":                        "AbbrAttr",
+	"accept":                      "AcceptAttr",
+	"accept-charset":              "AcceptCharsetAttr",
+	"accesskey":                   "AccessKeyAttr",
+	"action":                      "ActionAttr",
+	"allow":                       "AllowAttr",
+	"allowfullscreen":             "AllowFullscreenAttr",
+	"alt":                         "AltAttr",
+	"as":                          "AsAttr",
+	"async":                       "AsyncAttr",
+	"autocapitalize":              "AutoCapitalizeAttr",
+	"autocomplete":                "AutoCompleteAttr",
+	"autofocus":                   "AutofocusBoolAttr",
+	"autoplay":                    "AutoPlayAttr",
+	"blocking":                    "BlockingAttr",
+	"charset":                     "CharsetAttr",
+	"checked":                     "CheckedBoolAttr",
+	"cite":                        "CiteAttr",
+	"class":                       "ClassAttr",
+	"cols":                        "ColsAttr",
+	"colspan":                     "ColSpanAttr",
+	"content":                     "ContentAttr",
+	"contenteditable":             "ContentEditableAttr",
+	"controls":                    "ControlsAttr",
+	"coords":                      "CoordsAttr",
+	"crossorigin":                 "CrossOriginAttr",
+	"data":                        "ObjectDataAttr",
+	"datetime":                    "DateTimeAttr",
+	"decoding":                    "DecodingAttr",
+	"default":                     "DefaultAttr",
+	"defer":                       "DeferAttr",
+	"dir":                         "DirAttr",
+	"dirname":                     "DirNameAttr",
+	"disabled":                    "DisabledAttr",
+	"download":                    "DownloadAttr",
+	"draggable":                   "DraggableAttr",
+	"enctype":                     "EncTypeAttr",
+	"enterkeyhint":                "EnterKeyHintAttr",
+	"fetchpriority":               "FetchPriorityAttr",
+	"for":                         "ForAttr",
+	"form":                        "FormAttr",
+	"formaction":                  "FormActionAttr",
+	"formenctype":                 "FormEncTypeAttr",
+	"formmethod":                  "FormMethodAttr",
+	"formnovalidate":              "FormNoValidateAttr",
+	"formtarget":                  "FormTargetAttr",
+	"headers":                     "HeadersAttr",
+	"height":                      "HeightAttr",
+	"hidden":                      "HiddenAttr",
+	"high":                        "HighAttr",
+	"href":                        "HrefAttr",
+	"hreflang":                    "HrefLangAttr",
+	"http-equiv":                  "HTTPEquivAttr",
+	"id":                          "IDAttr",
+	"imagesizes":                  "ImageSizesAttr",
+	"imagesrcset":                 "ImageSrcSetAttr",
+	"inert":                       "InertAttr",
+	"inputmode":                   "InputModeAttr",
+	"integrity":                   "IntegrityAttr",
+	"is":                          "IsAttr",
+	"ismap":                       "IsMapAttr",
+	"itemid":                      "ItemIDAttr",
+	"itemprop":                    "ItemPropAttr",
+	"itemref":                     "ItemRefAttr",
+	"itemscope":                   "ItemScopeAttr",
+	"itemtype":                    "ItemTypeAttr",
+	"kind":                        "KindAttr",
+	"label":                       "LabelAttr",
+	"lang":                        "LangAttr",
+	"list":                        "ListAttr",
+	"loading":                     "LoadingAttr",
+	"loop":                        "LoopAttr",
+	"low":                         "LowAttr",
+	"max":                         "MaxAttr",
+	"maxlength":                   "MaxLengthAttr",
+	"media":                       "MediaAttr",
+	"method":                      "MethodAttr",
+	"min":                         "MinAttr",
+	"minlength":                   "MinLengthAttr",
+	"multiple":                    "MultipleAttr",
+	"muted":                       "MutedAttr",
+	"name":                        "NameAttr",
+	"nomodule":                    "NoModuleAttr",
+	"nonce":                       "NonceAttr",
+	"novalidate":                  "NoValidateAttr",
+	"open":                        "OpenAttr",
+	"optimum":                     "OptimumAttr",
+	"pattern":                     "PatternAttr",
+	"ping":                        "PingAttr",
+	"placeholder":                 "PlaceholderAttr",
+	"playsinline":                 "PlaysInlineAttr",
+	"popover":                     "PopoverAttr",
+	"popovertarget":               "PopoverTargetAttr",
+	"popovertargetaction":         "PopoverTargetActionAttr",
+	"poster":                      "PosterAttr",
+	"preload":                     "PreloadAttr",
+	"readonly":                    "ReadOnlyAttr",
+	"referrerpolicy":              "ReferrerPolicyAttr",
+	"rel":                         "RelAttr",
+	"required":                    "RequiredAttr",
+	"reversed":                    "ReversedAttr",
+	"role":                        "RoleAttr",
+	"rows":                        "RowsAttr",
+	"rowspan":                     "RowSpanAttr",
+	"sandbox":                     "SandboxAttr",
+	"scope":                       "ScopeAttr",
+	"selected":                    "SelectedAttr",
+	"shape":                       "ShapeAttr",
+	"size":                        "SizeAttr",
+	"sizes":                       "SizesAttr",
+	"slot":                        "SlotAttr",
+	"span":                        "SpanAttr",
+	"spellcheck":                  "SpellCheckAttr",
+	"src":                         "SrcAttr",
+	"srcdoc":                      "SrcDocAttr",
+	"srclang":                     "SrcLangAttr",
+	"srcset":                      "SrcSetAttr",
+	"start":                       "StartAttr",
+	"step":                        "StepAttr",
+	"tabindex":                    "TabIndexAttr",
+	"target":                      "TargetAttr",
+	"title":                       "TitleAttr",
+	"translate":                   "TranslateAttr",
+	"type":                        "TypeAttr",
+	"usemap":                      "UseMapAttr",
+	"value":                       "ValueAttr",
+	"width":                       "WidthAttr",
+	"wrap":                        "WrapAttr",
+	"aria-activedescendant":       "AriaActiveDescendantAttr",
+	"aria-atomic":                 "AriaAtomicAttr",
+	"aria-autocomplete":           "AriaAutoCompleteAttr",
+	"aria-braillelabel":           "AriaBrailleLabelAttr",
+	"aria-brailleroledescription": "AriaBrailleRoleDescriptionAttr",
+	"aria-busy":                   "AriaBusyAttr",
+	"aria-checked":                "AriaCheckedAttr",
+	"aria-colcount":               "AriaColCountAttr",
+	"aria-colindex":               "AriaColIndexAttr",
+	"aria-colindextext":           "AriaColIndexTextAttr",
+	"aria-colspan":                "AriaColSpanAttr",
+	"aria-controls":               "AriaControlsAttr",
+	"aria-current":                "AriaCurrentAttr",
+	"aria-describedby":            "AriaDescribedByAttr",
+	"aria-description":            "AriaDescriptionAttr",
+	"aria-details":                "AriaDetailsAttr",
+	"aria-disabled":               "AriaDisabledAttr",
+	"aria-errormessage":           "AriaErrorMessageAttr",
+	"aria-expanded":               "AriaExpandedAttr",
+	"aria-flowto":                 "AriaFlowToAttr",
+	"aria-haspopup":               "AriaHasPopupAttr",
+	"aria-hidden":                 "AriaHiddenAttr",
+	"aria-invalid":                "AriaInvalidAttr",
+	"aria-keyshortcuts":           "AriaKeyShortcutsAttr",
+	"aria-label":                  "AriaLabelAttr",
+	"aria-labelledby":             "AriaLabelledByAttr",
+	"aria-level":                  "AriaLevelAttr",
+	"aria-live":                   "AriaLiveAttr",
+	"aria-modal":                  "AriaModalAttr",
+	"aria-multiline":              "AriaMultiLineAttr",
+	"aria-multiselectable":        "AriaMultiSelectableAttr",
+	"aria-orientation":            "AriaOrientationAttr",
+	"aria-owns":                   "AriaOwnsAttr",
+	"aria-placeholder":            "AriaPlaceholderAttr",
+	"aria-posinset":               "AriaPosInSetAttr",
+	"aria-pressed":                "AriaPressedAttr",
+	"aria-readonly":               "AriaReadOnlyAttr",
+	"aria-relevant":               "AriaRelevantAttr",
+	"aria-required":               "AriaRequiredAttr",
+	"aria-roledescription":        "AriaRoleDescriptionAttr",
+	"aria-rowcount":               "AriaRowCountAttr",
+	"aria-rowindex":               "AriaRowIndexAttr",
+	"aria-rowindextext":           "AriaRowIndexTextAttr",
+	"aria-rowspan":                "AriaRowSpanAttr",
+	"aria-selected":               "AriaSelectedAttr",
+	"aria-setsize":                "AriaSetSizeAttr",
+	"aria-sort":                   "AriaSortAttr",
+	"aria-valuemax":               "AriaValueMaxAttr",
+	"aria-valuemin":               "AriaValueMinAttr",
+	"aria-valuenow":               "AriaValueNowAttr",
+	"aria-valuetext":              "AriaValueTextAttr",
+}
+
+// BooleanAttrs contains the boolean attributes, whose functions take a bool.
+var BooleanAttrs = map[string]bool{
+	"allowfullscreen": true,
+	"async":           true,
+	"autofocus":       true,
+	"autoplay":        true,
+	"checked":         true,
+	"controls":        true,
+	"default":         true,
+	"defer":           true,
+	"disabled":        true,
+	"formnovalidate":  true,
+	"hidden":          true,
+	"inert":           true,
+	"ismap":           true,
+	"itemscope":       true,
+	"loop":            true,
+	"multiple":        true,
+	"muted":           true,
+	"nomodule":        true,
+	"novalidate":      true,
+	"open":            true,
+	"playsinline":     true,
+	"readonly":        true,
+	"required":        true,
+	"reversed":        true,
+	"selected":        true,
+}
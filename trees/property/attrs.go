@@ -8,6 +8,9 @@ func NameAttr(val string) trees.Property {
 }
 
 // CheckedAttr defines attributes of type "Checked" for html element types.
+//
+// Deprecated: Use CheckedBoolAttr, as the attribute is set by it's presence
+// regardless of it's value.
 func CheckedAttr(val string) trees.Property {
 	return &trees.Attribute{Name: "checked", Value: val}
 }
@@ -23,6 +26,9 @@ func ClassNameAttr(val string) trees.Property {
 }
 
 // AutofocusAttr defines attributes of type "Autofocus" for html element types.
+//
+// Deprecated: Use AutofocusBoolAttr, as the attribute is set by it's presence
+// regardless of it's value.
 func AutofocusAttr(val string) trees.Property {
 	return &trees.Attribute{Name: "autofocus", Value: val}
 }
@@ -69,3 +75,16 @@ func PlaceholderAttr(val string) trees.Property {
 func ValueAttr(val string) trees.Property {
 	return &trees.Attribute{Name: "value", Value: val}
 }
+
+// DataAttr defines custom data attributes, where the name is given without
+// it's "data-" prefix, such as DataAttr("user-id", "12") for "data-user-id".
+func DataAttr(name string, val string) trees.Property {
+	return trees.NewAttr("data-"+name, val)
+}
+
+// AriaAttr defines aria attributes, where the name is given without it's
+// "aria-" prefix. The generated functions such as AriaLabelAttr cover the
+// aria attributes of WAI-ARIA.
+func AriaAttr(name string, val string) trees.Property {
+	return trees.NewAttr("aria-"+name, val)
+}
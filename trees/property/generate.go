@@ -0,0 +1,453 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"strings"
+
+	"github.com/gu-io/gu/trees/css"
+)
+
+// attribute defines a html attribute along with the elements using it, where
+// global attributes have no elements and boolean attributes are set by their
+// presence.
+type attribute struct {
+	name     string
+	elements string
+	boolean  bool
+}
+
+// attributes contains the html attributes, as listed within the "Attributes"
+// index of the HTML Living Standard (https://html.spec.whatwg.org/multipage/indices.html#attributes-3),
+// without event handler attributes and the style attribute.
+var attributes = []attribute{
+	{name: "abbr", elements: "th"},
+	{name: "accept", elements: "input"},
+	{name: "accept-charset", elements: "form"},
+	{name: "accesskey"},
+	{name: "action", elements: "form"},
+	{name: "allow", elements: "iframe"},
+	{name: "allowfullscreen", elements: "iframe", boolean: true},
+	{name: "alt", elements: "area, img, input"},
+	{name: "as", elements: "link"},
+	{name: "async", elements: "script", boolean: true},
+	{name: "autocapitalize"},
+	{name: "autocomplete", elements: "form, input, select, textarea"},
+	{name: "autofocus", boolean: true},
+	{name: "autoplay", elements: "audio, video", boolean: true},
+	{name: "blocking", elements: "link, script, style"},
+	{name: "charset", elements: "meta"},
+	{name: "checked", elements: "input", boolean: true},
+	{name: "cite", elements: "blockquote, del, ins, q"},
+	{name: "class"},
+	{name: "cols", elements: "textarea"},
+	{name: "colspan", elements: "td, th"},
+	{name: "content", elements: "meta"},
+	{name: "contenteditable"},
+	{name: "controls", elements: "audio, video", boolean: true},
+	{name: "coords", elements: "area"},
+	{name: "crossorigin", elements: "audio, img, link, script, video"},
+	{name: "data", elements: "object"},
+	{name: "datetime", elements: "del, ins, time"},
+	{name: "decoding", elements: "img"},
+	{name: "default", elements: "track", boolean: true},
+	{name: "defer", elements: "script", boolean: true},
+	{name: "dir"},
+	{name: "dirname", elements: "input, textarea"},
+	{name: "disabled", elements: "button, fieldset, input, link, optgroup, option, select, textarea", boolean: true},
+	{name: "download", elements: "a, area"},
+	{name: "draggable"},
+	{name: "enctype", elements: "form"},
+	{name: "enterkeyhint"},
+	{name: "fetchpriority", elements: "img, link, script"},
+	{name: "for", elements: "label, output"},
+	{name: "form", elements: "button, fieldset, input, object, output, select, textarea"},
+	{name: "formaction", elements: "button, input"},
+	{name: "formenctype", elements: "button, input"},
+	{name: "formmethod", elements: "button, input"},
+	{name: "formnovalidate", elements: "button, input", boolean: true},
+	{name: "formtarget", elements: "button, input"},
+	{name: "headers", elements: "td, th"},
+	{name: "height", elements: "canvas, embed, iframe, img, input, object, source, video"},
+	{name: "hidden", boolean: true},
+	{name: "high", elements: "meter"},
+	{name: "href", elements: "a, area, base, link"},
+	{name: "hreflang", elements: "a, link"},
+	{name: "http-equiv", elements: "meta"},
+	{name: "id"},
+	{name: "imagesizes", elements: "link"},
+	{name: "imagesrcset", elements: "link"},
+	{name: "inert", boolean: true},
+	{name: "inputmode"},
+	{name: "integrity", elements: "link, script"},
+	{name: "is"},
+	{name: "ismap", elements: "img", boolean: true},
+	{name: "itemid"},
+	{name: "itemprop"},
+	{name: "itemref"},
+	{name: "itemscope", boolean: true},
+	{name: "itemtype"},
+	{name: "kind", elements: "track"},
+	{name: "label", elements: "optgroup, option, track"},
+	{name: "lang"},
+	{name: "list", elements: "input"},
+	{name: "loading", elements: "iframe, img"},
+	{name: "loop", elements: "audio, video", boolean: true},
+	{name: "low", elements: "meter"},
+	{name: "max", elements: "input, meter, progress"},
+	{name: "maxlength", elements: "input, textarea"},
+	{name: "media", elements: "link, meta, source, style"},
+	{name: "method", elements: "form"},
+	{name: "min", elements: "input, meter"},
+	{name: "minlength", elements: "input, textarea"},
+	{name: "multiple", elements: "input, select", boolean: true},
+	{name: "muted", elements: "audio, video", boolean: true},
+	{name: "name", elements: "button, fieldset, form, iframe, input, map, meta, object, output, select, slot, textarea"},
+	{name: "nomodule", elements: "script", boolean: true},
+	{name: "nonce"},
+	{name: "novalidate", elements: "form", boolean: true},
+	{name: "open", elements: "details, dialog", boolean: true},
+	{name: "optimum", elements: "meter"},
+	{name: "pattern", elements: "input"},
+	{name: "ping", elements: "a, area"},
+	{name: "placeholder", elements: "input, textarea"},
+	{name: "playsinline", elements: "video", boolean: true},
+	{name: "popover"},
+	{name: "popovertarget", elements: "button, input"},
+	{name: "popovertargetaction", elements: "button, input"},
+	{name: "poster", elements: "video"},
+	{name: "preload", elements: "audio, video"},
+	{name: "readonly", elements: "input, textarea", boolean: true},
+	{name: "referrerpolicy", elements: "a, area, iframe, img, link, script"},
+	{name: "rel", elements: "a, area, form, link"},
+	{name: "required", elements: "input, select, textarea", boolean: true},
+	{name: "reversed", elements: "ol", boolean: true},
+	{name: "role"},
+	{name: "rows", elements: "textarea"},
+	{name: "rowspan", elements: "td, th"},
+	{name: "sandbox", elements: "iframe"},
+	{name: "scope", elements: "th"},
+	{name: "selected", elements: "option", boolean: true},
+	{name: "shape", elements: "area"},
+	{name: "size", elements: "input, select"},
+	{name: "sizes", elements: "img, link, source"},
+	{name: "slot"},
+	{name: "span", elements: "col, colgroup"},
+	{name: "spellcheck"},
+	{name: "src", elements: "audio, embed, iframe, img, input, script, source, track, video"},
+	{name: "srcdoc", elements: "iframe"},
+	{name: "srclang", elements: "track"},
+	{name: "srcset", elements: "img, source"},
+	{name: "start", elements: "ol"},
+	{name: "step", elements: "input"},
+	{name: "tabindex"},
+	{name: "target", elements: "a, area, base, form"},
+	{name: "title"},
+	{name: "translate"},
+	{name: "type", elements: "a, button, embed, input, link, object, ol, script, source, style"},
+	{name: "usemap", elements: "img"},
+	{name: "value", elements: "button, data, input, li, meter, option, param, progress"},
+	{name: "width", elements: "canvas, embed, iframe, img, input, object, source, video"},
+	{name: "wrap", elements: "textarea"},
+}
+
+// aria contains the aria attributes, as listed within the "Supported States
+// and Properties" of WAI-ARIA 1.2 (https://www.w3.org/TR/wai-aria-1.2/#state_prop_def),
+// without their "aria-" prefix.
+var aria = []string{
+	"activedescendant", "atomic", "autocomplete", "braillelabel",
+	"brailleroledescription", "busy", "checked", "colcount", "colindex",
+	"colindextext", "colspan", "controls", "current", "describedby",
+	"description", "details", "disabled", "errormessage", "expanded", "flowto",
+	"haspopup", "hidden", "invalid", "keyshortcuts", "label", "labelledby",
+	"level", "live", "modal", "multiline", "multiselectable", "orientation",
+	"owns", "placeholder", "posinset", "pressed", "readonly", "relevant",
+	"required", "roledescription", "rowcount", "rowindex", "rowindextext",
+	"rowspan", "selected", "setsize", "sort", "valuemax", "valuemin",
+	"valuenow", "valuetext",
+}
+
+// names contains the names of the attributes made of several words, used in
+// place of their capitalized name for the name of their function. Every aria
+// attribute made of several words has a entry, keeping their names alike.
+var names = map[string]string{
+	"accept-charset":              "AcceptCharset",
+	"accesskey":                   "AccessKey",
+	"allowfullscreen":             "AllowFullscreen",
+	"aria-activedescendant":       "AriaActiveDescendant",
+	"aria-autocomplete":           "AriaAutoComplete",
+	"aria-braillelabel":           "AriaBrailleLabel",
+	"aria-brailleroledescription": "AriaBrailleRoleDescription",
+	"aria-colcount":               "AriaColCount",
+	"aria-colindex":               "AriaColIndex",
+	"aria-colindextext":           "AriaColIndexText",
+	"aria-colspan":                "AriaColSpan",
+	"aria-describedby":            "AriaDescribedBy",
+	"aria-errormessage":           "AriaErrorMessage",
+	"aria-flowto":                 "AriaFlowTo",
+	"aria-haspopup":               "AriaHasPopup",
+	"aria-keyshortcuts":           "AriaKeyShortcuts",
+	"aria-labelledby":             "AriaLabelledBy",
+	"aria-multiline":              "AriaMultiLine",
+	"aria-multiselectable":        "AriaMultiSelectable",
+	"aria-posinset":               "AriaPosInSet",
+	"aria-readonly":               "AriaReadOnly",
+	"aria-roledescription":        "AriaRoleDescription",
+	"aria-rowcount":               "AriaRowCount",
+	"aria-rowindex":               "AriaRowIndex",
+	"aria-rowindextext":           "AriaRowIndexText",
+	"aria-rowspan":                "AriaRowSpan",
+	"aria-setsize":                "AriaSetSize",
+	"aria-valuemax":               "AriaValueMax",
+	"aria-valuemin":               "AriaValueMin",
+	"aria-valuenow":               "AriaValueNow",
+	"aria-valuetext":              "AriaValueText",
+	"autocapitalize":              "AutoCapitalize",
+	"autocomplete":                "AutoComplete",
+	"autoplay":                    "AutoPlay",
+	"colspan":                     "ColSpan",
+	"contenteditable":             "ContentEditable",
+	"crossorigin":                 "CrossOrigin",
+	"data":                        "ObjectData",
+	"datetime":                    "DateTime",
+	"dirname":                     "DirName",
+	"enctype":                     "EncType",
+	"enterkeyhint":                "EnterKeyHint",
+	"fetchpriority":               "FetchPriority",
+	"formaction":                  "FormAction",
+	"formenctype":                 "FormEncType",
+	"formmethod":                  "FormMethod",
+	"formnovalidate":              "FormNoValidate",
+	"formtarget":                  "FormTarget",
+	"hreflang":                    "HrefLang",
+	"http-equiv":                  "HTTPEquiv",
+	"imagesizes":                  "ImageSizes",
+	"imagesrcset":                 "ImageSrcSet",
+	"inputmode":                   "InputMode",
+	"ismap":                       "IsMap",
+	"itemid":                      "ItemID",
+	"itemprop":                    "ItemProp",
+	"itemref":                     "ItemRef",
+	"itemscope":                   "ItemScope",
+	"itemtype":                    "ItemType",
+	"maxlength":                   "MaxLength",
+	"minlength":                   "MinLength",
+	"nomodule":                    "NoModule",
+	"novalidate":                  "NoValidate",
+	"playsinline":                 "PlaysInline",
+	"popovertarget":               "PopoverTarget",
+	"popovertargetaction":         "PopoverTargetAction",
+	"readonly":                    "ReadOnly",
+	"referrerpolicy":              "ReferrerPolicy",
+	"rowspan":                     "RowSpan",
+	"spellcheck":                  "SpellCheck",
+	"srcdoc":                      "SrcDoc",
+	"srclang":                     "SrcLang",
+	"srcset":                      "SrcSet",
+	"tabindex":                    "TabIndex",
+	"usemap":                      "UseMap",
+}
+
+// attrs contains the functions of the attributes within attrs.go, which are
+// kept as is for compatibility.
+var attrs = map[string]string{
+	"autofocus":   "AutofocusAttr",
+	"checked":     "CheckedAttr",
+	"class":       "ClassAttr",
+	"href":        "HrefAttr",
+	"id":          "IDAttr",
+	"name":        "NameAttr",
+	"placeholder": "PlaceholderAttr",
+	"rel":         "RelAttr",
+	"src":         "SrcAttr",
+	"type":        "TypeAttr",
+	"value":       "ValueAttr",
+}
+
+// typedAttrs contains the names of the functions generated for the boolean
+// attributes within attrs, whose functions in attrs.go take a string.
+var typedAttrs = map[string]string{
+	"autofocus": "AutofocusBoolAttr",
+	"checked":   "CheckedBoolAttr",
+}
+
+// styles contains the functions of the css properties within styles.go, which
+// are kept as is for compatibility.
+var styles = map[string]string{
+	"background": "BackgroundStyle",
+	"color":      "ColorStyle",
+	"display":    "DisplayStyle",
+	"height":     "HeightStyle",
+	"margin":     "MarginStyle",
+	"padding":    "PaddingStyle",
+	"width":      "WidthStyle",
+}
+
+func main() {
+	write("attrs.gen.go", attrsSource())
+	write("styles.gen.go", stylesSource())
+}
+
+// attrsSource returns the source of the attribute functions.
+func attrsSource() []byte {
+	var out bytes.Buffer
+
+	fmt.Fprint(&out, `// Code generated by generate.go; DO NOT EDIT.
+
+//go:generate go run generate.go
+
+// Attribute source: "HTML Living Standard" by WHATWG, https://html.spec.whatwg.org/multipage/indices.html#attributes-3,
+// and "Accessible Rich Internet Applications (WAI-ARIA) 1.2" by W3C, https://www.w3.org/TR/wai-aria-1.2/.
+
+package property
+
+import "github.com/gu-io/gu/trees"
+`)
+
+	var table, booleans bytes.Buffer
+
+	for _, attr := range attributes {
+		if attr.boolean {
+			fmt.Fprintf(&booleans, "\t%q: true,\n", attr.name)
+		}
+
+		name := funcName(attr.name) + "Attr"
+
+		if known, ok := attrs[attr.name]; ok {
+			typed, ok := typedAttrs[attr.name]
+			if !ok {
+				fmt.Fprintf(&table, "\t%q: %q,\n", attr.name, known)
+				continue
+			}
+
+			name = typed
+		}
+
+		fmt.Fprintf(&table, "\t%q: %q,\n", attr.name, name)
+
+		kind := "attributes"
+		if attr.boolean {
+			kind = "boolean attributes"
+		}
+
+		of := "for html element types"
+		if attr.elements != "" {
+			of = "for the " + attr.elements + " elements"
+		}
+
+		if attr.boolean {
+			fmt.Fprintf(&out, `
+// %s defines %s of type %q %s, which are set only when on is true.
+func %s(on bool) trees.Property {
+	return trees.If(on, trees.NewAttr(%q, ""))
+}
+`, name, kind, attr.name, of, name, attr.name)
+			continue
+		}
+
+		fmt.Fprintf(&out, `
+// %s defines %s of type %q %s.
+func %s(val string) trees.Property {
+	return trees.NewAttr(%q, val)
+}
+`, name, kind, attr.name, of, name, attr.name)
+	}
+
+	for _, attr := range aria {
+		name := funcName("aria-"+attr) + "Attr"
+		fmt.Fprintf(&table, "\t\"aria-%s\": %q,\n", attr, name)
+
+		fmt.Fprintf(&out, `
+// %s defines attributes of type "aria-%s" for html element types.
+func %s(val string) trees.Property {
+	return trees.NewAttr("aria-%s", val)
+}
+`, name, attr, name, attr)
+	}
+
+	fmt.Fprintf(&out, `
+// AttrFuncs maps the html attributes to the names of the functions setting
+// them, for use by tools writing code with the package such as gu html2go.
+var AttrFuncs = map[string]string{
+%s}
+
+// BooleanAttrs contains the boolean attributes, whose functions take a bool.
+var BooleanAttrs = map[string]bool{
+%s}
+`, table.String(), booleans.String())
+
+	return out.Bytes()
+}
+
+// stylesSource returns the source of the style functions.
+func stylesSource() []byte {
+	var out bytes.Buffer
+
+	fmt.Fprint(&out, `// Code generated by generate.go; DO NOT EDIT.
+
+// Property source: "All CSS properties" by W3C, https://www.w3.org/Style/CSS/all-properties.en.html.
+
+package property
+
+import "github.com/gu-io/gu/trees"
+`)
+
+	var table bytes.Buffer
+
+	for _, property := range css.Properties {
+		if name, ok := styles[property]; ok {
+			fmt.Fprintf(&table, "\t%q: %q,\n", property, name)
+			continue
+		}
+
+		name := funcName(property) + "Style"
+		fmt.Fprintf(&table, "\t%q: %q,\n", property, name)
+
+		fmt.Fprintf(&out, `
+// %s provides the style setter that sets the css %q value.
+func %s(value string) trees.Property {
+	return trees.NewCSSStyle(%q, value)
+}
+`, name, property, name, property)
+	}
+
+	fmt.Fprintf(&out, `
+// StyleFuncs maps the css properties to the names of the functions setting
+// them, for use by tools writing code with the package such as gu html2go.
+var StyleFuncs = map[string]string{
+%s}
+`, table.String())
+
+	return out.Bytes()
+}
+
+// write writes the formatted source into the file.
+func write(file string, source []byte) {
+	source, err := format.Source(source)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile(file, source, 0644); err != nil {
+		panic(err)
+	}
+}
+
+// funcName returns the name of the function of the attribute or property.
+func funcName(name string) string {
+	if known, ok := names[name]; ok {
+		return known
+	}
+
+	parts := strings.Split(name, "-")
+	for index, part := range parts {
+		parts[index] = strings.ToUpper(part[:1]) + part[1:]
+	}
+
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,54 @@
+package property_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/tests"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/property"
+)
+
+func TestAttributes(t *testing.T) {
+	input := elems.Input(
+		property.TypeAttr("text"),
+		property.RequiredAttr(true),
+		property.DisabledAttr(false),
+		property.CheckedBoolAttr(true),
+		property.AutofocusBoolAttr(false),
+		property.MaxLengthAttr("20"),
+		property.DataAttr("user-id", "12"),
+		property.AriaLabelAttr("Name"),
+	)
+
+	html := input.HTML()
+
+	for _, attr := range []string{`required=""`, `checked=""`, `maxlength="20"`, `data-user-id="12"`, `aria-label="Name"`} {
+		if !strings.Contains(html, attr) {
+			tests.Failed(t, "Should have written the %s attribute: %s", attr, html)
+		}
+	}
+	tests.Passed(t, "Should have written the attributes")
+
+	if strings.Contains(html, "disabled") || strings.Contains(html, "autofocus") {
+		tests.Failed(t, "Should have left out unset boolean attributes: %s", html)
+	}
+	tests.Passed(t, "Should have left out unset boolean attributes")
+}
+
+func TestStyles(t *testing.T) {
+	div := elems.Div(
+		property.FontSizeStyle("12px"),
+		property.BorderTopLeftRadiusStyle("4px"),
+		property.ColorStyle("red"),
+	)
+
+	html := div.HTML()
+
+	for _, style := range []string{"font-size:12px;", "border-top-left-radius:4px;", "color:red;"} {
+		if !strings.Contains(html, style) {
+			tests.Failed(t, "Should have written the %q style: %s", style, html)
+		}
+	}
+	tests.Passed(t, "Should have written the styles")
+}
@@ -0,0 +1,2437 @@
+// Code generated by generate.go; DO NOT EDIT.
+
+// Property source: "All CSS properties" by W3C, https://www.w3.org/Style/CSS/all-properties.en.html.
+
+package property
+
+import "github.com/gu-io/gu/trees"
+
+// AccentColorStyle provides the style setter that sets the css "accent-color" value.
+func AccentColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("accent-color", value)
+}
+
+// AlignContentStyle provides the style setter that sets the css "align-content" value.
+func AlignContentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("align-content", value)
+}
+
+// AlignItemsStyle provides the style setter that sets the css "align-items" value.
+func AlignItemsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("align-items", value)
+}
+
+// AlignSelfStyle provides the style setter that sets the css "align-self" value.
+func AlignSelfStyle(value string) trees.Property {
+	return trees.NewCSSStyle("align-self", value)
+}
+
+// AlignmentBaselineStyle provides the style setter that sets the css "alignment-baseline" value.
+func AlignmentBaselineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("alignment-baseline", value)
+}
+
+// AllStyle provides the style setter that sets the css "all" value.
+func AllStyle(value string) trees.Property {
+	return trees.NewCSSStyle("all", value)
+}
+
+// AnimationStyle provides the style setter that sets the css "animation" value.
+func AnimationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation", value)
+}
+
+// AnimationDelayStyle provides the style setter that sets the css "animation-delay" value.
+func AnimationDelayStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-delay", value)
+}
+
+// AnimationDirectionStyle provides the style setter that sets the css "animation-direction" value.
+func AnimationDirectionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-direction", value)
+}
+
+// AnimationDurationStyle provides the style setter that sets the css "animation-duration" value.
+func AnimationDurationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-duration", value)
+}
+
+// AnimationFillModeStyle provides the style setter that sets the css "animation-fill-mode" value.
+func AnimationFillModeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-fill-mode", value)
+}
+
+// AnimationIterationCountStyle provides the style setter that sets the css "animation-iteration-count" value.
+func AnimationIterationCountStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-iteration-count", value)
+}
+
+// AnimationNameStyle provides the style setter that sets the css "animation-name" value.
+func AnimationNameStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-name", value)
+}
+
+// AnimationPlayStateStyle provides the style setter that sets the css "animation-play-state" value.
+func AnimationPlayStateStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-play-state", value)
+}
+
+// AnimationTimingFunctionStyle provides the style setter that sets the css "animation-timing-function" value.
+func AnimationTimingFunctionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-timing-function", value)
+}
+
+// AppearanceStyle provides the style setter that sets the css "appearance" value.
+func AppearanceStyle(value string) trees.Property {
+	return trees.NewCSSStyle("appearance", value)
+}
+
+// AspectRatioStyle provides the style setter that sets the css "aspect-ratio" value.
+func AspectRatioStyle(value string) trees.Property {
+	return trees.NewCSSStyle("aspect-ratio", value)
+}
+
+// BackdropFilterStyle provides the style setter that sets the css "backdrop-filter" value.
+func BackdropFilterStyle(value string) trees.Property {
+	return trees.NewCSSStyle("backdrop-filter", value)
+}
+
+// BackfaceVisibilityStyle provides the style setter that sets the css "backface-visibility" value.
+func BackfaceVisibilityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("backface-visibility", value)
+}
+
+// BackgroundAttachmentStyle provides the style setter that sets the css "background-attachment" value.
+func BackgroundAttachmentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-attachment", value)
+}
+
+// BackgroundBlendModeStyle provides the style setter that sets the css "background-blend-mode" value.
+func BackgroundBlendModeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-blend-mode", value)
+}
+
+// BackgroundClipStyle provides the style setter that sets the css "background-clip" value.
+func BackgroundClipStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-clip", value)
+}
+
+// BackgroundColorStyle provides the style setter that sets the css "background-color" value.
+func BackgroundColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-color", value)
+}
+
+// BackgroundImageStyle provides the style setter that sets the css "background-image" value.
+func BackgroundImageStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-image", value)
+}
+
+// BackgroundOriginStyle provides the style setter that sets the css "background-origin" value.
+func BackgroundOriginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-origin", value)
+}
+
+// BackgroundPositionStyle provides the style setter that sets the css "background-position" value.
+func BackgroundPositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-position", value)
+}
+
+// BackgroundPositionXStyle provides the style setter that sets the css "background-position-x" value.
+func BackgroundPositionXStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-position-x", value)
+}
+
+// BackgroundPositionYStyle provides the style setter that sets the css "background-position-y" value.
+func BackgroundPositionYStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-position-y", value)
+}
+
+// BackgroundRepeatStyle provides the style setter that sets the css "background-repeat" value.
+func BackgroundRepeatStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-repeat", value)
+}
+
+// BackgroundSizeStyle provides the style setter that sets the css "background-size" value.
+func BackgroundSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-size", value)
+}
+
+// BaselineShiftStyle provides the style setter that sets the css "baseline-shift" value.
+func BaselineShiftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("baseline-shift", value)
+}
+
+// BlockSizeStyle provides the style setter that sets the css "block-size" value.
+func BlockSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("block-size", value)
+}
+
+// BorderStyle provides the style setter that sets the css "border" value.
+func BorderStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border", value)
+}
+
+// BorderBlockStyle provides the style setter that sets the css "border-block" value.
+func BorderBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block", value)
+}
+
+// BorderBlockColorStyle provides the style setter that sets the css "border-block-color" value.
+func BorderBlockColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-color", value)
+}
+
+// BorderBlockEndStyle provides the style setter that sets the css "border-block-end" value.
+func BorderBlockEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-end", value)
+}
+
+// BorderBlockEndColorStyle provides the style setter that sets the css "border-block-end-color" value.
+func BorderBlockEndColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-end-color", value)
+}
+
+// BorderBlockEndStyleStyle provides the style setter that sets the css "border-block-end-style" value.
+func BorderBlockEndStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-end-style", value)
+}
+
+// BorderBlockEndWidthStyle provides the style setter that sets the css "border-block-end-width" value.
+func BorderBlockEndWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-end-width", value)
+}
+
+// BorderBlockStartStyle provides the style setter that sets the css "border-block-start" value.
+func BorderBlockStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-start", value)
+}
+
+// BorderBlockStartColorStyle provides the style setter that sets the css "border-block-start-color" value.
+func BorderBlockStartColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-start-color", value)
+}
+
+// BorderBlockStartStyleStyle provides the style setter that sets the css "border-block-start-style" value.
+func BorderBlockStartStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-start-style", value)
+}
+
+// BorderBlockStartWidthStyle provides the style setter that sets the css "border-block-start-width" value.
+func BorderBlockStartWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-start-width", value)
+}
+
+// BorderBlockStyleStyle provides the style setter that sets the css "border-block-style" value.
+func BorderBlockStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-style", value)
+}
+
+// BorderBlockWidthStyle provides the style setter that sets the css "border-block-width" value.
+func BorderBlockWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-width", value)
+}
+
+// BorderBottomStyle provides the style setter that sets the css "border-bottom" value.
+func BorderBottomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom", value)
+}
+
+// BorderBottomColorStyle provides the style setter that sets the css "border-bottom-color" value.
+func BorderBottomColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom-color", value)
+}
+
+// BorderBottomLeftRadiusStyle provides the style setter that sets the css "border-bottom-left-radius" value.
+func BorderBottomLeftRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom-left-radius", value)
+}
+
+// BorderBottomRightRadiusStyle provides the style setter that sets the css "border-bottom-right-radius" value.
+func BorderBottomRightRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom-right-radius", value)
+}
+
+// BorderBottomStyleStyle provides the style setter that sets the css "border-bottom-style" value.
+func BorderBottomStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom-style", value)
+}
+
+// BorderBottomWidthStyle provides the style setter that sets the css "border-bottom-width" value.
+func BorderBottomWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom-width", value)
+}
+
+// BorderCollapseStyle provides the style setter that sets the css "border-collapse" value.
+func BorderCollapseStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-collapse", value)
+}
+
+// BorderColorStyle provides the style setter that sets the css "border-color" value.
+func BorderColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-color", value)
+}
+
+// BorderEndEndRadiusStyle provides the style setter that sets the css "border-end-end-radius" value.
+func BorderEndEndRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-end-end-radius", value)
+}
+
+// BorderEndStartRadiusStyle provides the style setter that sets the css "border-end-start-radius" value.
+func BorderEndStartRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-end-start-radius", value)
+}
+
+// BorderImageStyle provides the style setter that sets the css "border-image" value.
+func BorderImageStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image", value)
+}
+
+// BorderImageOutsetStyle provides the style setter that sets the css "border-image-outset" value.
+func BorderImageOutsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image-outset", value)
+}
+
+// BorderImageRepeatStyle provides the style setter that sets the css "border-image-repeat" value.
+func BorderImageRepeatStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image-repeat", value)
+}
+
+// BorderImageSliceStyle provides the style setter that sets the css "border-image-slice" value.
+func BorderImageSliceStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image-slice", value)
+}
+
+// BorderImageSourceStyle provides the style setter that sets the css "border-image-source" value.
+func BorderImageSourceStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image-source", value)
+}
+
+// BorderImageWidthStyle provides the style setter that sets the css "border-image-width" value.
+func BorderImageWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image-width", value)
+}
+
+// BorderInlineStyle provides the style setter that sets the css "border-inline" value.
+func BorderInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline", value)
+}
+
+// BorderInlineColorStyle provides the style setter that sets the css "border-inline-color" value.
+func BorderInlineColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-color", value)
+}
+
+// BorderInlineEndStyle provides the style setter that sets the css "border-inline-end" value.
+func BorderInlineEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-end", value)
+}
+
+// BorderInlineEndColorStyle provides the style setter that sets the css "border-inline-end-color" value.
+func BorderInlineEndColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-end-color", value)
+}
+
+// BorderInlineEndStyleStyle provides the style setter that sets the css "border-inline-end-style" value.
+func BorderInlineEndStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-end-style", value)
+}
+
+// BorderInlineEndWidthStyle provides the style setter that sets the css "border-inline-end-width" value.
+func BorderInlineEndWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-end-width", value)
+}
+
+// BorderInlineStartStyle provides the style setter that sets the css "border-inline-start" value.
+func BorderInlineStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-start", value)
+}
+
+// BorderInlineStartColorStyle provides the style setter that sets the css "border-inline-start-color" value.
+func BorderInlineStartColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-start-color", value)
+}
+
+// BorderInlineStartStyleStyle provides the style setter that sets the css "border-inline-start-style" value.
+func BorderInlineStartStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-start-style", value)
+}
+
+// BorderInlineStartWidthStyle provides the style setter that sets the css "border-inline-start-width" value.
+func BorderInlineStartWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-start-width", value)
+}
+
+// BorderInlineStyleStyle provides the style setter that sets the css "border-inline-style" value.
+func BorderInlineStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-style", value)
+}
+
+// BorderInlineWidthStyle provides the style setter that sets the css "border-inline-width" value.
+func BorderInlineWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-width", value)
+}
+
+// BorderLeftStyle provides the style setter that sets the css "border-left" value.
+func BorderLeftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-left", value)
+}
+
+// BorderLeftColorStyle provides the style setter that sets the css "border-left-color" value.
+func BorderLeftColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-left-color", value)
+}
+
+// BorderLeftStyleStyle provides the style setter that sets the css "border-left-style" value.
+func BorderLeftStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-left-style", value)
+}
+
+// BorderLeftWidthStyle provides the style setter that sets the css "border-left-width" value.
+func BorderLeftWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-left-width", value)
+}
+
+// BorderRadiusStyle provides the style setter that sets the css "border-radius" value.
+func BorderRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-radius", value)
+}
+
+// BorderRightStyle provides the style setter that sets the css "border-right" value.
+func BorderRightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-right", value)
+}
+
+// BorderRightColorStyle provides the style setter that sets the css "border-right-color" value.
+func BorderRightColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-right-color", value)
+}
+
+// BorderRightStyleStyle provides the style setter that sets the css "border-right-style" value.
+func BorderRightStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-right-style", value)
+}
+
+// BorderRightWidthStyle provides the style setter that sets the css "border-right-width" value.
+func BorderRightWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-right-width", value)
+}
+
+// BorderSpacingStyle provides the style setter that sets the css "border-spacing" value.
+func BorderSpacingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-spacing", value)
+}
+
+// BorderStartEndRadiusStyle provides the style setter that sets the css "border-start-end-radius" value.
+func BorderStartEndRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-start-end-radius", value)
+}
+
+// BorderStartStartRadiusStyle provides the style setter that sets the css "border-start-start-radius" value.
+func BorderStartStartRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-start-start-radius", value)
+}
+
+// BorderStyleStyle provides the style setter that sets the css "border-style" value.
+func BorderStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-style", value)
+}
+
+// BorderTopStyle provides the style setter that sets the css "border-top" value.
+func BorderTopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top", value)
+}
+
+// BorderTopColorStyle provides the style setter that sets the css "border-top-color" value.
+func BorderTopColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top-color", value)
+}
+
+// BorderTopLeftRadiusStyle provides the style setter that sets the css "border-top-left-radius" value.
+func BorderTopLeftRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top-left-radius", value)
+}
+
+// BorderTopRightRadiusStyle provides the style setter that sets the css "border-top-right-radius" value.
+func BorderTopRightRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top-right-radius", value)
+}
+
+// BorderTopStyleStyle provides the style setter that sets the css "border-top-style" value.
+func BorderTopStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top-style", value)
+}
+
+// BorderTopWidthStyle provides the style setter that sets the css "border-top-width" value.
+func BorderTopWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top-width", value)
+}
+
+// BorderWidthStyle provides the style setter that sets the css "border-width" value.
+func BorderWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-width", value)
+}
+
+// BottomStyle provides the style setter that sets the css "bottom" value.
+func BottomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("bottom", value)
+}
+
+// BoxDecorationBreakStyle provides the style setter that sets the css "box-decoration-break" value.
+func BoxDecorationBreakStyle(value string) trees.Property {
+	return trees.NewCSSStyle("box-decoration-break", value)
+}
+
+// BoxShadowStyle provides the style setter that sets the css "box-shadow" value.
+func BoxShadowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("box-shadow", value)
+}
+
+// BoxSizingStyle provides the style setter that sets the css "box-sizing" value.
+func BoxSizingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("box-sizing", value)
+}
+
+// BreakAfterStyle provides the style setter that sets the css "break-after" value.
+func BreakAfterStyle(value string) trees.Property {
+	return trees.NewCSSStyle("break-after", value)
+}
+
+// BreakBeforeStyle provides the style setter that sets the css "break-before" value.
+func BreakBeforeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("break-before", value)
+}
+
+// BreakInsideStyle provides the style setter that sets the css "break-inside" value.
+func BreakInsideStyle(value string) trees.Property {
+	return trees.NewCSSStyle("break-inside", value)
+}
+
+// CaptionSideStyle provides the style setter that sets the css "caption-side" value.
+func CaptionSideStyle(value string) trees.Property {
+	return trees.NewCSSStyle("caption-side", value)
+}
+
+// CaretColorStyle provides the style setter that sets the css "caret-color" value.
+func CaretColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("caret-color", value)
+}
+
+// ClearStyle provides the style setter that sets the css "clear" value.
+func ClearStyle(value string) trees.Property {
+	return trees.NewCSSStyle("clear", value)
+}
+
+// ClipStyle provides the style setter that sets the css "clip" value.
+func ClipStyle(value string) trees.Property {
+	return trees.NewCSSStyle("clip", value)
+}
+
+// ClipPathStyle provides the style setter that sets the css "clip-path" value.
+func ClipPathStyle(value string) trees.Property {
+	return trees.NewCSSStyle("clip-path", value)
+}
+
+// ClipRuleStyle provides the style setter that sets the css "clip-rule" value.
+func ClipRuleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("clip-rule", value)
+}
+
+// ColorInterpolationStyle provides the style setter that sets the css "color-interpolation" value.
+func ColorInterpolationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("color-interpolation", value)
+}
+
+// ColorInterpolationFiltersStyle provides the style setter that sets the css "color-interpolation-filters" value.
+func ColorInterpolationFiltersStyle(value string) trees.Property {
+	return trees.NewCSSStyle("color-interpolation-filters", value)
+}
+
+// ColorSchemeStyle provides the style setter that sets the css "color-scheme" value.
+func ColorSchemeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("color-scheme", value)
+}
+
+// ColumnCountStyle provides the style setter that sets the css "column-count" value.
+func ColumnCountStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-count", value)
+}
+
+// ColumnFillStyle provides the style setter that sets the css "column-fill" value.
+func ColumnFillStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-fill", value)
+}
+
+// ColumnGapStyle provides the style setter that sets the css "column-gap" value.
+func ColumnGapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-gap", value)
+}
+
+// ColumnRuleStyle provides the style setter that sets the css "column-rule" value.
+func ColumnRuleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-rule", value)
+}
+
+// ColumnRuleColorStyle provides the style setter that sets the css "column-rule-color" value.
+func ColumnRuleColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-rule-color", value)
+}
+
+// ColumnRuleStyleStyle provides the style setter that sets the css "column-rule-style" value.
+func ColumnRuleStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-rule-style", value)
+}
+
+// ColumnRuleWidthStyle provides the style setter that sets the css "column-rule-width" value.
+func ColumnRuleWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-rule-width", value)
+}
+
+// ColumnSpanStyle provides the style setter that sets the css "column-span" value.
+func ColumnSpanStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-span", value)
+}
+
+// ColumnWidthStyle provides the style setter that sets the css "column-width" value.
+func ColumnWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-width", value)
+}
+
+// ColumnsStyle provides the style setter that sets the css "columns" value.
+func ColumnsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("columns", value)
+}
+
+// ContainStyle provides the style setter that sets the css "contain" value.
+func ContainStyle(value string) trees.Property {
+	return trees.NewCSSStyle("contain", value)
+}
+
+// ContainIntrinsicSizeStyle provides the style setter that sets the css "contain-intrinsic-size" value.
+func ContainIntrinsicSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("contain-intrinsic-size", value)
+}
+
+// ContainerStyle provides the style setter that sets the css "container" value.
+func ContainerStyle(value string) trees.Property {
+	return trees.NewCSSStyle("container", value)
+}
+
+// ContainerNameStyle provides the style setter that sets the css "container-name" value.
+func ContainerNameStyle(value string) trees.Property {
+	return trees.NewCSSStyle("container-name", value)
+}
+
+// ContainerTypeStyle provides the style setter that sets the css "container-type" value.
+func ContainerTypeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("container-type", value)
+}
+
+// ContentStyle provides the style setter that sets the css "content" value.
+func ContentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("content", value)
+}
+
+// ContentVisibilityStyle provides the style setter that sets the css "content-visibility" value.
+func ContentVisibilityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("content-visibility", value)
+}
+
+// CounterIncrementStyle provides the style setter that sets the css "counter-increment" value.
+func CounterIncrementStyle(value string) trees.Property {
+	return trees.NewCSSStyle("counter-increment", value)
+}
+
+// CounterResetStyle provides the style setter that sets the css "counter-reset" value.
+func CounterResetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("counter-reset", value)
+}
+
+// CounterSetStyle provides the style setter that sets the css "counter-set" value.
+func CounterSetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("counter-set", value)
+}
+
+// CursorStyle provides the style setter that sets the css "cursor" value.
+func CursorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("cursor", value)
+}
+
+// CxStyle provides the style setter that sets the css "cx" value.
+func CxStyle(value string) trees.Property {
+	return trees.NewCSSStyle("cx", value)
+}
+
+// CyStyle provides the style setter that sets the css "cy" value.
+func CyStyle(value string) trees.Property {
+	return trees.NewCSSStyle("cy", value)
+}
+
+// DStyle provides the style setter that sets the css "d" value.
+func DStyle(value string) trees.Property {
+	return trees.NewCSSStyle("d", value)
+}
+
+// DirectionStyle provides the style setter that sets the css "direction" value.
+func DirectionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("direction", value)
+}
+
+// DominantBaselineStyle provides the style setter that sets the css "dominant-baseline" value.
+func DominantBaselineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("dominant-baseline", value)
+}
+
+// EmptyCellsStyle provides the style setter that sets the css "empty-cells" value.
+func EmptyCellsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("empty-cells", value)
+}
+
+// FillStyle provides the style setter that sets the css "fill" value.
+func FillStyle(value string) trees.Property {
+	return trees.NewCSSStyle("fill", value)
+}
+
+// FillOpacityStyle provides the style setter that sets the css "fill-opacity" value.
+func FillOpacityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("fill-opacity", value)
+}
+
+// FillRuleStyle provides the style setter that sets the css "fill-rule" value.
+func FillRuleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("fill-rule", value)
+}
+
+// FilterStyle provides the style setter that sets the css "filter" value.
+func FilterStyle(value string) trees.Property {
+	return trees.NewCSSStyle("filter", value)
+}
+
+// FlexStyle provides the style setter that sets the css "flex" value.
+func FlexStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex", value)
+}
+
+// FlexBasisStyle provides the style setter that sets the css "flex-basis" value.
+func FlexBasisStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-basis", value)
+}
+
+// FlexDirectionStyle provides the style setter that sets the css "flex-direction" value.
+func FlexDirectionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-direction", value)
+}
+
+// FlexFlowStyle provides the style setter that sets the css "flex-flow" value.
+func FlexFlowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-flow", value)
+}
+
+// FlexGrowStyle provides the style setter that sets the css "flex-grow" value.
+func FlexGrowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-grow", value)
+}
+
+// FlexShrinkStyle provides the style setter that sets the css "flex-shrink" value.
+func FlexShrinkStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-shrink", value)
+}
+
+// FlexWrapStyle provides the style setter that sets the css "flex-wrap" value.
+func FlexWrapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-wrap", value)
+}
+
+// FloatStyle provides the style setter that sets the css "float" value.
+func FloatStyle(value string) trees.Property {
+	return trees.NewCSSStyle("float", value)
+}
+
+// FloodColorStyle provides the style setter that sets the css "flood-color" value.
+func FloodColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flood-color", value)
+}
+
+// FloodOpacityStyle provides the style setter that sets the css "flood-opacity" value.
+func FloodOpacityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flood-opacity", value)
+}
+
+// FontStyle provides the style setter that sets the css "font" value.
+func FontStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font", value)
+}
+
+// FontFamilyStyle provides the style setter that sets the css "font-family" value.
+func FontFamilyStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-family", value)
+}
+
+// FontFeatureSettingsStyle provides the style setter that sets the css "font-feature-settings" value.
+func FontFeatureSettingsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-feature-settings", value)
+}
+
+// FontKerningStyle provides the style setter that sets the css "font-kerning" value.
+func FontKerningStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-kerning", value)
+}
+
+// FontLanguageOverrideStyle provides the style setter that sets the css "font-language-override" value.
+func FontLanguageOverrideStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-language-override", value)
+}
+
+// FontOpticalSizingStyle provides the style setter that sets the css "font-optical-sizing" value.
+func FontOpticalSizingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-optical-sizing", value)
+}
+
+// FontSizeStyle provides the style setter that sets the css "font-size" value.
+func FontSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-size", value)
+}
+
+// FontSizeAdjustStyle provides the style setter that sets the css "font-size-adjust" value.
+func FontSizeAdjustStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-size-adjust", value)
+}
+
+// FontStretchStyle provides the style setter that sets the css "font-stretch" value.
+func FontStretchStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-stretch", value)
+}
+
+// FontStyleStyle provides the style setter that sets the css "font-style" value.
+func FontStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-style", value)
+}
+
+// FontSynthesisStyle provides the style setter that sets the css "font-synthesis" value.
+func FontSynthesisStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-synthesis", value)
+}
+
+// FontVariantStyle provides the style setter that sets the css "font-variant" value.
+func FontVariantStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant", value)
+}
+
+// FontVariantAlternatesStyle provides the style setter that sets the css "font-variant-alternates" value.
+func FontVariantAlternatesStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant-alternates", value)
+}
+
+// FontVariantCapsStyle provides the style setter that sets the css "font-variant-caps" value.
+func FontVariantCapsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant-caps", value)
+}
+
+// FontVariantEastAsianStyle provides the style setter that sets the css "font-variant-east-asian" value.
+func FontVariantEastAsianStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant-east-asian", value)
+}
+
+// FontVariantLigaturesStyle provides the style setter that sets the css "font-variant-ligatures" value.
+func FontVariantLigaturesStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant-ligatures", value)
+}
+
+// FontVariantNumericStyle provides the style setter that sets the css "font-variant-numeric" value.
+func FontVariantNumericStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant-numeric", value)
+}
+
+// FontVariantPositionStyle provides the style setter that sets the css "font-variant-position" value.
+func FontVariantPositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant-position", value)
+}
+
+// FontVariationSettingsStyle provides the style setter that sets the css "font-variation-settings" value.
+func FontVariationSettingsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variation-settings", value)
+}
+
+// FontWeightStyle provides the style setter that sets the css "font-weight" value.
+func FontWeightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-weight", value)
+}
+
+// ForcedColorAdjustStyle provides the style setter that sets the css "forced-color-adjust" value.
+func ForcedColorAdjustStyle(value string) trees.Property {
+	return trees.NewCSSStyle("forced-color-adjust", value)
+}
+
+// GapStyle provides the style setter that sets the css "gap" value.
+func GapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("gap", value)
+}
+
+// GridStyle provides the style setter that sets the css "grid" value.
+func GridStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid", value)
+}
+
+// GridAreaStyle provides the style setter that sets the css "grid-area" value.
+func GridAreaStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-area", value)
+}
+
+// GridAutoColumnsStyle provides the style setter that sets the css "grid-auto-columns" value.
+func GridAutoColumnsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-auto-columns", value)
+}
+
+// GridAutoFlowStyle provides the style setter that sets the css "grid-auto-flow" value.
+func GridAutoFlowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-auto-flow", value)
+}
+
+// GridAutoRowsStyle provides the style setter that sets the css "grid-auto-rows" value.
+func GridAutoRowsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-auto-rows", value)
+}
+
+// GridColumnStyle provides the style setter that sets the css "grid-column" value.
+func GridColumnStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-column", value)
+}
+
+// GridColumnEndStyle provides the style setter that sets the css "grid-column-end" value.
+func GridColumnEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-column-end", value)
+}
+
+// GridColumnStartStyle provides the style setter that sets the css "grid-column-start" value.
+func GridColumnStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-column-start", value)
+}
+
+// GridRowStyle provides the style setter that sets the css "grid-row" value.
+func GridRowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-row", value)
+}
+
+// GridRowEndStyle provides the style setter that sets the css "grid-row-end" value.
+func GridRowEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-row-end", value)
+}
+
+// GridRowStartStyle provides the style setter that sets the css "grid-row-start" value.
+func GridRowStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-row-start", value)
+}
+
+// GridTemplateStyle provides the style setter that sets the css "grid-template" value.
+func GridTemplateStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-template", value)
+}
+
+// GridTemplateAreasStyle provides the style setter that sets the css "grid-template-areas" value.
+func GridTemplateAreasStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-template-areas", value)
+}
+
+// GridTemplateColumnsStyle provides the style setter that sets the css "grid-template-columns" value.
+func GridTemplateColumnsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-template-columns", value)
+}
+
+// GridTemplateRowsStyle provides the style setter that sets the css "grid-template-rows" value.
+func GridTemplateRowsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-template-rows", value)
+}
+
+// HangingPunctuationStyle provides the style setter that sets the css "hanging-punctuation" value.
+func HangingPunctuationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("hanging-punctuation", value)
+}
+
+// HyphensStyle provides the style setter that sets the css "hyphens" value.
+func HyphensStyle(value string) trees.Property {
+	return trees.NewCSSStyle("hyphens", value)
+}
+
+// ImageOrientationStyle provides the style setter that sets the css "image-orientation" value.
+func ImageOrientationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("image-orientation", value)
+}
+
+// ImageRenderingStyle provides the style setter that sets the css "image-rendering" value.
+func ImageRenderingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("image-rendering", value)
+}
+
+// InlineSizeStyle provides the style setter that sets the css "inline-size" value.
+func InlineSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("inline-size", value)
+}
+
+// InsetStyle provides the style setter that sets the css "inset" value.
+func InsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("inset", value)
+}
+
+// InsetBlockStyle provides the style setter that sets the css "inset-block" value.
+func InsetBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("inset-block", value)
+}
+
+// InsetBlockEndStyle provides the style setter that sets the css "inset-block-end" value.
+func InsetBlockEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("inset-block-end", value)
+}
+
+// InsetBlockStartStyle provides the style setter that sets the css "inset-block-start" value.
+func InsetBlockStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("inset-block-start", value)
+}
+
+// InsetInlineStyle provides the style setter that sets the css "inset-inline" value.
+func InsetInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("inset-inline", value)
+}
+
+// InsetInlineEndStyle provides the style setter that sets the css "inset-inline-end" value.
+func InsetInlineEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("inset-inline-end", value)
+}
+
+// InsetInlineStartStyle provides the style setter that sets the css "inset-inline-start" value.
+func InsetInlineStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("inset-inline-start", value)
+}
+
+// IsolationStyle provides the style setter that sets the css "isolation" value.
+func IsolationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("isolation", value)
+}
+
+// JustifyContentStyle provides the style setter that sets the css "justify-content" value.
+func JustifyContentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("justify-content", value)
+}
+
+// JustifyItemsStyle provides the style setter that sets the css "justify-items" value.
+func JustifyItemsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("justify-items", value)
+}
+
+// JustifySelfStyle provides the style setter that sets the css "justify-self" value.
+func JustifySelfStyle(value string) trees.Property {
+	return trees.NewCSSStyle("justify-self", value)
+}
+
+// LeftStyle provides the style setter that sets the css "left" value.
+func LeftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("left", value)
+}
+
+// LetterSpacingStyle provides the style setter that sets the css "letter-spacing" value.
+func LetterSpacingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("letter-spacing", value)
+}
+
+// LightingColorStyle provides the style setter that sets the css "lighting-color" value.
+func LightingColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("lighting-color", value)
+}
+
+// LineBreakStyle provides the style setter that sets the css "line-break" value.
+func LineBreakStyle(value string) trees.Property {
+	return trees.NewCSSStyle("line-break", value)
+}
+
+// LineHeightStyle provides the style setter that sets the css "line-height" value.
+func LineHeightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("line-height", value)
+}
+
+// ListStyleStyle provides the style setter that sets the css "list-style" value.
+func ListStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("list-style", value)
+}
+
+// ListStyleImageStyle provides the style setter that sets the css "list-style-image" value.
+func ListStyleImageStyle(value string) trees.Property {
+	return trees.NewCSSStyle("list-style-image", value)
+}
+
+// ListStylePositionStyle provides the style setter that sets the css "list-style-position" value.
+func ListStylePositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("list-style-position", value)
+}
+
+// ListStyleTypeStyle provides the style setter that sets the css "list-style-type" value.
+func ListStyleTypeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("list-style-type", value)
+}
+
+// MarginBlockStyle provides the style setter that sets the css "margin-block" value.
+func MarginBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-block", value)
+}
+
+// MarginBlockEndStyle provides the style setter that sets the css "margin-block-end" value.
+func MarginBlockEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-block-end", value)
+}
+
+// MarginBlockStartStyle provides the style setter that sets the css "margin-block-start" value.
+func MarginBlockStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-block-start", value)
+}
+
+// MarginBottomStyle provides the style setter that sets the css "margin-bottom" value.
+func MarginBottomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-bottom", value)
+}
+
+// MarginInlineStyle provides the style setter that sets the css "margin-inline" value.
+func MarginInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-inline", value)
+}
+
+// MarginInlineEndStyle provides the style setter that sets the css "margin-inline-end" value.
+func MarginInlineEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-inline-end", value)
+}
+
+// MarginInlineStartStyle provides the style setter that sets the css "margin-inline-start" value.
+func MarginInlineStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-inline-start", value)
+}
+
+// MarginLeftStyle provides the style setter that sets the css "margin-left" value.
+func MarginLeftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-left", value)
+}
+
+// MarginRightStyle provides the style setter that sets the css "margin-right" value.
+func MarginRightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-right", value)
+}
+
+// MarginTopStyle provides the style setter that sets the css "margin-top" value.
+func MarginTopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-top", value)
+}
+
+// MarkerStyle provides the style setter that sets the css "marker" value.
+func MarkerStyle(value string) trees.Property {
+	return trees.NewCSSStyle("marker", value)
+}
+
+// MarkerEndStyle provides the style setter that sets the css "marker-end" value.
+func MarkerEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("marker-end", value)
+}
+
+// MarkerMidStyle provides the style setter that sets the css "marker-mid" value.
+func MarkerMidStyle(value string) trees.Property {
+	return trees.NewCSSStyle("marker-mid", value)
+}
+
+// MarkerStartStyle provides the style setter that sets the css "marker-start" value.
+func MarkerStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("marker-start", value)
+}
+
+// MaskStyle provides the style setter that sets the css "mask" value.
+func MaskStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask", value)
+}
+
+// MaskBorderStyle provides the style setter that sets the css "mask-border" value.
+func MaskBorderStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-border", value)
+}
+
+// MaskClipStyle provides the style setter that sets the css "mask-clip" value.
+func MaskClipStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-clip", value)
+}
+
+// MaskCompositeStyle provides the style setter that sets the css "mask-composite" value.
+func MaskCompositeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-composite", value)
+}
+
+// MaskImageStyle provides the style setter that sets the css "mask-image" value.
+func MaskImageStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-image", value)
+}
+
+// MaskModeStyle provides the style setter that sets the css "mask-mode" value.
+func MaskModeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-mode", value)
+}
+
+// MaskOriginStyle provides the style setter that sets the css "mask-origin" value.
+func MaskOriginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-origin", value)
+}
+
+// MaskPositionStyle provides the style setter that sets the css "mask-position" value.
+func MaskPositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-position", value)
+}
+
+// MaskRepeatStyle provides the style setter that sets the css "mask-repeat" value.
+func MaskRepeatStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-repeat", value)
+}
+
+// MaskSizeStyle provides the style setter that sets the css "mask-size" value.
+func MaskSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-size", value)
+}
+
+// MaskTypeStyle provides the style setter that sets the css "mask-type" value.
+func MaskTypeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-type", value)
+}
+
+// MaxBlockSizeStyle provides the style setter that sets the css "max-block-size" value.
+func MaxBlockSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("max-block-size", value)
+}
+
+// MaxHeightStyle provides the style setter that sets the css "max-height" value.
+func MaxHeightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("max-height", value)
+}
+
+// MaxInlineSizeStyle provides the style setter that sets the css "max-inline-size" value.
+func MaxInlineSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("max-inline-size", value)
+}
+
+// MaxWidthStyle provides the style setter that sets the css "max-width" value.
+func MaxWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("max-width", value)
+}
+
+// MinBlockSizeStyle provides the style setter that sets the css "min-block-size" value.
+func MinBlockSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("min-block-size", value)
+}
+
+// MinHeightStyle provides the style setter that sets the css "min-height" value.
+func MinHeightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("min-height", value)
+}
+
+// MinInlineSizeStyle provides the style setter that sets the css "min-inline-size" value.
+func MinInlineSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("min-inline-size", value)
+}
+
+// MinWidthStyle provides the style setter that sets the css "min-width" value.
+func MinWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("min-width", value)
+}
+
+// MixBlendModeStyle provides the style setter that sets the css "mix-blend-mode" value.
+func MixBlendModeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mix-blend-mode", value)
+}
+
+// ObjectFitStyle provides the style setter that sets the css "object-fit" value.
+func ObjectFitStyle(value string) trees.Property {
+	return trees.NewCSSStyle("object-fit", value)
+}
+
+// ObjectPositionStyle provides the style setter that sets the css "object-position" value.
+func ObjectPositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("object-position", value)
+}
+
+// OffsetStyle provides the style setter that sets the css "offset" value.
+func OffsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("offset", value)
+}
+
+// OffsetAnchorStyle provides the style setter that sets the css "offset-anchor" value.
+func OffsetAnchorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("offset-anchor", value)
+}
+
+// OffsetDistanceStyle provides the style setter that sets the css "offset-distance" value.
+func OffsetDistanceStyle(value string) trees.Property {
+	return trees.NewCSSStyle("offset-distance", value)
+}
+
+// OffsetPathStyle provides the style setter that sets the css "offset-path" value.
+func OffsetPathStyle(value string) trees.Property {
+	return trees.NewCSSStyle("offset-path", value)
+}
+
+// OffsetRotateStyle provides the style setter that sets the css "offset-rotate" value.
+func OffsetRotateStyle(value string) trees.Property {
+	return trees.NewCSSStyle("offset-rotate", value)
+}
+
+// OpacityStyle provides the style setter that sets the css "opacity" value.
+func OpacityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("opacity", value)
+}
+
+// OrderStyle provides the style setter that sets the css "order" value.
+func OrderStyle(value string) trees.Property {
+	return trees.NewCSSStyle("order", value)
+}
+
+// OrphansStyle provides the style setter that sets the css "orphans" value.
+func OrphansStyle(value string) trees.Property {
+	return trees.NewCSSStyle("orphans", value)
+}
+
+// OutlineStyle provides the style setter that sets the css "outline" value.
+func OutlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("outline", value)
+}
+
+// OutlineColorStyle provides the style setter that sets the css "outline-color" value.
+func OutlineColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("outline-color", value)
+}
+
+// OutlineOffsetStyle provides the style setter that sets the css "outline-offset" value.
+func OutlineOffsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("outline-offset", value)
+}
+
+// OutlineStyleStyle provides the style setter that sets the css "outline-style" value.
+func OutlineStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("outline-style", value)
+}
+
+// OutlineWidthStyle provides the style setter that sets the css "outline-width" value.
+func OutlineWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("outline-width", value)
+}
+
+// OverflowStyle provides the style setter that sets the css "overflow" value.
+func OverflowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow", value)
+}
+
+// OverflowAnchorStyle provides the style setter that sets the css "overflow-anchor" value.
+func OverflowAnchorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow-anchor", value)
+}
+
+// OverflowBlockStyle provides the style setter that sets the css "overflow-block" value.
+func OverflowBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow-block", value)
+}
+
+// OverflowClipMarginStyle provides the style setter that sets the css "overflow-clip-margin" value.
+func OverflowClipMarginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow-clip-margin", value)
+}
+
+// OverflowInlineStyle provides the style setter that sets the css "overflow-inline" value.
+func OverflowInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow-inline", value)
+}
+
+// OverflowWrapStyle provides the style setter that sets the css "overflow-wrap" value.
+func OverflowWrapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow-wrap", value)
+}
+
+// OverflowXStyle provides the style setter that sets the css "overflow-x" value.
+func OverflowXStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow-x", value)
+}
+
+// OverflowYStyle provides the style setter that sets the css "overflow-y" value.
+func OverflowYStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow-y", value)
+}
+
+// OverscrollBehaviorStyle provides the style setter that sets the css "overscroll-behavior" value.
+func OverscrollBehaviorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overscroll-behavior", value)
+}
+
+// OverscrollBehaviorBlockStyle provides the style setter that sets the css "overscroll-behavior-block" value.
+func OverscrollBehaviorBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overscroll-behavior-block", value)
+}
+
+// OverscrollBehaviorInlineStyle provides the style setter that sets the css "overscroll-behavior-inline" value.
+func OverscrollBehaviorInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overscroll-behavior-inline", value)
+}
+
+// OverscrollBehaviorXStyle provides the style setter that sets the css "overscroll-behavior-x" value.
+func OverscrollBehaviorXStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overscroll-behavior-x", value)
+}
+
+// OverscrollBehaviorYStyle provides the style setter that sets the css "overscroll-behavior-y" value.
+func OverscrollBehaviorYStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overscroll-behavior-y", value)
+}
+
+// PaddingBlockStyle provides the style setter that sets the css "padding-block" value.
+func PaddingBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-block", value)
+}
+
+// PaddingBlockEndStyle provides the style setter that sets the css "padding-block-end" value.
+func PaddingBlockEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-block-end", value)
+}
+
+// PaddingBlockStartStyle provides the style setter that sets the css "padding-block-start" value.
+func PaddingBlockStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-block-start", value)
+}
+
+// PaddingBottomStyle provides the style setter that sets the css "padding-bottom" value.
+func PaddingBottomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-bottom", value)
+}
+
+// PaddingInlineStyle provides the style setter that sets the css "padding-inline" value.
+func PaddingInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-inline", value)
+}
+
+// PaddingInlineEndStyle provides the style setter that sets the css "padding-inline-end" value.
+func PaddingInlineEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-inline-end", value)
+}
+
+// PaddingInlineStartStyle provides the style setter that sets the css "padding-inline-start" value.
+func PaddingInlineStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-inline-start", value)
+}
+
+// PaddingLeftStyle provides the style setter that sets the css "padding-left" value.
+func PaddingLeftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-left", value)
+}
+
+// PaddingRightStyle provides the style setter that sets the css "padding-right" value.
+func PaddingRightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-right", value)
+}
+
+// PaddingTopStyle provides the style setter that sets the css "padding-top" value.
+func PaddingTopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-top", value)
+}
+
+// PageBreakAfterStyle provides the style setter that sets the css "page-break-after" value.
+func PageBreakAfterStyle(value string) trees.Property {
+	return trees.NewCSSStyle("page-break-after", value)
+}
+
+// PageBreakBeforeStyle provides the style setter that sets the css "page-break-before" value.
+func PageBreakBeforeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("page-break-before", value)
+}
+
+// PageBreakInsideStyle provides the style setter that sets the css "page-break-inside" value.
+func PageBreakInsideStyle(value string) trees.Property {
+	return trees.NewCSSStyle("page-break-inside", value)
+}
+
+// PaintOrderStyle provides the style setter that sets the css "paint-order" value.
+func PaintOrderStyle(value string) trees.Property {
+	return trees.NewCSSStyle("paint-order", value)
+}
+
+// PerspectiveStyle provides the style setter that sets the css "perspective" value.
+func PerspectiveStyle(value string) trees.Property {
+	return trees.NewCSSStyle("perspective", value)
+}
+
+// PerspectiveOriginStyle provides the style setter that sets the css "perspective-origin" value.
+func PerspectiveOriginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("perspective-origin", value)
+}
+
+// PlaceContentStyle provides the style setter that sets the css "place-content" value.
+func PlaceContentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("place-content", value)
+}
+
+// PlaceItemsStyle provides the style setter that sets the css "place-items" value.
+func PlaceItemsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("place-items", value)
+}
+
+// PlaceSelfStyle provides the style setter that sets the css "place-self" value.
+func PlaceSelfStyle(value string) trees.Property {
+	return trees.NewCSSStyle("place-self", value)
+}
+
+// PointerEventsStyle provides the style setter that sets the css "pointer-events" value.
+func PointerEventsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("pointer-events", value)
+}
+
+// PositionStyle provides the style setter that sets the css "position" value.
+func PositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("position", value)
+}
+
+// PrintColorAdjustStyle provides the style setter that sets the css "print-color-adjust" value.
+func PrintColorAdjustStyle(value string) trees.Property {
+	return trees.NewCSSStyle("print-color-adjust", value)
+}
+
+// QuotesStyle provides the style setter that sets the css "quotes" value.
+func QuotesStyle(value string) trees.Property {
+	return trees.NewCSSStyle("quotes", value)
+}
+
+// RStyle provides the style setter that sets the css "r" value.
+func RStyle(value string) trees.Property {
+	return trees.NewCSSStyle("r", value)
+}
+
+// ResizeStyle provides the style setter that sets the css "resize" value.
+func ResizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("resize", value)
+}
+
+// RightStyle provides the style setter that sets the css "right" value.
+func RightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("right", value)
+}
+
+// RotateStyle provides the style setter that sets the css "rotate" value.
+func RotateStyle(value string) trees.Property {
+	return trees.NewCSSStyle("rotate", value)
+}
+
+// RowGapStyle provides the style setter that sets the css "row-gap" value.
+func RowGapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("row-gap", value)
+}
+
+// RubyAlignStyle provides the style setter that sets the css "ruby-align" value.
+func RubyAlignStyle(value string) trees.Property {
+	return trees.NewCSSStyle("ruby-align", value)
+}
+
+// RubyPositionStyle provides the style setter that sets the css "ruby-position" value.
+func RubyPositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("ruby-position", value)
+}
+
+// RxStyle provides the style setter that sets the css "rx" value.
+func RxStyle(value string) trees.Property {
+	return trees.NewCSSStyle("rx", value)
+}
+
+// RyStyle provides the style setter that sets the css "ry" value.
+func RyStyle(value string) trees.Property {
+	return trees.NewCSSStyle("ry", value)
+}
+
+// ScaleStyle provides the style setter that sets the css "scale" value.
+func ScaleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scale", value)
+}
+
+// ScrollBehaviorStyle provides the style setter that sets the css "scroll-behavior" value.
+func ScrollBehaviorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-behavior", value)
+}
+
+// ScrollMarginStyle provides the style setter that sets the css "scroll-margin" value.
+func ScrollMarginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin", value)
+}
+
+// ScrollMarginBlockStyle provides the style setter that sets the css "scroll-margin-block" value.
+func ScrollMarginBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin-block", value)
+}
+
+// ScrollMarginBlockEndStyle provides the style setter that sets the css "scroll-margin-block-end" value.
+func ScrollMarginBlockEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin-block-end", value)
+}
+
+// ScrollMarginBlockStartStyle provides the style setter that sets the css "scroll-margin-block-start" value.
+func ScrollMarginBlockStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin-block-start", value)
+}
+
+// ScrollMarginBottomStyle provides the style setter that sets the css "scroll-margin-bottom" value.
+func ScrollMarginBottomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin-bottom", value)
+}
+
+// ScrollMarginInlineStyle provides the style setter that sets the css "scroll-margin-inline" value.
+func ScrollMarginInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin-inline", value)
+}
+
+// ScrollMarginInlineEndStyle provides the style setter that sets the css "scroll-margin-inline-end" value.
+func ScrollMarginInlineEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin-inline-end", value)
+}
+
+// ScrollMarginInlineStartStyle provides the style setter that sets the css "scroll-margin-inline-start" value.
+func ScrollMarginInlineStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin-inline-start", value)
+}
+
+// ScrollMarginLeftStyle provides the style setter that sets the css "scroll-margin-left" value.
+func ScrollMarginLeftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin-left", value)
+}
+
+// ScrollMarginRightStyle provides the style setter that sets the css "scroll-margin-right" value.
+func ScrollMarginRightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin-right", value)
+}
+
+// ScrollMarginTopStyle provides the style setter that sets the css "scroll-margin-top" value.
+func ScrollMarginTopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin-top", value)
+}
+
+// ScrollPaddingStyle provides the style setter that sets the css "scroll-padding" value.
+func ScrollPaddingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding", value)
+}
+
+// ScrollPaddingBlockStyle provides the style setter that sets the css "scroll-padding-block" value.
+func ScrollPaddingBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding-block", value)
+}
+
+// ScrollPaddingBlockEndStyle provides the style setter that sets the css "scroll-padding-block-end" value.
+func ScrollPaddingBlockEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding-block-end", value)
+}
+
+// ScrollPaddingBlockStartStyle provides the style setter that sets the css "scroll-padding-block-start" value.
+func ScrollPaddingBlockStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding-block-start", value)
+}
+
+// ScrollPaddingBottomStyle provides the style setter that sets the css "scroll-padding-bottom" value.
+func ScrollPaddingBottomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding-bottom", value)
+}
+
+// ScrollPaddingInlineStyle provides the style setter that sets the css "scroll-padding-inline" value.
+func ScrollPaddingInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding-inline", value)
+}
+
+// ScrollPaddingInlineEndStyle provides the style setter that sets the css "scroll-padding-inline-end" value.
+func ScrollPaddingInlineEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding-inline-end", value)
+}
+
+// ScrollPaddingInlineStartStyle provides the style setter that sets the css "scroll-padding-inline-start" value.
+func ScrollPaddingInlineStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding-inline-start", value)
+}
+
+// ScrollPaddingLeftStyle provides the style setter that sets the css "scroll-padding-left" value.
+func ScrollPaddingLeftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding-left", value)
+}
+
+// ScrollPaddingRightStyle provides the style setter that sets the css "scroll-padding-right" value.
+func ScrollPaddingRightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding-right", value)
+}
+
+// ScrollPaddingTopStyle provides the style setter that sets the css "scroll-padding-top" value.
+func ScrollPaddingTopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding-top", value)
+}
+
+// ScrollSnapAlignStyle provides the style setter that sets the css "scroll-snap-align" value.
+func ScrollSnapAlignStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-snap-align", value)
+}
+
+// ScrollSnapStopStyle provides the style setter that sets the css "scroll-snap-stop" value.
+func ScrollSnapStopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-snap-stop", value)
+}
+
+// ScrollSnapTypeStyle provides the style setter that sets the css "scroll-snap-type" value.
+func ScrollSnapTypeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-snap-type", value)
+}
+
+// ScrollbarColorStyle provides the style setter that sets the css "scrollbar-color" value.
+func ScrollbarColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scrollbar-color", value)
+}
+
+// ScrollbarGutterStyle provides the style setter that sets the css "scrollbar-gutter" value.
+func ScrollbarGutterStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scrollbar-gutter", value)
+}
+
+// ScrollbarWidthStyle provides the style setter that sets the css "scrollbar-width" value.
+func ScrollbarWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scrollbar-width", value)
+}
+
+// ShapeImageThresholdStyle provides the style setter that sets the css "shape-image-threshold" value.
+func ShapeImageThresholdStyle(value string) trees.Property {
+	return trees.NewCSSStyle("shape-image-threshold", value)
+}
+
+// ShapeMarginStyle provides the style setter that sets the css "shape-margin" value.
+func ShapeMarginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("shape-margin", value)
+}
+
+// ShapeOutsideStyle provides the style setter that sets the css "shape-outside" value.
+func ShapeOutsideStyle(value string) trees.Property {
+	return trees.NewCSSStyle("shape-outside", value)
+}
+
+// ShapeRenderingStyle provides the style setter that sets the css "shape-rendering" value.
+func ShapeRenderingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("shape-rendering", value)
+}
+
+// StopColorStyle provides the style setter that sets the css "stop-color" value.
+func StopColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stop-color", value)
+}
+
+// StopOpacityStyle provides the style setter that sets the css "stop-opacity" value.
+func StopOpacityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stop-opacity", value)
+}
+
+// StrokeStyle provides the style setter that sets the css "stroke" value.
+func StrokeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke", value)
+}
+
+// StrokeDasharrayStyle provides the style setter that sets the css "stroke-dasharray" value.
+func StrokeDasharrayStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-dasharray", value)
+}
+
+// StrokeDashoffsetStyle provides the style setter that sets the css "stroke-dashoffset" value.
+func StrokeDashoffsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-dashoffset", value)
+}
+
+// StrokeLinecapStyle provides the style setter that sets the css "stroke-linecap" value.
+func StrokeLinecapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-linecap", value)
+}
+
+// StrokeLinejoinStyle provides the style setter that sets the css "stroke-linejoin" value.
+func StrokeLinejoinStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-linejoin", value)
+}
+
+// StrokeMiterlimitStyle provides the style setter that sets the css "stroke-miterlimit" value.
+func StrokeMiterlimitStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-miterlimit", value)
+}
+
+// StrokeOpacityStyle provides the style setter that sets the css "stroke-opacity" value.
+func StrokeOpacityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-opacity", value)
+}
+
+// StrokeWidthStyle provides the style setter that sets the css "stroke-width" value.
+func StrokeWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-width", value)
+}
+
+// TabSizeStyle provides the style setter that sets the css "tab-size" value.
+func TabSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("tab-size", value)
+}
+
+// TableLayoutStyle provides the style setter that sets the css "table-layout" value.
+func TableLayoutStyle(value string) trees.Property {
+	return trees.NewCSSStyle("table-layout", value)
+}
+
+// TextAlignStyle provides the style setter that sets the css "text-align" value.
+func TextAlignStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-align", value)
+}
+
+// TextAlignLastStyle provides the style setter that sets the css "text-align-last" value.
+func TextAlignLastStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-align-last", value)
+}
+
+// TextAnchorStyle provides the style setter that sets the css "text-anchor" value.
+func TextAnchorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-anchor", value)
+}
+
+// TextCombineUprightStyle provides the style setter that sets the css "text-combine-upright" value.
+func TextCombineUprightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-combine-upright", value)
+}
+
+// TextDecorationStyle provides the style setter that sets the css "text-decoration" value.
+func TextDecorationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-decoration", value)
+}
+
+// TextDecorationColorStyle provides the style setter that sets the css "text-decoration-color" value.
+func TextDecorationColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-decoration-color", value)
+}
+
+// TextDecorationLineStyle provides the style setter that sets the css "text-decoration-line" value.
+func TextDecorationLineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-decoration-line", value)
+}
+
+// TextDecorationSkipInkStyle provides the style setter that sets the css "text-decoration-skip-ink" value.
+func TextDecorationSkipInkStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-decoration-skip-ink", value)
+}
+
+// TextDecorationStyleStyle provides the style setter that sets the css "text-decoration-style" value.
+func TextDecorationStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-decoration-style", value)
+}
+
+// TextDecorationThicknessStyle provides the style setter that sets the css "text-decoration-thickness" value.
+func TextDecorationThicknessStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-decoration-thickness", value)
+}
+
+// TextEmphasisStyle provides the style setter that sets the css "text-emphasis" value.
+func TextEmphasisStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-emphasis", value)
+}
+
+// TextEmphasisColorStyle provides the style setter that sets the css "text-emphasis-color" value.
+func TextEmphasisColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-emphasis-color", value)
+}
+
+// TextEmphasisPositionStyle provides the style setter that sets the css "text-emphasis-position" value.
+func TextEmphasisPositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-emphasis-position", value)
+}
+
+// TextEmphasisStyleStyle provides the style setter that sets the css "text-emphasis-style" value.
+func TextEmphasisStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-emphasis-style", value)
+}
+
+// TextIndentStyle provides the style setter that sets the css "text-indent" value.
+func TextIndentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-indent", value)
+}
+
+// TextJustifyStyle provides the style setter that sets the css "text-justify" value.
+func TextJustifyStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-justify", value)
+}
+
+// TextOrientationStyle provides the style setter that sets the css "text-orientation" value.
+func TextOrientationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-orientation", value)
+}
+
+// TextOverflowStyle provides the style setter that sets the css "text-overflow" value.
+func TextOverflowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-overflow", value)
+}
+
+// TextRenderingStyle provides the style setter that sets the css "text-rendering" value.
+func TextRenderingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-rendering", value)
+}
+
+// TextShadowStyle provides the style setter that sets the css "text-shadow" value.
+func TextShadowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-shadow", value)
+}
+
+// TextTransformStyle provides the style setter that sets the css "text-transform" value.
+func TextTransformStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-transform", value)
+}
+
+// TextUnderlineOffsetStyle provides the style setter that sets the css "text-underline-offset" value.
+func TextUnderlineOffsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-underline-offset", value)
+}
+
+// TextUnderlinePositionStyle provides the style setter that sets the css "text-underline-position" value.
+func TextUnderlinePositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-underline-position", value)
+}
+
+// TopStyle provides the style setter that sets the css "top" value.
+func TopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("top", value)
+}
+
+// TouchActionStyle provides the style setter that sets the css "touch-action" value.
+func TouchActionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("touch-action", value)
+}
+
+// TransformStyle provides the style setter that sets the css "transform" value.
+func TransformStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transform", value)
+}
+
+// TransformBoxStyle provides the style setter that sets the css "transform-box" value.
+func TransformBoxStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transform-box", value)
+}
+
+// TransformOriginStyle provides the style setter that sets the css "transform-origin" value.
+func TransformOriginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transform-origin", value)
+}
+
+// TransformStyleStyle provides the style setter that sets the css "transform-style" value.
+func TransformStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transform-style", value)
+}
+
+// TransitionStyle provides the style setter that sets the css "transition" value.
+func TransitionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transition", value)
+}
+
+// TransitionDelayStyle provides the style setter that sets the css "transition-delay" value.
+func TransitionDelayStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transition-delay", value)
+}
+
+// TransitionDurationStyle provides the style setter that sets the css "transition-duration" value.
+func TransitionDurationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transition-duration", value)
+}
+
+// TransitionPropertyStyle provides the style setter that sets the css "transition-property" value.
+func TransitionPropertyStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transition-property", value)
+}
+
+// TransitionTimingFunctionStyle provides the style setter that sets the css "transition-timing-function" value.
+func TransitionTimingFunctionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transition-timing-function", value)
+}
+
+// TranslateStyle provides the style setter that sets the css "translate" value.
+func TranslateStyle(value string) trees.Property {
+	return trees.NewCSSStyle("translate", value)
+}
+
+// UnicodeBidiStyle provides the style setter that sets the css "unicode-bidi" value.
+func UnicodeBidiStyle(value string) trees.Property {
+	return trees.NewCSSStyle("unicode-bidi", value)
+}
+
+// UserSelectStyle provides the style setter that sets the css "user-select" value.
+func UserSelectStyle(value string) trees.Property {
+	return trees.NewCSSStyle("user-select", value)
+}
+
+// VectorEffectStyle provides the style setter that sets the css "vector-effect" value.
+func VectorEffectStyle(value string) trees.Property {
+	return trees.NewCSSStyle("vector-effect", value)
+}
+
+// VerticalAlignStyle provides the style setter that sets the css "vertical-align" value.
+func VerticalAlignStyle(value string) trees.Property {
+	return trees.NewCSSStyle("vertical-align", value)
+}
+
+// VisibilityStyle provides the style setter that sets the css "visibility" value.
+func VisibilityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("visibility", value)
+}
+
+// WhiteSpaceStyle provides the style setter that sets the css "white-space" value.
+func WhiteSpaceStyle(value string) trees.Property {
+	return trees.NewCSSStyle("white-space", value)
+}
+
+// WidowsStyle provides the style setter that sets the css "widows" value.
+func WidowsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("widows", value)
+}
+
+// WillChangeStyle provides the style setter that sets the css "will-change" value.
+func WillChangeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("will-change", value)
+}
+
+// WordBreakStyle provides the style setter that sets the css "word-break" value.
+func WordBreakStyle(value string) trees.Property {
+	return trees.NewCSSStyle("word-break", value)
+}
+
+// WordSpacingStyle provides the style setter that sets the css "word-spacing" value.
+func WordSpacingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("word-spacing", value)
+}
+
+// WordWrapStyle provides the style setter that sets the css "word-wrap" value.
+func WordWrapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("word-wrap", value)
+}
+
+// WritingModeStyle provides the style setter that sets the css "writing-mode" value.
+func WritingModeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("writing-mode", value)
+}
+
+// XStyle provides the style setter that sets the css "x" value.
+func XStyle(value string) trees.Property {
+	return trees.NewCSSStyle("x", value)
+}
+
+// YStyle provides the style setter that sets the css "y" value.
+func YStyle(value string) trees.Property {
+	return trees.NewCSSStyle("y", value)
+}
+
+// ZIndexStyle provides the style setter that sets the css "z-index" value.
+func ZIndexStyle(value string) trees.Property {
+	return trees.NewCSSStyle("z-index", value)
+}
+
+// ZoomStyle provides the style setter that sets the css "zoom" value.
+func ZoomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("zoom", value)
+}
+
+// StyleFuncs maps the css properties to the names of the functions setting
+// them, for use by tools writing code with the package such as gu html2go.
+var StyleFuncs = map[string]string{
+	"accent-color":                "AccentColorStyle",
+	"align-content":               "AlignContentStyle",
+	"align-items":                 "AlignItemsStyle",
+	"align-self":                  "AlignSelfStyle",
+	"alignment-baseline":          "AlignmentBaselineStyle",
+	"all":                         "AllStyle",
+	"animation":                   "AnimationStyle",
+	"animation-delay":             "AnimationDelayStyle",
+	"animation-direction":         "AnimationDirectionStyle",
+	"animation-duration":          "AnimationDurationStyle",
+	"animation-fill-mode":         "AnimationFillModeStyle",
+	"animation-iteration-count":   "AnimationIterationCountStyle",
+	"animation-name":              "AnimationNameStyle",
+	"animation-play-state":        "AnimationPlayStateStyle",
+	"animation-timing-function":   "AnimationTimingFunctionStyle",
+	"appearance":                  "AppearanceStyle",
+	"aspect-ratio":                "AspectRatioStyle",
+	"backdrop-filter":             "BackdropFilterStyle",
+	"backface-visibility":         "BackfaceVisibilityStyle",
+	"background":                  "BackgroundStyle",
+	"background-attachment":       "BackgroundAttachmentStyle",
+	"background-blend-mode":       "BackgroundBlendModeStyle",
+	"background-clip":             "BackgroundClipStyle",
+	"background-color":            "BackgroundColorStyle",
+	"background-image":            "BackgroundImageStyle",
+	"background-origin":           "BackgroundOriginStyle",
+	"background-position":         "BackgroundPositionStyle",
+	"background-position-x":       "BackgroundPositionXStyle",
+	"background-position-y":       "BackgroundPositionYStyle",
+	"background-repeat":           "BackgroundRepeatStyle",
+	"background-size":             "BackgroundSizeStyle",
+	"baseline-shift":              "BaselineShiftStyle",
+	"block-size":                  "BlockSizeStyle",
+	"border":                      "BorderStyle",
+	"border-block":                "BorderBlockStyle",
+	"border-block-color":          "BorderBlockColorStyle",
+	"border-block-end":            "BorderBlockEndStyle",
+	"border-block-end-color":      "BorderBlockEndColorStyle",
+	"border-block-end-style":      "BorderBlockEndStyleStyle",
+	"border-block-end-width":      "BorderBlockEndWidthStyle",
+	"border-block-start":          "BorderBlockStartStyle",
+	"border-block-start-color":    "BorderBlockStartColorStyle",
+	"border-block-start-style":    "BorderBlockStartStyleStyle",
+	"border-block-start-width":    "BorderBlockStartWidthStyle",
+	"border-block-style":          "BorderBlockStyleStyle",
+	"border-block-width":          "BorderBlockWidthStyle",
+	"border-bottom":               "BorderBottomStyle",
+	"border-bottom-color":         "BorderBottomColorStyle",
+	"border-bottom-left-radius":   "BorderBottomLeftRadiusStyle",
+	"border-bottom-right-radius":  "BorderBottomRightRadiusStyle",
+	"border-bottom-style":         "BorderBottomStyleStyle",
+	"border-bottom-width":         "BorderBottomWidthStyle",
+	"border-collapse":             "BorderCollapseStyle",
+	"border-color":                "BorderColorStyle",
+	"border-end-end-radius":       "BorderEndEndRadiusStyle",
+	"border-end-start-radius":     "BorderEndStartRadiusStyle",
+	"border-image":                "BorderImageStyle",
+	"border-image-outset":         "BorderImageOutsetStyle",
+	"border-image-repeat":         "BorderImageRepeatStyle",
+	"border-image-slice":          "BorderImageSliceStyle",
+	"border-image-source":         "BorderImageSourceStyle",
+	"border-image-width":          "BorderImageWidthStyle",
+	"border-inline":               "BorderInlineStyle",
+	"border-inline-color":         "BorderInlineColorStyle",
+	"border-inline-end":           "BorderInlineEndStyle",
+	"border-inline-end-color":     "BorderInlineEndColorStyle",
+	"border-inline-end-style":     "BorderInlineEndStyleStyle",
+	"border-inline-end-width":     "BorderInlineEndWidthStyle",
+	"border-inline-start":         "BorderInlineStartStyle",
+	"border-inline-start-color":   "BorderInlineStartColorStyle",
+	"border-inline-start-style":   "BorderInlineStartStyleStyle",
+	"border-inline-start-width":   "BorderInlineStartWidthStyle",
+	"border-inline-style":         "BorderInlineStyleStyle",
+	"border-inline-width":         "BorderInlineWidthStyle",
+	"border-left":                 "BorderLeftStyle",
+	"border-left-color":           "BorderLeftColorStyle",
+	"border-left-style":           "BorderLeftStyleStyle",
+	"border-left-width":           "BorderLeftWidthStyle",
+	"border-radius":               "BorderRadiusStyle",
+	"border-right":                "BorderRightStyle",
+	"border-right-color":          "BorderRightColorStyle",
+	"border-right-style":          "BorderRightStyleStyle",
+	"border-right-width":          "BorderRightWidthStyle",
+	"border-spacing":              "BorderSpacingStyle",
+	"border-start-end-radius":     "BorderStartEndRadiusStyle",
+	"border-start-start-radius":   "BorderStartStartRadiusStyle",
+	"border-style":                "BorderStyleStyle",
+	"border-top":                  "BorderTopStyle",
+	"border-top-color":            "BorderTopColorStyle",
+	"border-top-left-radius":      "BorderTopLeftRadiusStyle",
+	"border-top-right-radius":     "BorderTopRightRadiusStyle",
+	"border-top-style":            "BorderTopStyleStyle",
+	"border-top-width":            "BorderTopWidthStyle",
+	"border-width":                "BorderWidthStyle",
+	"bottom":                      "BottomStyle",
+	"box-decoration-break":        "BoxDecorationBreakStyle",
+	"box-shadow":                  "BoxShadowStyle",
+	"box-sizing":                  "BoxSizingStyle",
+	"break-after":                 "BreakAfterStyle",
+	"break-before":                "BreakBeforeStyle",
+	"break-inside":                "BreakInsideStyle",
+	"caption-side":                "CaptionSideStyle",
+	"caret-color":                 "CaretColorStyle",
+	"clear":                       "ClearStyle",
+	"clip":                        "ClipStyle",
+	"clip-path":                   "ClipPathStyle",
+	"clip-rule":                   "ClipRuleStyle",
+	"color":                       "ColorStyle",
+	"color-interpolation":         "ColorInterpolationStyle",
+	"color-interpolation-filters": "ColorInterpolationFiltersStyle",
+	"color-scheme":                "ColorSchemeStyle",
+	"column-count":                "ColumnCountStyle",
+	"column-fill":                 "ColumnFillStyle",
+	"column-gap":                  "ColumnGapStyle",
+	"column-rule":                 "ColumnRuleStyle",
+	"column-rule-color":           "ColumnRuleColorStyle",
+	"column-rule-style":           "ColumnRuleStyleStyle",
+	"column-rule-width":           "ColumnRuleWidthStyle",
+	"column-span":                 "ColumnSpanStyle",
+	"column-width":                "ColumnWidthStyle",
+	"columns":                     "ColumnsStyle",
+	"contain":                     "ContainStyle",
+	"contain-intrinsic-size":      "ContainIntrinsicSizeStyle",
+	"container":                   "ContainerStyle",
+	"container-name":              "ContainerNameStyle",
+	"container-type":              "ContainerTypeStyle",
+	"content":                     "ContentStyle",
+	"content-visibility":          "ContentVisibilityStyle",
+	"counter-increment":           "CounterIncrementStyle",
+	"counter-reset":               "CounterResetStyle",
+	"counter-set":                 "CounterSetStyle",
+	"cursor":                      "CursorStyle",
+	"cx":                          "CxStyle",
+	"cy":                          "CyStyle",
+	"d":                           "DStyle",
+	"direction":                   "DirectionStyle",
+	"display":                     "DisplayStyle",
+	"dominant-baseline":           "DominantBaselineStyle",
+	"empty-cells":                 "EmptyCellsStyle",
+	"fill":                        "FillStyle",
+	"fill-opacity":                "FillOpacityStyle",
+	"fill-rule":                   "FillRuleStyle",
+	"filter":                      "FilterStyle",
+	"flex":                        "FlexStyle",
+	"flex-basis":                  "FlexBasisStyle",
+	"flex-direction":              "FlexDirectionStyle",
+	"flex-flow":                   "FlexFlowStyle",
+	"flex-grow":                   "FlexGrowStyle",
+	"flex-shrink":                 "FlexShrinkStyle",
+	"flex-wrap":                   "FlexWrapStyle",
+	"float":                       "FloatStyle",
+	"flood-color":                 "FloodColorStyle",
+	"flood-opacity":               "FloodOpacityStyle",
+	"font":                        "FontStyle",
+	"font-family":                 "FontFamilyStyle",
+	"font-feature-settings":       "FontFeatureSettingsStyle",
+	"font-kerning":                "FontKerningStyle",
+	"font-language-override":      "FontLanguageOverrideStyle",
+	"font-optical-sizing":         "FontOpticalSizingStyle",
+	"font-size":                   "FontSizeStyle",
+	"font-size-adjust":            "FontSizeAdjustStyle",
+	"font-stretch":                "FontStretchStyle",
+	"font-style":                  "FontStyleStyle",
+	"font-synthesis":              "FontSynthesisStyle",
+	"font-variant":                "FontVariantStyle",
+	"font-variant-alternates":     "FontVariantAlternatesStyle",
+	"font-variant-caps":           "FontVariantCapsStyle",
+	"font-variant-east-asian":     "FontVariantEastAsianStyle",
+	"font-variant-ligatures":      "FontVariantLigaturesStyle",
+	"font-variant-numeric":        "FontVariantNumericStyle",
+	"font-variant-position":       "FontVariantPositionStyle",
+	"font-variation-settings":     "FontVariationSettingsStyle",
+	"font-weight":                 "FontWeightStyle",
+	"forced-color-adjust":         "ForcedColorAdjustStyle",
+	"gap":                         "GapStyle",
+	"grid":                        "GridStyle",
+	"grid-area":                   "GridAreaStyle",
+	"grid-auto-columns":           "GridAutoColumnsStyle",
+	"grid-auto-flow":              "GridAutoFlowStyle",
+	"grid-auto-rows":              "GridAutoRowsStyle",
+	"grid-column":                 "GridColumnStyle",
+	"grid-column-end":             "GridColumnEndStyle",
+	"grid-column-start":           "GridColumnStartStyle",
+	"grid-row":                    "GridRowStyle",
+	"grid-row-end":                "GridRowEndStyle",
+	"grid-row-start":              "GridRowStartStyle",
+	"grid-template":               "GridTemplateStyle",
+	"grid-template-areas":         "GridTemplateAreasStyle",
+	"grid-template-columns":       "GridTemplateColumnsStyle",
+	"grid-template-rows":          "GridTemplateRowsStyle",
+	"hanging-punctuation":         "HangingPunctuationStyle",
+	"height":                      "HeightStyle",
+	"hyphens":                     "HyphensStyle",
+	"image-orientation":           "ImageOrientationStyle",
+	"image-rendering":             "ImageRenderingStyle",
+	"inline-size":                 "InlineSizeStyle",
+	"inset":                       "InsetStyle",
+	"inset-block":                 "InsetBlockStyle",
+	"inset-block-end":             "InsetBlockEndStyle",
+	"inset-block-start":           "InsetBlockStartStyle",
+	"inset-inline":                "InsetInlineStyle",
+	"inset-inline-end":            "InsetInlineEndStyle",
+	"inset-inline-start":          "InsetInlineStartStyle",
+	"isolation":                   "IsolationStyle",
+	"justify-content":             "JustifyContentStyle",
+	"justify-items":               "JustifyItemsStyle",
+	"justify-self":                "JustifySelfStyle",
+	"left":                        "LeftStyle",
+	"letter-spacing":              "LetterSpacingStyle",
+	"lighting-color":              "LightingColorStyle",
+	"line-break":                  "LineBreakStyle",
+	"line-height":                 "LineHeightStyle",
+	"list-style":                  "ListStyleStyle",
+	"list-style-image":            "ListStyleImageStyle",
+	"list-style-position":         "ListStylePositionStyle",
+	"list-style-type":             "ListStyleTypeStyle",
+	"margin":                      "MarginStyle",
+	"margin-block":                "MarginBlockStyle",
+	"margin-block-end":            "MarginBlockEndStyle",
+	"margin-block-start":          "MarginBlockStartStyle",
+	"margin-bottom":               "MarginBottomStyle",
+	"margin-inline":               "MarginInlineStyle",
+	"margin-inline-end":           "MarginInlineEndStyle",
+	"margin-inline-start":         "MarginInlineStartStyle",
+	"margin-left":                 "MarginLeftStyle",
+	"margin-right":                "MarginRightStyle",
+	"margin-top":                  "MarginTopStyle",
+	"marker":                      "MarkerStyle",
+	"marker-end":                  "MarkerEndStyle",
+	"marker-mid":                  "MarkerMidStyle",
+	"marker-start":                "MarkerStartStyle",
+	"mask":                        "MaskStyle",
+	"mask-border":                 "MaskBorderStyle",
+	"mask-clip":                   "MaskClipStyle",
+	"mask-composite":              "MaskCompositeStyle",
+	"mask-image":                  "MaskImageStyle",
+	"mask-mode":                   "MaskModeStyle",
+	"mask-origin":                 "MaskOriginStyle",
+	"mask-position":               "MaskPositionStyle",
+	"mask-repeat":                 "MaskRepeatStyle",
+	"mask-size":                   "MaskSizeStyle",
+	"mask-type":                   "MaskTypeStyle",
+	"max-block-size":              "MaxBlockSizeStyle",
+	"max-height":                  "MaxHeightStyle",
+	"max-inline-size":             "MaxInlineSizeStyle",
+	"max-width":                   "MaxWidthStyle",
+	"min-block-size":              "MinBlockSizeStyle",
+	"min-height":                  "MinHeightStyle",
+	"min-inline-size":             "MinInlineSizeStyle",
+	"min-width":                   "MinWidthStyle",
+	"mix-blend-mode":              "MixBlendModeStyle",
+	"object-fit":                  "ObjectFitStyle",
+	"object-position":             "ObjectPositionStyle",
+	"offset":                      "OffsetStyle",
+	"offset-anchor":               "OffsetAnchorStyle",
+	"offset-distance":             "OffsetDistanceStyle",
+	"offset-path":                 "OffsetPathStyle",
+	"offset-rotate":               "OffsetRotateStyle",
+	"opacity":                     "OpacityStyle",
+	"order":                       "OrderStyle",
+	"orphans":                     "OrphansStyle",
+	"outline":                     "OutlineStyle",
+	"outline-color":               "OutlineColorStyle",
+	"outline-offset":              "OutlineOffsetStyle",
+	"outline-style":               "OutlineStyleStyle",
+	"outline-width":               "OutlineWidthStyle",
+	"overflow":                    "OverflowStyle",
+	"overflow-anchor":             "OverflowAnchorStyle",
+	"overflow-block":              "OverflowBlockStyle",
+	"overflow-clip-margin":        "OverflowClipMarginStyle",
+	"overflow-inline":             "OverflowInlineStyle",
+	"overflow-wrap":               "OverflowWrapStyle",
+	"overflow-x":                  "OverflowXStyle",
+	"overflow-y":                  "OverflowYStyle",
+	"overscroll-behavior":         "OverscrollBehaviorStyle",
+	"overscroll-behavior-block":   "OverscrollBehaviorBlockStyle",
+	"overscroll-behavior-inline":  "OverscrollBehaviorInlineStyle",
+	"overscroll-behavior-x":       "OverscrollBehaviorXStyle",
+	"overscroll-behavior-y":       "OverscrollBehaviorYStyle",
+	"padding":                     "PaddingStyle",
+	"padding-block":               "PaddingBlockStyle",
+	"padding-block-end":           "PaddingBlockEndStyle",
+	"padding-block-start":         "PaddingBlockStartStyle",
+	"padding-bottom":              "PaddingBottomStyle",
+	"padding-inline":              "PaddingInlineStyle",
+	"padding-inline-end":          "PaddingInlineEndStyle",
+	"padding-inline-start":        "PaddingInlineStartStyle",
+	"padding-left":                "PaddingLeftStyle",
+	"padding-right":               "PaddingRightStyle",
+	"padding-top":                 "PaddingTopStyle",
+	"page-break-after":            "PageBreakAfterStyle",
+	"page-break-before":           "PageBreakBeforeStyle",
+	"page-break-inside":           "PageBreakInsideStyle",
+	"paint-order":                 "PaintOrderStyle",
+	"perspective":                 "PerspectiveStyle",
+	"perspective-origin":          "PerspectiveOriginStyle",
+	"place-content":               "PlaceContentStyle",
+	"place-items":                 "PlaceItemsStyle",
+	"place-self":                  "PlaceSelfStyle",
+	"pointer-events":              "PointerEventsStyle",
+	"position":                    "PositionStyle",
+	"print-color-adjust":          "PrintColorAdjustStyle",
+	"quotes":                      "QuotesStyle",
+	"r":                           "RStyle",
+	"resize":                      "ResizeStyle",
+	"right":                       "RightStyle",
+	"rotate":                      "RotateStyle",
+	"row-gap":                     "RowGapStyle",
+	"ruby-align":                  "RubyAlignStyle",
+	"ruby-position":               "RubyPositionStyle",
+	"rx":                          "RxStyle",
+	"ry":                          "RyStyle",
+	"scale":                       "ScaleStyle",
+	"scroll-behavior":             "ScrollBehaviorStyle",
+	"scroll-margin":               "ScrollMarginStyle",
+	"scroll-margin-block":         "ScrollMarginBlockStyle",
+	"scroll-margin-block-end":     "ScrollMarginBlockEndStyle",
+	"scroll-margin-block-start":   "ScrollMarginBlockStartStyle",
+	"scroll-margin-bottom":        "ScrollMarginBottomStyle",
+	"scroll-margin-inline":        "ScrollMarginInlineStyle",
+	"scroll-margin-inline-end":    "ScrollMarginInlineEndStyle",
+	"scroll-margin-inline-start":  "ScrollMarginInlineStartStyle",
+	"scroll-margin-left":          "ScrollMarginLeftStyle",
+	"scroll-margin-right":         "ScrollMarginRightStyle",
+	"scroll-margin-top":           "ScrollMarginTopStyle",
+	"scroll-padding":              "ScrollPaddingStyle",
+	"scroll-padding-block":        "ScrollPaddingBlockStyle",
+	"scroll-padding-block-end":    "ScrollPaddingBlockEndStyle",
+	"scroll-padding-block-start":  "ScrollPaddingBlockStartStyle",
+	"scroll-padding-bottom":       "ScrollPaddingBottomStyle",
+	"scroll-padding-inline":       "ScrollPaddingInlineStyle",
+	"scroll-padding-inline-end":   "ScrollPaddingInlineEndStyle",
+	"scroll-padding-inline-start": "ScrollPaddingInlineStartStyle",
+	"scroll-padding-left":         "ScrollPaddingLeftStyle",
+	"scroll-padding-right":        "ScrollPaddingRightStyle",
+	"scroll-padding-top":          "ScrollPaddingTopStyle",
+	"scroll-snap-align":           "ScrollSnapAlignStyle",
+	"scroll-snap-stop":            "ScrollSnapStopStyle",
+	"scroll-snap-type":            "ScrollSnapTypeStyle",
+	"scrollbar-color":             "ScrollbarColorStyle",
+	"scrollbar-gutter":            "ScrollbarGutterStyle",
+	"scrollbar-width":             "ScrollbarWidthStyle",
+	"shape-image-threshold":       "ShapeImageThresholdStyle",
+	"shape-margin":                "ShapeMarginStyle",
+	"shape-outside":               "ShapeOutsideStyle",
+	"shape-rendering":             "ShapeRenderingStyle",
+	"stop-color":                  "StopColorStyle",
+	"stop-opacity":                "StopOpacityStyle",
+	"stroke":                      "StrokeStyle",
+	"stroke-dasharray":            "StrokeDasharrayStyle",
+	"stroke-dashoffset":           "StrokeDashoffsetStyle",
+	"stroke-linecap":              "StrokeLinecapStyle",
+	"stroke-linejoin":             "StrokeLinejoinStyle",
+	"stroke-miterlimit":           "StrokeMiterlimitStyle",
+	"stroke-opacity":              "StrokeOpacityStyle",
+	"stroke-width":                "StrokeWidthStyle",
+	"tab-size":                    "TabSizeStyle",
+	"table-layout":                "TableLayoutStyle",
+	"text-align":                  "TextAlignStyle",
+	"text-align-last":             "TextAlignLastStyle",
+	"text-anchor":                 "TextAnchorStyle",
+	"text-combine-upright":        "TextCombineUprightStyle",
+	"text-decoration":             "TextDecorationStyle",
+	"text-decoration-color":       "TextDecorationColorStyle",
+	"text-decoration-line":        "TextDecorationLineStyle",
+	"text-decoration-skip-ink":    "TextDecorationSkipInkStyle",
+	"text-decoration-style":       "TextDecorationStyleStyle",
+	"text-decoration-thickness":   "TextDecorationThicknessStyle",
+	"text-emphasis":               "TextEmphasisStyle",
+	"text-emphasis-color":         "TextEmphasisColorStyle",
+	"text-emphasis-position":      "TextEmphasisPositionStyle",
+	"text-emphasis-style":         "TextEmphasisStyleStyle",
+	"text-indent":                 "TextIndentStyle",
+	"text-justify":                "TextJustifyStyle",
+	"text-orientation":            "TextOrientationStyle",
+	"text-overflow":               "TextOverflowStyle",
+	"text-rendering":              "TextRenderingStyle",
+	"text-shadow":                 "TextShadowStyle",
+	"text-transform":              "TextTransformStyle",
+	"text-underline-offset":       "TextUnderlineOffsetStyle",
+	"text-underline-position":     "TextUnderlinePositionStyle",
+	"top":                         "TopStyle",
+	"touch-action":                "TouchActionStyle",
+	"transform":                   "TransformStyle",
+	"transform-box":               "TransformBoxStyle",
+	"transform-origin":            "TransformOriginStyle",
+	"transform-style":             "TransformStyleStyle",
+	"transition":                  "TransitionStyle",
+	"transition-delay":            "TransitionDelayStyle",
+	"transition-duration":         "TransitionDurationStyle",
+	"transition-property":         "TransitionPropertyStyle",
+	"transition-timing-function":  "TransitionTimingFunctionStyle",
+	"translate":                   "TranslateStyle",
+	"unicode-bidi":                "UnicodeBidiStyle",
+	"user-select":                 "UserSelectStyle",
+	"vector-effect":               "VectorEffectStyle",
+	"vertical-align":              "VerticalAlignStyle",
+	"visibility":                  "VisibilityStyle",
+	"white-space":                 "WhiteSpaceStyle",
+	"widows":                      "WidowsStyle",
+	"width":                       "WidthStyle",
+	"will-change":                 "WillChangeStyle",
+	"word-break":                  "WordBreakStyle",
+	"word-spacing":                "WordSpacingStyle",
+	"word-wrap":                   "WordWrapStyle",
+	"writing-mode":                "WritingModeStyle",
+	"x":                           "XStyle",
+	"y":                           "YStyle",
+	"z-index":                     "ZIndexStyle",
+	"zoom":                        "ZoomStyle",
+}
@@ -22,7 +22,9 @@ func HeightStyle(size string) trees.Property {
 	return trees.NewCSSStyle("height", size)
 }
 
-// FontstringStyle provides the margin style value
+// FontstringStyle provides the font-size style value.
+//
+// Deprecated: Use FontSizeStyle.
 func FontstringStyle(size string) trees.Property {
 	return trees.NewCSSStyle("font-size", size)
 }
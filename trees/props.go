@@ -78,19 +78,30 @@ type Attribute struct {
 	After func(*Markup)
 }
 
-// NewAttr returns a new attribute instance.
+// NewAttr returns a new attribute instance. The name is lowercased unless it's
+// one of the case sensitive svg attribute names, such as viewBox.
 func NewAttr(name, val string) *Attribute {
-	a := Attribute{Name: strings.ToLower(name), Value: val}
+	a := Attribute{Name: attrName(name), Value: val}
 	return &a
 }
 
 // NewAttrWith returns a new attribute instance with a provided function
 // to call to provide a after effect to the markup.
 func NewAttrWith(name, val string, after func(*Markup)) *Attribute {
-	a := Attribute{Name: strings.ToLower(name), Value: val, After: after}
+	a := Attribute{Name: attrName(name), Value: val, After: after}
 	return &a
 }
 
+// attrName returns the lowercased name, keeping the case of the case sensitive
+// svg attribute names.
+func attrName(name string) string {
+	if original, ok := svgAttrNames[strings.ToLower(name)]; ok && original == name {
+		return name
+	}
+
+	return strings.ToLower(name)
+}
+
 // Render returns the key and value for this attribute rendered.
 func (a *Attribute) Render() (string, string) {
 	return a.Name, a.Value
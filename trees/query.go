@@ -69,144 +69,75 @@ func (s *Selector) GetClass() string {
 	return strings.Join(sels, "")
 }
 
-// Query returns the first element matching the giving selector.
+// Query returns the first element within the root matching the giving
+// selector, or nil if none matches or the selector is invalid.
 func (q queryCtrl) Query(root *Markup, sel string) *Markup {
-	sels := q.ParseSelector(sel)
-	if sels == nil {
+	compiled, err := q.Compile(sel)
+	if err != nil {
 		return nil
 	}
 
-	return q.QuerySelector(root, sels[0])
+	return compiled.Query(root)
 }
 
-// QueryAll returns the first element matching the giving selector.
+// QueryAll returns all elements within the root matching the giving selector
+// in document order, or nil if the selector is invalid.
 func (q queryCtrl) QueryAll(root *Markup, sel string) []*Markup {
-	sels := q.ParseSelector(sel)
-	if sels == nil {
+	compiled, err := q.Compile(sel)
+	if err != nil {
 		return nil
 	}
 
-	return q.QueryAllSelector(root, sels[0])
+	return compiled.QueryAll(root)
 }
 
 // QuerySelector uses the provided selector and root returning the first
 // element that matches the selector's criteria.
 func (q queryCtrl) QuerySelector(root *Markup, sel *Selector) *Markup {
-	var filtered *Markup
-
-childloop:
-	for _, child := range root.children {
-		if q.queryOne(child, sel) {
-			filtered = child
-			break childloop
-		}
-
-		for _, kid := range child.children {
-			if q.queryOne(kid, sel) {
-				filtered = kid
-				break childloop
-			}
-
-			if item := q.QuerySelector(kid, sel); item != nil {
-				filtered = item
-				break childloop
-			}
-		}
-	}
-
-	if sel.Children == nil {
-		return filtered
-	}
-
-	for _, child := range sel.Children {
-		filtered = q.QuerySelector(filtered, child)
-		if filtered == nil {
-			return nil
-		}
-	}
-
-	return filtered
+	return (&CompiledSelector{source: sel.GetSelector(), list: []*complexSelector{sel.complex()}}).Query(root)
 }
 
 // QueryAllSelector uses the provided selector and root returning all
 // elements that matches the selector's criteria.
 func (q queryCtrl) QueryAllSelector(root *Markup, sel *Selector) []*Markup {
-	var found []*Markup
-
-	for _, child := range root.children {
-		if !q.queryOne(child, sel) {
-
-			for _, kid := range child.children {
-				if q.queryOne(kid, sel) {
-					found = append(found, kid)
-				}
-
-				found = append(found, q.QueryAllSelector(kid, sel)...)
-			}
-
-			continue
-		}
-
-		if sel.Children == nil {
-			found = append(found, child)
-
-			for _, kid := range child.children {
-				if q.queryOne(kid, sel) {
-					found = append(found, kid)
-				}
-
-				found = append(found, q.QueryAllSelector(kid, sel)...)
-			}
-
-			continue
-		}
-
-		kid := child
-		for _, kidSel := range sel.Children {
-			kid = q.QuerySelector(kid, kidSel)
-			if kid == nil {
-				continue
-			}
-		}
-
-		for _, mkid := range child.children {
-			if q.queryOne(mkid, sel) {
-				found = append(found, mkid)
-			}
+	return (&CompiledSelector{source: sel.GetSelector(), list: []*complexSelector{sel.complex()}}).QueryAll(root)
+}
 
-			found = append(found, q.QueryAllSelector(mkid, sel)...)
-		}
+// complex returns the complex selector matching the selector and it's
+// children as descendants.
+func (s *Selector) complex() *complexSelector {
+	var complex complexSelector
+	complex.parts = append(complex.parts, s.compound())
 
-		found = append(found, kid)
+	for _, child := range s.Children {
+		complex.parts = append(complex.parts, child.compound())
+		complex.combinators = append(complex.combinators, descendantCombinator)
 	}
 
-	return found
+	return &complex
 }
 
-func (q queryCtrl) queryOne(target *Markup, sel *Selector) bool {
-	if sel.Tag != "" && !q.tagFor(target, sel.Tag) {
-		return false
-	}
+// compound returns the compound selector matching the selector.
+func (s *Selector) compound() *compoundSelector {
+	compound := compoundSelector{tag: s.Tag, classes: s.Classes}
 
-	if sel.ID != "" && !q.idFor(target, sel.ID) {
-		return false
+	if s.ID != "" {
+		compound.ids = append(compound.ids, s.ID)
 	}
 
-	if sel.Classes != nil {
-		for _, class := range sel.Classes {
-			if !q.classFor(target, class) {
-				return false
-			}
-		}
-
-		return true
+	if s.AttrName != "" {
+		compound.attrs = append(compound.attrs, attrSelector{name: s.AttrName, op: s.AttrOp, value: s.AttrValue})
 	}
 
-	if sel.AttrName != "" && !q.attrFor(target, sel.AttrName, sel.AttrValue, sel.AttrOp) {
-		return false
+	if s.Psuedo != "" {
+		parser := selectorParser{source: "*" + s.Psuedo}
+		if pseudo, err := parser.parseCompound(); err == nil {
+			compound.pseudos = pseudo.pseudos
+			compound.element = pseudo.element
+		}
 	}
 
-	return true
+	return &compound
 }
 
 var (
@@ -919,71 +850,3 @@ func (queryCtrl) splitBracketSelector(sel string) (string, string, string) {
 
 	return sel, "", ""
 }
-
-func (queryCtrl) attrFor(target *Markup, attrName string, attrVal string, op string) bool {
-	attr, err := GetAttr(target, attrName)
-	if err != nil {
-		return false
-	}
-
-	_, val := attr.Render()
-
-	switch op {
-	case exactMatch:
-		return val == attrVal
-
-	case exactWordInListMatch:
-		splits := strings.Split(val, " ")
-		for _, item := range splits {
-			if item != attrVal {
-				continue
-			}
-
-			return true
-		}
-
-	case beginOrExactlyMatch:
-		if strings.HasPrefix(val, attrVal+"-") {
-			return true
-		}
-
-		return val == attrVal
-
-	case prefixMatch:
-		return strings.HasPrefix(val, attrVal)
-
-	case suffixMatch:
-		return strings.HasSuffix(val, attrVal)
-
-	case containsMatch:
-		return strings.Contains(val, attrVal)
-
-	default:
-		return true
-	}
-
-	return false
-}
-
-func (queryCtrl) tagFor(target *Markup, tag string) bool {
-	return target.tagname == tag
-}
-
-func (queryCtrl) classFor(target *Markup, class string) bool {
-	if attr, err := GetAttr(target, "class"); err == nil {
-		_, val := attr.Render()
-		return strings.Contains(val, class)
-	}
-
-	return false
-}
-
-func (queryCtrl) idFor(target *Markup, id string) bool {
-	if attr, err := GetAttr(target, "id"); err == nil {
-		if _, val := attr.Render(); val == id {
-			return true
-		}
-	}
-
-	return false
-}
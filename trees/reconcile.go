@@ -0,0 +1,188 @@
+package trees
+
+// reconcileKeyed reconciles the new children against the old children matching
+// them by key, with children lacking keys matched in order against the old
+// children lacking keys. It returns true if any child changed, was inserted,
+// removed or moved.
+func (e *Markup) reconcileKeyed(newChildren, oldChildren []*Markup) bool {
+	var childChanged bool
+
+	sources := matchKeyed(newChildren, oldChildren)
+	matched := make([]bool, len(oldChildren))
+
+	for index, source := range sources {
+		// New child is either inserted or replaces a old one of another type.
+		if source < 0 {
+			childChanged = true
+			continue
+		}
+
+		matched[source] = true
+
+		if newChildren[index].Reconcile(oldChildren[source]) {
+			childChanged = true
+		}
+	}
+
+	if len(movedChildren(sources)) != 0 {
+		childChanged = true
+	}
+
+	for index, och := range oldChildren {
+		if matched[index] {
+			continue
+		}
+
+		och.Remove()
+		e.AddChild(och)
+		childChanged = true
+	}
+
+	return childChanged
+}
+
+// matchKeyed returns the index of the old child each new child matches or -1
+// if it matches none. Children are matched by key, with children lacking keys
+// matched in order against the old children lacking keys, and only match
+// children of the same tag. Children sharing a key are matched in order, where
+// the first new child with the key matches the first old child with the key,
+// the second the second, and so on.
+func matchKeyed(newChildren, oldChildren []*Markup) []int {
+	keyed := make(map[string][]int)
+	var unkeyed []int
+
+	for index, och := range oldChildren {
+		if key := och.Key(); key != "" {
+			keyed[key] = append(keyed[key], index)
+			continue
+		}
+
+		unkeyed = append(unkeyed, index)
+	}
+
+	matched := make([]bool, len(oldChildren))
+	sources := make([]int, len(newChildren))
+
+	var nextUnkeyed int
+
+	for index, nch := range newChildren {
+		sources[index] = -1
+
+		var oldIndex int
+		var found bool
+
+		if key := nch.Key(); key != "" {
+			if indexes := keyed[key]; len(indexes) != 0 {
+				oldIndex, found = indexes[0], true
+				keyed[key] = indexes[1:]
+			}
+		} else if nextUnkeyed < len(unkeyed) {
+			oldIndex, found = unkeyed[nextUnkeyed], true
+			nextUnkeyed++
+		}
+
+		if !found || matched[oldIndex] || oldChildren[oldIndex].Name() != nch.Name() {
+			continue
+		}
+
+		matched[oldIndex] = true
+		sources[index] = oldIndex
+	}
+
+	return sources
+}
+
+// matchPositioned returns the index of the old child each new child matches
+// or -1 if it matches none. Children are matched by position and only match
+// children of the same tag.
+func matchPositioned(newChildren, oldChildren []*Markup) []int {
+	sources := make([]int, len(newChildren))
+
+	for index, nch := range newChildren {
+		sources[index] = -1
+
+		if index < len(oldChildren) && oldChildren[index].Name() == nch.Name() {
+			sources[index] = index
+		}
+	}
+
+	return sources
+}
+
+// hasKeys returns true/false if any of the provided markups has a key.
+func hasKeys(children []*Markup) bool {
+	for _, child := range children {
+		if child.Key() != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// movedChildren returns the indexes of the new children which moved, where
+// sources holds the index of the old child each new child was matched with
+// or -1 if it was not matched. The children which are part of the longest
+// increasing subsequence of old indexes stay in place, while others moved.
+func movedChildren(sources []int) []int {
+	var moved []int
+
+	stable := longestIncreasing(sources)
+
+	for index, source := range sources {
+		if source >= 0 && !stable[index] {
+			moved = append(moved, index)
+		}
+	}
+
+	return moved
+}
+
+// longestIncreasing returns a slice marking the indexes which form the longest
+// increasing subsequence of the non-negative values in the provided sequence.
+func longestIncreasing(sequence []int) []bool {
+	members := make([]bool, len(sequence))
+
+	// tails holds the index of the smallest tail value for each length of
+	// increasing subsequence found, and previous the index before each index
+	// within it's subsequence.
+	var tails []int
+	previous := make([]int, len(sequence))
+
+	for index, value := range sequence {
+		if value < 0 {
+			continue
+		}
+
+		low, high := 0, len(tails)
+		for low < high {
+			mid := (low + high) / 2
+			if sequence[tails[mid]] < value {
+				low = mid + 1
+			} else {
+				high = mid
+			}
+		}
+
+		previous[index] = -1
+		if low > 0 {
+			previous[index] = tails[low-1]
+		}
+
+		if low == len(tails) {
+			tails = append(tails, index)
+		} else {
+			tails[low] = index
+		}
+	}
+
+	if len(tails) == 0 {
+		return members
+	}
+
+	for index := tails[len(tails)-1]; index >= 0; index = previous[index] {
+		members[index] = true
+	}
+
+	return members
+}
@@ -0,0 +1,203 @@
+package trees_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// keyedList returns a ul markup with a keyed li for each provided key.
+func keyedList(keys ...string) *trees.Markup {
+	list := trees.NewMarkup("ul", false)
+
+	for _, key := range keys {
+		item := trees.NewMarkup("li", false)
+		item.SetKey(key)
+		trees.NewText("item %s", key).Apply(item)
+		item.Apply(list)
+	}
+
+	return list
+}
+
+// TestKeyedReconcile validates the matching of keyed children during
+// reconciliation.
+func TestKeyedReconcile(t *testing.T) {
+	old := keyedList("a", "b", "c", "d")
+
+	identity := make(map[string][2]string)
+	for _, child := range old.Children() {
+		identity[child.Key()] = [2]string{child.UID(), child.Hash()}
+	}
+
+	// Prepend "e", move "a" behind "c" and remove "d".
+	newList := keyedList("e", "b", "c", "a")
+
+	if !newList.Reconcile(old) {
+		t.Fatalf("\t%s\t  Should have reported changed children", failed)
+	}
+	t.Logf("\t%s\t  Should have reported changed children", success)
+
+	for _, child := range newList.Children() {
+		if child.Removed() {
+			continue
+		}
+
+		expected, ok := identity[child.Key()]
+		if !ok {
+			continue
+		}
+
+		if child.UID() != expected[0] || child.Hash() != expected[1] {
+			t.Fatalf("\t%s\t  Should have kept uid and hash of child %q: %q %q", failed, child.Key(), child.UID(), child.Hash())
+		}
+	}
+	t.Logf("\t%s\t  Should have kept uid and hash of keyed children", success)
+
+	var removed []string
+	for _, child := range newList.Children() {
+		if child.Removed() {
+			removed = append(removed, child.Key())
+		}
+	}
+
+	if len(removed) != 1 || removed[0] != "d" {
+		t.Fatalf("\t%s\t  Should have only removed child %q: %+q", failed, "d", removed)
+	}
+	t.Logf("\t%s\t  Should have only removed child %q", success, "d")
+
+	same := keyedList("a", "b")
+	sameOld := keyedList("a", "b")
+
+	if same.Reconcile(sameOld) {
+		t.Fatalf("\t%s\t  Should have reported no change for equal keyed children", failed)
+	}
+	t.Logf("\t%s\t  Should have reported no change for equal keyed children", success)
+
+	moved := keyedList("b", "a")
+	if !moved.Reconcile(keyedList("a", "b")) {
+		t.Fatalf("\t%s\t  Should have reported change for moved keyed children", failed)
+	}
+	t.Logf("\t%s\t  Should have reported change for moved keyed children", success)
+}
+
+// TestKeyedReconcileDuplicates validates children sharing a key are matched
+// in order.
+func TestKeyedReconcileDuplicates(t *testing.T) {
+	old := keyedList("a", "a", "b")
+	oldChildren := old.Children()
+
+	newList := keyedList("b", "a", "a")
+	newList.Reconcile(old)
+
+	newChildren := newList.Children()
+	for index, source := range []int{2, 0, 1} {
+		if newChildren[index].UID() != oldChildren[source].UID() {
+			t.Fatalf("\t%s\t  Should have matched child %d with old child %d", failed, index, source)
+		}
+	}
+	t.Logf("\t%s\t  Should have matched children sharing a key in order", success)
+
+	for _, child := range newList.Children() {
+		if child.Removed() {
+			t.Fatalf("\t%s\t  Should have removed no child: %q", failed, child.Key())
+		}
+	}
+	t.Logf("\t%s\t  Should have removed no child", success)
+
+	fewer := keyedList("a")
+	fewer.Reconcile(keyedList("a", "a"))
+
+	if children := fewer.Children(); len(children) != 2 || children[0].Removed() || !children[1].Removed() {
+		t.Fatalf("\t%s\t  Should have removed the last child sharing the key", failed)
+	}
+	t.Logf("\t%s\t  Should have removed the last child sharing the key", success)
+}
+
+// TestKeyedReconcileEvents validates moved keyed children keep the selectors
+// their events are bound with.
+func TestKeyedReconcileEvents(t *testing.T) {
+	withEvents := func(list *trees.Markup) *trees.Markup {
+		for _, child := range list.Children() {
+			trees.NewEvent("click", "", false, false, false, false).Apply(child)
+		}
+
+		return list
+	}
+
+	old := withEvents(keyedList("a", "b", "c"))
+
+	bound := make(map[string]trees.EventJSON)
+	for _, child := range old.Children() {
+		bound[child.Key()] = child.Events()[0].EventJSON()
+	}
+
+	newList := withEvents(keyedList("c", "a", "b"))
+	newList.Reconcile(old)
+
+	for _, child := range newList.Children() {
+		if event := child.Events()[0].EventJSON(); event != bound[child.Key()] {
+			t.Fatalf("\t%s\t  Should have kept the event of child %q: %+v", failed, child.Key(), event)
+		}
+	}
+	t.Logf("\t%s\t  Should have kept the events of moved keyed children", success)
+}
+
+// TestKeyAttribute validates the use of the key attribute as key.
+func TestKeyAttribute(t *testing.T) {
+	item := trees.NewMarkup("li", false)
+	trees.NewAttr("key", "first").Apply(item)
+
+	if item.Key() != "first" {
+		t.Fatalf("\t%s\t  Should have used key attribute as key: %q", failed, item.Key())
+	}
+	t.Logf("\t%s\t  Should have used key attribute as key", success)
+
+	item.SetKey("second")
+	if item.Key() != "second" {
+		t.Fatalf("\t%s\t  Should have used set key: %q", failed, item.Key())
+	}
+	t.Logf("\t%s\t  Should have used set key", success)
+}
+
+//==============================================================================
+
+// benchmarkList returns a ul markup with the provided total of li children,
+// keyed when requested, where the first item has the provided offset.
+func benchmarkList(total int, offset int, keyed bool) *trees.Markup {
+	list := trees.NewMarkup("ul", false)
+
+	for i := offset; i < total+offset; i++ {
+		item := trees.NewMarkup("li", false)
+		if keyed {
+			item.SetKey(fmt.Sprintf("%d", i))
+		}
+
+		trees.NewText("item %d", i).Apply(item)
+		item.Apply(list)
+	}
+
+	return list
+}
+
+func benchmarkReconcile(b *testing.B, keyed bool) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		old := benchmarkList(1000, 1, keyed)
+		newList := benchmarkList(1001, 0, keyed)
+		b.StartTimer()
+
+		newList.Reconcile(old)
+	}
+}
+
+func BenchmarkReconcilePrependKeyed(b *testing.B) {
+	benchmarkReconcile(b, true)
+}
+
+func BenchmarkReconcilePrependPositioned(b *testing.B) {
+	benchmarkReconcile(b, false)
+}
@@ -0,0 +1,357 @@
+package trees
+
+import "strings"
+
+// Policy defines the allow-lists used to sanitize untrusted markup, such as
+// rich text written by users. Elements not allowed are replaced with their
+// sanitized children, except those in DropElements which are removed with
+// their content. Attributes, url schemes and css properties not allowed are
+// removed, as are all events.
+type Policy struct {
+	// Elements contains the allowed elements.
+	Elements map[string]bool
+
+	// DropElements contains the elements removed along with their content
+	// when not allowed, such as scripts and styles.
+	DropElements map[string]bool
+
+	// Attrs contains the attributes allowed on all allowed elements.
+	Attrs map[string]bool
+
+	// ElementAttrs contains the attributes allowed on specific elements.
+	ElementAttrs map[string]map[string]bool
+
+	// URLSchemes contains the schemes allowed for urls within attributes
+	// such as href and src.
+	URLSchemes map[string]bool
+
+	// RelativeURLs sets if urls without a scheme are allowed.
+	RelativeURLs bool
+
+	// Styles contains the css properties allowed within styles.
+	Styles map[string]bool
+
+	// Comments sets if comments are kept.
+	Comments bool
+
+	// LinkRel sets the rel attribute of links with a href when not empty,
+	// such as "nofollow ugc noopener".
+	LinkRel string
+}
+
+// NewPolicy returns a new Policy which allows no elements, attributes or
+// styles, keeping only text, and which drops the content of elements such
+// as scripts, styles and frames.
+func NewPolicy() *Policy {
+	return &Policy{
+		Elements:     make(map[string]bool),
+		DropElements: toSet("script", "style", "iframe", "frame", "frameset", "object", "embed", "applet", "noscript", "noembed", "template", "title", "head", "textarea", "select", "svg", "math", "xmp", "plaintext"),
+		Attrs:        make(map[string]bool),
+		ElementAttrs: make(map[string]map[string]bool),
+		URLSchemes:   make(map[string]bool),
+		Styles:       make(map[string]bool),
+	}
+}
+
+// StrictTextPolicy returns a policy which removes all elements, keeping only
+// their text.
+func StrictTextPolicy() *Policy {
+	return NewPolicy()
+}
+
+// BasicFormattingPolicy returns a policy which allows inline formatting,
+// paragraphs, quotes and code without links, images or attributes.
+func BasicFormattingPolicy() *Policy {
+	return NewPolicy().
+		AllowElements("b", "strong", "i", "em", "u", "s", "strike", "del", "ins", "sub", "sup", "small", "mark", "br", "p", "span", "code", "pre", "blockquote", "q", "abbr", "kbd", "samp", "var", "cite", "dfn").
+		AllowAttrsOn("abbr", "title")
+}
+
+// UGCPolicy returns a policy for user generated content, which adds links,
+// images, lists, headings, tables and a few text styles to the
+// BasicFormattingPolicy. Links are given rel="nofollow ugc noopener".
+func UGCPolicy() *Policy {
+	return BasicFormattingPolicy().
+		AllowElements("a", "img", "ul", "ol", "li", "dl", "dt", "dd", "h1", "h2", "h3", "h4", "h5", "h6", "hr", "div", "table", "caption", "thead", "tbody", "tfoot", "tr", "td", "th", "figure", "figcaption", "time", "details", "summary").
+		AllowAttrs("title", "lang", "dir").
+		AllowAttrsOn("a", "href").
+		AllowAttrsOn("img", "src", "alt", "width", "height").
+		AllowAttrsOn("td", "colspan", "rowspan").
+		AllowAttrsOn("th", "colspan", "rowspan", "scope").
+		AllowAttrsOn("ol", "start", "reversed").
+		AllowAttrsOn("time", "datetime").
+		AllowStyles("color", "background-color", "text-align", "font-weight", "font-style", "text-decoration").
+		AllowURLSchemes("http", "https", "mailto").
+		AllowRelativeURLs().
+		RequireLinkRel("nofollow ugc noopener")
+}
+
+// AllowElements adds the elements to the allowed elements.
+func (p *Policy) AllowElements(tags ...string) *Policy {
+	for _, tag := range tags {
+		p.Elements[strings.ToLower(tag)] = true
+	}
+
+	return p
+}
+
+// AllowAttrs adds the attributes to those allowed on all allowed elements.
+func (p *Policy) AllowAttrs(attrs ...string) *Policy {
+	for _, attr := range attrs {
+		p.Attrs[strings.ToLower(attr)] = true
+	}
+
+	return p
+}
+
+// AllowAttrsOn adds the attributes to those allowed on the element.
+func (p *Policy) AllowAttrsOn(tag string, attrs ...string) *Policy {
+	tag = strings.ToLower(tag)
+
+	allowed, ok := p.ElementAttrs[tag]
+	if !ok {
+		allowed = make(map[string]bool)
+		p.ElementAttrs[tag] = allowed
+	}
+
+	for _, attr := range attrs {
+		allowed[strings.ToLower(attr)] = true
+	}
+
+	return p
+}
+
+// AllowURLSchemes adds the schemes to those allowed for urls.
+func (p *Policy) AllowURLSchemes(schemes ...string) *Policy {
+	for _, scheme := range schemes {
+		p.URLSchemes[strings.ToLower(scheme)] = true
+	}
+
+	return p
+}
+
+// AllowRelativeURLs allows urls without a scheme.
+func (p *Policy) AllowRelativeURLs() *Policy {
+	p.RelativeURLs = true
+	return p
+}
+
+// AllowStyles adds the css properties to those allowed within styles and
+// allows the style attribute.
+func (p *Policy) AllowStyles(properties ...string) *Policy {
+	for _, property := range properties {
+		p.Styles[strings.ToLower(property)] = true
+	}
+
+	return p
+}
+
+// AllowComments keeps comments within the markup.
+func (p *Policy) AllowComments() *Policy {
+	p.Comments = true
+	return p
+}
+
+// RequireLinkRel sets the rel attribute given to links with a href.
+func (p *Policy) RequireLinkRel(rel string) *Policy {
+	p.LinkRel = rel
+	return p
+}
+
+//==============================================================================
+
+// SanitizeHTML parses the markup and returns it sanitized.
+func (p *Policy) SanitizeHTML(markup string) []*Markup {
+	return p.SanitizeTree(ParseTree(markup))
+}
+
+// SanitizeTree returns the sanitized markups of the provided list, where
+// markups not allowed are replaced with their sanitized children or dropped.
+func (p *Policy) SanitizeTree(tree []*Markup) []*Markup {
+	var sanitized []*Markup
+	for _, item := range tree {
+		for _, node := range p.sanitize(item) {
+			node.parent = nil
+			sanitized = append(sanitized, node)
+		}
+	}
+
+	return sanitized
+}
+
+// Sanitize sanitizes the children, attributes and styles of the root in
+// place, keeping the root itself whatever it's tag, and returns the root.
+func (p *Policy) Sanitize(root *Markup) *Markup {
+	p.sanitizeElement(root)
+	return root
+}
+
+// sanitize returns the markups which replace the provided markup.
+func (p *Policy) sanitize(e *Markup) []*Markup {
+	if e.IsRawHTML() {
+		return nil
+	}
+
+	if e.tagname == "text" {
+		if e.IsDoctype() || (e.IsComment() && !p.Comments) {
+			return nil
+		}
+
+		return []*Markup{e}
+	}
+
+	tag := strings.ToLower(e.tagname)
+
+	if !p.Elements[tag] {
+		if p.DropElements[tag] {
+			return nil
+		}
+
+		var children []*Markup
+		for _, child := range e.children {
+			children = append(children, p.sanitize(child)...)
+		}
+
+		return children
+	}
+
+	p.sanitizeElement(e)
+	return []*Markup{e}
+}
+
+// sanitizeElement removes the events, attributes and styles of the element
+// which are not allowed and sanitizes it's children. The internal data-gen and
+// NodeRemoved attributes are dropped with those of the input and added back
+// from the state of the element, as the input may hold them too.
+func (p *Policy) sanitizeElement(e *Markup) {
+	tag := strings.ToLower(e.tagname)
+
+	e.events = nil
+
+	attrs := []Property{NewAttr("data-gen", "gu")}
+	for _, attr := range e.attrs {
+		name, value := attr.Render()
+		lower := strings.ToLower(name)
+
+		switch {
+		case lower == "data-gen" || lower == "noderemoved":
+			continue
+
+		case lower == "style":
+			if style := p.sanitizeStyle(value); style != "" {
+				attrs = append(attrs, &Attribute{Name: "style", Value: style})
+			}
+
+		case strings.HasPrefix(lower, "on"):
+			continue
+
+		case !p.Attrs[lower] && !p.ElementAttrs[tag][lower]:
+			continue
+
+		case urlAttrs[lower] && !p.allowedURL(value):
+			continue
+
+		default:
+			attrs = append(attrs, attr)
+		}
+	}
+
+	if e.removed {
+		attrs = append(attrs, &Attribute{Name: "NodeRemoved", Value: ""})
+	}
+
+	e.attrs = attrs
+
+	if p.LinkRel != "" && tag == "a" {
+		if _, err := GetAttr(e, "href"); err == nil {
+			e.attrs = append(removeAttr(e.attrs, "rel"), &Attribute{Name: "rel", Value: p.LinkRel})
+		}
+	}
+
+	var styles []Property
+	for _, style := range e.styles {
+		name, value := style.Render()
+		if p.Styles[strings.ToLower(name)] && FilterStyleValue(value) != UnsafeStyle {
+			styles = append(styles, style)
+		}
+	}
+
+	e.styles = styles
+
+	var children []*Markup
+	for _, child := range e.children {
+		for _, node := range p.sanitize(child) {
+			node.parent = e
+			children = append(children, node)
+		}
+	}
+
+	e.children = children
+}
+
+// sanitizeStyle returns the declarations of the style attribute which are
+// allowed.
+func (p *Policy) sanitizeStyle(style string) string {
+	var declarations []string
+	for _, declaration := range strings.Split(style, ";") {
+		parts := strings.SplitN(declaration, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+		value := strings.TrimSpace(parts[1])
+
+		if !p.Styles[name] || value == "" || FilterStyle(value) == UnsafeStyle || strings.Contains(strings.ToLower(value), "url(") {
+			continue
+		}
+
+		declarations = append(declarations, name+": "+value)
+	}
+
+	if len(declarations) == 0 {
+		return ""
+	}
+
+	return strings.Join(declarations, "; ") + ";"
+}
+
+// allowedURL returns true/false if the url is relative and relative urls are
+// allowed or it's scheme is allowed.
+func (p *Policy) allowedURL(url string) bool {
+	trimmed := strings.TrimFunc(url, func(char rune) bool { return char <= ' ' })
+
+	colon := strings.IndexByte(trimmed, ':')
+	if strings.HasPrefix(trimmed, "//") {
+		return p.URLSchemes["http"] || p.URLSchemes["https"]
+	}
+
+	if colon < 0 || strings.ContainsAny(trimmed[:colon], "/?#") {
+		return p.RelativeURLs
+	}
+
+	scheme := strings.ToLower(trimmed[:colon])
+	return p.URLSchemes[scheme] && FilterURL(trimmed) != UnsafeURL
+}
+
+// removeAttr returns the attributes without those of the provided name.
+func removeAttr(attrs []Property, name string) []Property {
+	var kept []Property
+	for _, attr := range attrs {
+		if attrName, _ := attr.Render(); !strings.EqualFold(attrName, name) {
+			kept = append(kept, attr)
+		}
+	}
+
+	return kept
+}
+
+// toSet returns a set of the provided items.
+func toSet(items ...string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		set[item] = true
+	}
+
+	return set
+}
@@ -0,0 +1,183 @@
+package trees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// sanitized returns the compact markup of the sanitized html without the
+// data-gen attributes.
+func sanitized(policy *trees.Policy, markup string) string {
+	var out []string
+	for _, item := range policy.SanitizeHTML(markup) {
+		out = append(out, trees.CompactPrinter.Print(item))
+	}
+
+	return strings.Replace(strings.Join(out, ""), ` data-gen="gu"`, "", -1)
+}
+
+// xssVectors contains markup which attempts to run scripts.
+var xssVectors = []string{
+	`<script>alert(1)</script>`,
+	`<SCRIPT SRC=http://evil.example/xss.js></SCRIPT>`,
+	`<img src=x onerror=alert(1)>`,
+	`<img src="javascript:alert(1)">`,
+	`<img src=" jav&#x09;ascript:alert(1)">`,
+	`<img src="JaVaScRiPt:alert(1)">`,
+	`<a href="javascript:alert(1)">x</a>`,
+	`<a href="&#106;&#97;&#118;&#97;&#115;&#99;&#114;&#105;&#112;&#116;&#58;alert(1)">x</a>`,
+	`<a href="vbscript:msgbox(1)">x</a>`,
+	`<a href="data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==">x</a>`,
+	`<a href=" javascript:alert(1)">x</a>`,
+	`<body onload=alert(1)>`,
+	`<svg onload=alert(1)><script>alert(1)</script></svg>`,
+	`<math><mtext><script>alert(1)</script></mtext></math>`,
+	`<iframe src="javascript:alert(1)"></iframe>`,
+	`<object data="javascript:alert(1)"></object>`,
+	`<embed src="javascript:alert(1)">`,
+	`<div style="background:url(javascript:alert(1))">x</div>`,
+	`<div style="width: expression(alert(1))">x</div>`,
+	`<p style="color: red; behavior: url(xss.htc)">x</p>`,
+	`<style>body{background:url(javascript:alert(1))}</style>`,
+	`<link rel="stylesheet" href="javascript:alert(1)">`,
+	`<meta http-equiv="refresh" content="0;url=javascript:alert(1)">`,
+	`<form action="javascript:alert(1)"><button>x</button></form>`,
+	`<button formaction="javascript:alert(1)">x</button>`,
+	`<input onfocus=alert(1) autofocus>`,
+	`<details open ontoggle=alert(1)>`,
+	`<a href="#" onclick="alert(1)">x</a>`,
+	`<p onmouseover="alert(1)">x</p>`,
+	`<table background="javascript:alert(1)"><tr><td>x</td></tr></table>`,
+	`<!--<script>alert(1)</script>-->`,
+	`<noscript><p title="</noscript><img src=x onerror=alert(1)>"></noscript>`,
+	`<textarea><script>alert(1)</script></textarea>`,
+	`<template><script>alert(1)</script></template>`,
+	`<base href="javascript:alert(1)//">`,
+	`<scr<script>ipt>alert(1)</script>`,
+	`<img src="x" alt="" "onerror="alert(1)">`,
+	`<video><source onerror="alert(1)"></video>`,
+	`<a href="java` + "\x00" + `script:alert(1)">x</a>`,
+}
+
+// TestSanitizeVectors validates no policy lets scripts through.
+func TestSanitizeVectors(t *testing.T) {
+	policies := map[string]func() *trees.Policy{
+		"strict":     trees.StrictTextPolicy,
+		"formatting": trees.BasicFormattingPolicy,
+		"ugc":        trees.UGCPolicy,
+	}
+
+	for name, policy := range policies {
+		for _, vector := range xssVectors {
+			output := sanitized(policy(), vector)
+
+			parsed := trees.ParseTree(output)
+
+			var unsafe []string
+			for _, item := range parsed {
+				trees.Walk(item, func(e *trees.Markup) trees.WalkAction {
+					switch e.Name() {
+					case "script", "style", "iframe", "object", "embed", "svg", "math", "link", "meta", "base", "form", "button", "input", "textarea":
+						unsafe = append(unsafe, "<"+e.Name()+">")
+					}
+
+					for _, attr := range e.Attributes() {
+						attrName, value := attr.Render()
+						lower := strings.ToLower(strings.Map(func(char rune) rune {
+							if char <= ' ' {
+								return -1
+							}
+							return char
+						}, value))
+
+						if strings.HasPrefix(attrName, "on") || strings.Contains(lower, "script:") || strings.HasPrefix(lower, "data:") || strings.Contains(lower, "expression(") || strings.HasPrefix(lower, "//") {
+							unsafe = append(unsafe, attrName+"="+value)
+						}
+					}
+
+					return trees.WalkContinue
+				})
+			}
+
+			if len(unsafe) != 0 {
+				t.Fatalf("\t%s\t  Should have sanitized %q with %s policy: %q\n%s", failed, vector, name, unsafe, output)
+			}
+		}
+	}
+	t.Logf("\t%s\t  Should have sanitized all script vectors with all policies", success)
+}
+
+// TestSanitizePolicies validates the output of the default policies.
+func TestSanitizePolicies(t *testing.T) {
+	tests := []struct {
+		policy   func() *trees.Policy
+		markup   string
+		expected string
+	}{
+		{trees.StrictTextPolicy, `<p>Hello <b>World</b></p>`, `Hello World`},
+		{trees.StrictTextPolicy, `Fish & <i>Chips</i><script>x()</script>`, `Fish &amp; Chips`},
+		{trees.StrictTextPolicy, `<!-- note -->text`, `text`},
+		{trees.BasicFormattingPolicy, `<p class="x" id="y">Hello <b>World</b></p>`, `<p>Hello <b>World</b></p>`},
+		{trees.BasicFormattingPolicy, `<a href="/x">link</a>, <abbr title="Hyper">H</abbr>`, `link, <abbr title="Hyper">H</abbr>`},
+		{trees.BasicFormattingPolicy, `<div><em>x</em></div>`, `<em>x</em>`},
+		{trees.BasicFormattingPolicy, `<p style="color: red">x</p>`, `<p>x</p>`},
+		{trees.UGCPolicy, `<a href="https://example.com" rel="opener" target="_blank">x</a>`, `<a href="https://example.com" rel="nofollow ugc noopener">x</a>`},
+		{trees.UGCPolicy, `<a href="/docs?a=1&amp;b=2">x</a>`, `<a href="/docs?a=1&amp;b=2" rel="nofollow ugc noopener">x</a>`},
+		{trees.UGCPolicy, `<a href="mailto:me@example.com">x</a>`, `<a href="mailto:me@example.com" rel="nofollow ugc noopener">x</a>`},
+		{trees.UGCPolicy, `<a name="x">x</a>`, `<a>x</a>`},
+		{trees.UGCPolicy, `<img src="/cat.png" alt="Cat" onload="x()" class="big">`, `<img src="/cat.png" alt="Cat"/>`},
+		{trees.UGCPolicy, `<p style="color: red; position: fixed; background-color: url(x.png)">x</p>`, `<p style="color: red;">x</p>`},
+		{trees.UGCPolicy, `<ul><li>One<li>Two</ul>`, `<ul><li>One</li><li>Two</li></ul>`},
+		{trees.UGCPolicy, `<table><tr><td colspan="2" onclick="x()">x</td></tr></table>`, `<table><tr><td colspan="2">x</td></tr></table>`},
+		{trees.UGCPolicy, `<h1 lang="en" dir="ltr" title="t">x</h1>`, `<h1 lang="en" dir="ltr" title="t">x</h1>`},
+		{trees.UGCPolicy, `<custom-tag><span>x</span></custom-tag>`, `<span>x</span>`},
+		{func() *trees.Policy { return trees.BasicFormattingPolicy().AllowComments() }, `<!-- note --><b>x</b>`, `<!-- note --><b>x</b>`},
+		{func() *trees.Policy {
+			return trees.NewPolicy().AllowElements("a").AllowAttrsOn("a", "href").AllowURLSchemes("https")
+		}, `<a href="/x">a</a><a href="https://x">b</a><a href="http://x">c</a>`, `<a>a</a><a href="https://x">b</a><a>c</a>`},
+		{func() *trees.Policy { return trees.NewPolicy().AllowElements("span").AllowAttrs("class") }, `<span class="a" id="b">x</span>`, `<span class="a">x</span>`},
+	}
+
+	for _, test := range tests {
+		if output := sanitized(test.policy(), test.markup); output != test.expected {
+			t.Fatalf("\t%s\t  Should have sanitized %q into %q: %q", failed, test.markup, test.expected, output)
+		}
+	}
+	t.Logf("\t%s\t  Should have sanitized markup with policies", success)
+}
+
+// TestSanitizeMarkup validates sanitizing built trees in place.
+func TestSanitizeMarkup(t *testing.T) {
+	root := trees.NewMarkup("section", false)
+	trees.NewAttr("onclick", "x()").Apply(root)
+
+	link := trees.NewMarkup("a", false)
+	trees.NewAttr("href", "javascript:alert(1)").Apply(link)
+	trees.NewCSSStyle("color", "red").Apply(link)
+	trees.NewCSSStyle("position", "fixed").Apply(link)
+	trees.NewEvent("click", "", false, false, false, false).Apply(link)
+	trees.UnsafeRawHTML("<script>x()</script>").Apply(link)
+	trees.NewText("text").Apply(link)
+	link.Apply(root)
+
+	trees.UGCPolicy().Sanitize(root)
+
+	if output := trees.CompactPrinter.Print(root); output != `<section data-gen="gu"><a data-gen="gu" style="color: red;">text</a></section>` {
+		t.Fatalf("\t%s\t  Should have sanitized markup in place: %s", failed, output)
+	}
+
+	if len(link.Events()) != 0 || link.Parent() != root {
+		t.Fatalf("\t%s\t  Should have removed events and kept parents", failed)
+	}
+	t.Logf("\t%s\t  Should have sanitized markup in place", success)
+
+	parsed := trees.ParseTree(`<p data-gen="evil" NodeRemoved="">x</p>`)[0]
+	trees.UGCPolicy().Sanitize(parsed)
+
+	if output := trees.CompactPrinter.Print(parsed); output != `<p data-gen="gu">x</p>` || parsed.Removed() {
+		t.Fatalf("\t%s\t  Should have dropped internal attributes of the input: %s", failed, output)
+	}
+	t.Logf("\t%s\t  Should have dropped internal attributes of the input", success)
+}
@@ -0,0 +1,1183 @@
+package trees
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SelectorError defines the error returned when a selector fails to parse,
+// holding the selector and the position of the failure.
+type SelectorError struct {
+	Selector string
+	Pos      int
+	Reason   string
+}
+
+// Error returns the error message of the SelectorError.
+func (s *SelectorError) Error() string {
+	return fmt.Sprintf("Invalid selector %q at %d: %s", s.Selector, s.Pos, s.Reason)
+}
+
+// CompiledSelector defines a parsed selector list which can be matched against
+// markups repeatedly without parsing it again. It supports type, universal,
+// id, class and attribute selectors, the descendant, child (>), adjacent
+// sibling (+) and general sibling (~) combinators and the structural, logical
+// and relational pseudo-classes, matching the same elements the browser does.
+type CompiledSelector struct {
+	source string
+	list   []*complexSelector
+}
+
+// String returns the selector the CompiledSelector was compiled from.
+func (c *CompiledSelector) String() string {
+	return c.source
+}
+
+// Match returns true/false if the markup matches the selector.
+func (c *CompiledSelector) Match(target *Markup) bool {
+	return isElement(target) && matchList(c.list, target, nil)
+}
+
+// Query returns the first element within the root matching the selector, where
+// the root itself is not matched but is the element :scope refers to.
+func (c *CompiledSelector) Query(root *Markup) *Markup {
+	var found *Markup
+
+	eachDescendant(root, func(target *Markup) bool {
+		if matchList(c.list, target, root) {
+			found = target
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// QueryAll returns all elements within the root matching the selector in
+// document order, where the root itself is not matched but is the element
+// :scope refers to.
+func (c *CompiledSelector) QueryAll(root *Markup) []*Markup {
+	var found []*Markup
+
+	eachDescendant(root, func(target *Markup) bool {
+		if matchList(c.list, target, root) {
+			found = append(found, target)
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// Specificity returns the specificity of the selector, being that of it's
+// most specific selector for selector lists.
+func (c *CompiledSelector) Specificity() Specificity {
+	return listSpecificity(c.list)
+}
+
+// Specificity defines the specificity of a selector, holding the count of
+// it's id selectors, of it's class, attribute and pseudo-class selectors and
+// of it's type selectors and pseudo-elements.
+type Specificity [3]int
+
+// Less returns true/false if the specificity is lower than the other.
+func (s Specificity) Less(other Specificity) bool {
+	for index := range s {
+		if s[index] != other[index] {
+			return s[index] < other[index]
+		}
+	}
+
+	return false
+}
+
+// add returns the sum of the specificities.
+func (s Specificity) add(other Specificity) Specificity {
+	return Specificity{s[0] + other[0], s[1] + other[1], s[2] + other[2]}
+}
+
+// Compile returns the CompiledSelector for the provided selector or a
+// *SelectorError if the selector is invalid.
+func (q queryCtrl) Compile(sel string) (*CompiledSelector, error) {
+	parser := selectorParser{source: sel}
+
+	list, err := parser.parseList(false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !parser.done() {
+		return nil, parser.fail("unexpected %q", parser.peek())
+	}
+
+	return &CompiledSelector{source: sel, list: list}, nil
+}
+
+// Matches returns true/false if the markup matches the selector, returning
+// false for invalid selectors.
+func (q queryCtrl) Matches(target *Markup, sel string) bool {
+	compiled, err := q.Compile(sel)
+	if err != nil {
+		return false
+	}
+
+	return compiled.Match(target)
+}
+
+//==============================================================================
+
+// contains the combinators joining compound selectors.
+const (
+	descendantCombinator = ' '
+	childCombinator      = '>'
+	adjacentCombinator   = '+'
+	siblingCombinator    = '~'
+)
+
+// complexSelector defines a list of compound selectors joined by combinators,
+// where combinators[i] joins parts[i] and parts[i+1].
+type complexSelector struct {
+	parts       []*compoundSelector
+	combinators []byte
+}
+
+// compoundSelector defines the simple selectors which must all match a
+// element. Anchored compounds only match the element a relative selector
+// is anchored to.
+type compoundSelector struct {
+	tag      string
+	ids      []string
+	classes  []string
+	attrs    []attrSelector
+	pseudos  []pseudoSelector
+	anchored bool
+	element  bool
+}
+
+// attrSelector defines a attribute selector, where fold marks case
+// insensitive matching of the value.
+type attrSelector struct {
+	name  string
+	op    string
+	value string
+	fold  bool
+}
+
+// pseudoSelector defines a pseudo-class, where a and b hold the An+B
+// arguments of the nth pseudo-classes and list the selector argument.
+type pseudoSelector struct {
+	name string
+	a, b int
+	list []*complexSelector
+}
+
+// listSpecificity returns the specificity of the most specific selector of
+// the list.
+func listSpecificity(list []*complexSelector) Specificity {
+	var highest Specificity
+
+	for _, complex := range list {
+		var specificity Specificity
+		for _, part := range complex.parts {
+			specificity = specificity.add(compoundSpecificity(part))
+		}
+
+		if highest.Less(specificity) {
+			highest = specificity
+		}
+	}
+
+	return highest
+}
+
+// compoundSpecificity returns the specificity of the compound, where :where
+// adds nothing, :not, :is, :matches and :has add their most specific argument
+// and the nth pseudo-classes with a selector argument add it to their own.
+func compoundSpecificity(compound *compoundSelector) Specificity {
+	specificity := Specificity{len(compound.ids), len(compound.classes) + len(compound.attrs), 0}
+
+	if compound.tag != "" && compound.tag != "*" {
+		specificity[2]++
+	}
+
+	if compound.element {
+		specificity[2]++
+	}
+
+	for _, pseudo := range compound.pseudos {
+		switch pseudo.name {
+		case "where":
+		case "not", "is", "matches", "has":
+			specificity = specificity.add(listSpecificity(pseudo.list))
+		default:
+			specificity[1]++
+			specificity = specificity.add(listSpecificity(pseudo.list))
+		}
+	}
+
+	return specificity
+}
+
+// matchList returns true/false if the target matches any of the selectors.
+func matchList(list []*complexSelector, target, scope *Markup) bool {
+	for _, complex := range list {
+		if matchComplex(complex, len(complex.parts)-1, target, scope) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchComplex returns true/false if the target matches the complex selector
+// up to the compound at the provided index, matching from right to left.
+func matchComplex(complex *complexSelector, index int, target, scope *Markup) bool {
+	if !matchCompound(complex.parts[index], target, scope) {
+		return false
+	}
+
+	if index == 0 {
+		return true
+	}
+
+	switch complex.combinators[index-1] {
+	case childCombinator:
+		return target.parent != nil && matchComplex(complex, index-1, target.parent, scope)
+
+	case adjacentCombinator:
+		prev := previousElement(target)
+		return prev != nil && matchComplex(complex, index-1, prev, scope)
+
+	case siblingCombinator:
+		for prev := previousElement(target); prev != nil; prev = previousElement(prev) {
+			if matchComplex(complex, index-1, prev, scope) {
+				return true
+			}
+		}
+
+	default:
+		for parent := target.parent; parent != nil; parent = parent.parent {
+			if matchComplex(complex, index-1, parent, scope) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchCompound returns true/false if the target matches all the simple
+// selectors of the compound.
+func matchCompound(compound *compoundSelector, target, scope *Markup) bool {
+	if compound.element {
+		return false
+	}
+
+	if compound.anchored && target != scope {
+		return false
+	}
+
+	if compound.tag != "" && compound.tag != "*" && !strings.EqualFold(compound.tag, target.tagname) {
+		return false
+	}
+
+	for _, id := range compound.ids {
+		if value, ok := attrValue(target, "id"); !ok || value != id {
+			return false
+		}
+	}
+
+	if len(compound.classes) != 0 {
+		value, _ := attrValue(target, "class")
+		classes := strings.Fields(value)
+
+		for _, class := range compound.classes {
+			if !containsString(classes, class) {
+				return false
+			}
+		}
+	}
+
+	for _, attr := range compound.attrs {
+		if !matchAttr(attr, target) {
+			return false
+		}
+	}
+
+	for _, pseudo := range compound.pseudos {
+		if !matchPseudo(pseudo, target, scope) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchAttr returns true/false if the target matches the attribute selector.
+func matchAttr(attr attrSelector, target *Markup) bool {
+	value, ok := attrValue(target, attr.name)
+	if !ok {
+		return false
+	}
+
+	if attr.op == "" {
+		return true
+	}
+
+	expected := attr.value
+	if attr.fold {
+		value = strings.ToLower(value)
+		expected = strings.ToLower(expected)
+	}
+
+	switch attr.op {
+	case exactMatch:
+		return value == expected
+
+	case exactWordInListMatch:
+		return expected != "" && !strings.ContainsAny(expected, " \t\n\r\f") && containsString(strings.Fields(value), expected)
+
+	case beginOrExactlyMatch:
+		return value == expected || strings.HasPrefix(value, expected+"-")
+
+	case prefixMatch:
+		return expected != "" && strings.HasPrefix(value, expected)
+
+	case suffixMatch:
+		return expected != "" && strings.HasSuffix(value, expected)
+
+	case containsMatch:
+		return expected != "" && strings.Contains(value, expected)
+	}
+
+	return false
+}
+
+// matchPseudo returns true/false if the target matches the pseudo-class.
+func matchPseudo(pseudo pseudoSelector, target, scope *Markup) bool {
+	switch pseudo.name {
+	case "first-child":
+		return previousElement(target) == nil
+
+	case "last-child":
+		return nextElement(target) == nil
+
+	case "only-child":
+		return previousElement(target) == nil && nextElement(target) == nil
+
+	case "first-of-type":
+		return typePosition(target, false) == 1
+
+	case "last-of-type":
+		return typePosition(target, true) == 1
+
+	case "only-of-type":
+		return typePosition(target, false) == 1 && typePosition(target, true) == 1
+
+	case "nth-child", "nth-last-child":
+		if pseudo.list != nil && !matchList(pseudo.list, target, scope) {
+			return false
+		}
+
+		position := 1
+		step := previousElement
+		if pseudo.name == "nth-last-child" {
+			step = nextElement
+		}
+
+		for sibling := step(target); sibling != nil; sibling = step(sibling) {
+			if pseudo.list == nil || matchList(pseudo.list, sibling, scope) {
+				position++
+			}
+		}
+
+		return matchNth(pseudo.a, pseudo.b, position)
+
+	case "nth-of-type":
+		return matchNth(pseudo.a, pseudo.b, typePosition(target, false))
+
+	case "nth-last-of-type":
+		return matchNth(pseudo.a, pseudo.b, typePosition(target, true))
+
+	case "not":
+		return !matchList(pseudo.list, target, scope)
+
+	case "is", "where", "matches":
+		return matchList(pseudo.list, target, scope)
+
+	case "has":
+		return matchHas(pseudo.list, target)
+
+	case "empty":
+		if target.TextContent() != "" {
+			return false
+		}
+
+		for _, child := range target.children {
+			if child.removed {
+				continue
+			}
+
+			if !child.IsComment() {
+				return false
+			}
+		}
+
+		return true
+
+	case "root":
+		return target.parent == nil
+
+	case "scope":
+		if scope == nil {
+			return target.parent == nil
+		}
+
+		return target == scope
+
+	case "checked":
+		_, checked := attrValue(target, "checked")
+		_, selected := attrValue(target, "selected")
+		return checked || selected
+
+	case "disabled":
+		_, disabled := attrValue(target, "disabled")
+		return disabled && isFormElement(target)
+
+	case "enabled":
+		_, disabled := attrValue(target, "disabled")
+		return !disabled && isFormElement(target)
+	}
+
+	return false
+}
+
+// matchHas returns true/false if any element relative to the target matches
+// any of the relative selectors.
+func matchHas(list []*complexSelector, target *Markup) bool {
+	for _, complex := range list {
+		var found bool
+
+		check := func(candidate *Markup) bool {
+			found = matchComplex(complex, len(complex.parts)-1, candidate, target)
+			return !found
+		}
+
+		switch complex.combinators[0] {
+		case adjacentCombinator, siblingCombinator:
+			for next := nextElement(target); next != nil && !found; next = nextElement(next) {
+				if check(next) {
+					eachDescendant(next, check)
+				}
+			}
+		default:
+			eachDescendant(target, check)
+		}
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchNth returns true/false if the position is a An+B for some n >= 0.
+func matchNth(a, b, position int) bool {
+	if a == 0 {
+		return position == b
+	}
+
+	diff := position - b
+	return diff%a == 0 && diff/a >= 0
+}
+
+// typePosition returns the position of the target among the siblings of the
+// same type, counting from the last sibling if fromEnd is true.
+func typePosition(target *Markup, fromEnd bool) int {
+	position := 1
+	step := previousElement
+	if fromEnd {
+		step = nextElement
+	}
+
+	for sibling := step(target); sibling != nil; sibling = step(sibling) {
+		if strings.EqualFold(sibling.tagname, target.tagname) {
+			position++
+		}
+	}
+
+	return position
+}
+
+// previousElement returns the element before the target within it's parent.
+func previousElement(target *Markup) *Markup {
+	if target.parent == nil {
+		return nil
+	}
+
+	var prev *Markup
+	for _, child := range target.parent.children {
+		if child == target {
+			return prev
+		}
+
+		if isElement(child) {
+			prev = child
+		}
+	}
+
+	return nil
+}
+
+// nextElement returns the element after the target within it's parent.
+func nextElement(target *Markup) *Markup {
+	if target.parent == nil {
+		return nil
+	}
+
+	var seen bool
+	for _, child := range target.parent.children {
+		if child == target {
+			seen = true
+			continue
+		}
+
+		if seen && isElement(child) {
+			return child
+		}
+	}
+
+	return nil
+}
+
+// eachDescendant calls the function with each element within the root in
+// document order, stopping once the function returns false. It returns false
+// if it was stopped.
+func eachDescendant(root *Markup, fn func(*Markup) bool) bool {
+	for _, child := range root.children {
+		if !isElement(child) {
+			continue
+		}
+
+		if !fn(child) || !eachDescendant(child, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isElement returns true/false if the markup is a element which is not marked
+// as removed.
+func isElement(target *Markup) bool {
+	return target != nil && target.tagname != "text" && target.tagname != RawHTMLTag && !target.removed
+}
+
+// isFormElement returns true/false if the markup can be disabled.
+func isFormElement(target *Markup) bool {
+	switch strings.ToLower(target.tagname) {
+	case "button", "input", "select", "textarea", "option", "optgroup", "fieldset":
+		return true
+	}
+
+	return false
+}
+
+// attrValue returns the value of the attribute of the markup, where attribute
+// names are matched case insensitively.
+func attrValue(target *Markup, name string) (string, bool) {
+	for _, attr := range target.attrs {
+		if attrName, value := attr.Render(); strings.EqualFold(attrName, name) {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// containsString returns true/false if the list contains the item.
+func containsString(list []string, item string) bool {
+	for _, value := range list {
+		if value == item {
+			return true
+		}
+	}
+
+	return false
+}
+
+//==============================================================================
+
+// selectorParser defines a parser for selector lists.
+type selectorParser struct {
+	source string
+	pos    int
+}
+
+// parseList parses a comma separated list of complex selectors until the end
+// of the source or a closing parenthesis. Relative lists allow each selector
+// to start with a combinator, as in :has().
+func (p *selectorParser) parseList(relative bool) ([]*complexSelector, error) {
+	var list []*complexSelector
+
+	for {
+		complex, err := p.parseComplex(relative)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, complex)
+
+		p.skipSpace()
+		if p.peek() != ',' {
+			return list, nil
+		}
+
+		p.pos++
+	}
+}
+
+// parseComplex parses a complex selector.
+func (p *selectorParser) parseComplex(relative bool) (*complexSelector, error) {
+	var complex complexSelector
+
+	p.skipSpace()
+
+	if relative {
+		combinator := byte(descendantCombinator)
+		switch p.peek() {
+		case childCombinator, adjacentCombinator, siblingCombinator:
+			combinator = byte(p.peek())
+			p.pos++
+			p.skipSpace()
+		}
+
+		complex.parts = append(complex.parts, &compoundSelector{anchored: true})
+		complex.combinators = append(complex.combinators, combinator)
+	}
+
+	for {
+		compound, err := p.parseCompound()
+		if err != nil {
+			return nil, err
+		}
+
+		complex.parts = append(complex.parts, compound)
+
+		spaced := p.skipSpace()
+
+		switch next := p.peek(); next {
+		case childCombinator, adjacentCombinator, siblingCombinator:
+			p.pos++
+			p.skipSpace()
+			complex.combinators = append(complex.combinators, byte(next))
+			continue
+
+		case ',', ')', 0:
+			return &complex, nil
+		}
+
+		if !spaced {
+			return nil, p.fail("unexpected %q", p.peek())
+		}
+
+		complex.combinators = append(complex.combinators, descendantCombinator)
+	}
+}
+
+// parseCompound parses a compound selector.
+func (p *selectorParser) parseCompound() (*compoundSelector, error) {
+	var compound compoundSelector
+	start := p.pos
+
+	switch next := p.peek(); {
+	case next == '*':
+		p.pos++
+		compound.tag = "*"
+
+	case isIdentStart(p.source[p.pos:]):
+		compound.tag = p.parseName()
+	}
+
+	for {
+		switch p.peek() {
+		case '#':
+			p.pos++
+			if !isIdentStart(p.source[p.pos:]) {
+				return nil, p.fail("expected id")
+			}
+
+			compound.ids = append(compound.ids, p.parseName())
+
+		case '.':
+			p.pos++
+			if !isIdentStart(p.source[p.pos:]) {
+				return nil, p.fail("expected class name")
+			}
+
+			compound.classes = append(compound.classes, p.parseName())
+
+		case '[':
+			attr, err := p.parseAttr()
+			if err != nil {
+				return nil, err
+			}
+
+			compound.attrs = append(compound.attrs, attr)
+
+		case ':':
+			if err := p.parsePseudo(&compound); err != nil {
+				return nil, err
+			}
+
+		case '(':
+			if p.pos == start {
+				return nil, p.fail("expected selector")
+			}
+
+			// Skip the order annotation supported by ParseSelector.
+			if _, err := p.parseArgument(); err != nil {
+				return nil, err
+			}
+
+		default:
+			if p.pos == start {
+				return nil, p.fail("expected selector")
+			}
+
+			return &compound, nil
+		}
+	}
+}
+
+// parseAttr parses a attribute selector.
+func (p *selectorParser) parseAttr() (attrSelector, error) {
+	var attr attrSelector
+
+	p.pos++
+	p.skipSpace()
+
+	if !isIdentStart(p.source[p.pos:]) {
+		return attr, p.fail("expected attribute name")
+	}
+
+	attr.name = p.parseName()
+	p.skipSpace()
+
+	if p.peek() == ']' {
+		p.pos++
+		return attr, nil
+	}
+
+	for _, op := range []string{exactWordInListMatch, beginOrExactlyMatch, prefixMatch, suffixMatch, containsMatch, exactMatch} {
+		if strings.HasPrefix(p.source[p.pos:], op) {
+			attr.op = op
+			p.pos += len(op)
+			break
+		}
+	}
+
+	if attr.op == "" {
+		return attr, p.fail("expected attribute operator")
+	}
+
+	p.skipSpace()
+
+	switch next := p.peek(); {
+	case next == '"' || next == '\'':
+		value, err := p.parseString()
+		if err != nil {
+			return attr, err
+		}
+
+		attr.value = value
+
+	case isIdentStart(p.source[p.pos:]):
+		attr.value = p.parseName()
+
+	default:
+		return attr, p.fail("expected attribute value")
+	}
+
+	p.skipSpace()
+
+	switch p.peek() {
+	case 'i', 'I':
+		attr.fold = true
+		p.pos++
+		p.skipSpace()
+	case 's', 'S':
+		p.pos++
+		p.skipSpace()
+	}
+
+	if p.peek() != ']' {
+		return attr, p.fail("expected ]")
+	}
+
+	p.pos++
+	return attr, nil
+}
+
+// parsePseudo parses a pseudo-class or pseudo-element into the compound.
+func (p *selectorParser) parsePseudo(compound *compoundSelector) error {
+	p.pos++
+
+	if p.peek() == ':' {
+		p.pos++
+		if !isIdentStart(p.source[p.pos:]) {
+			return p.fail("expected pseudo-element")
+		}
+
+		// Pseudo-elements are not part of the tree, hence never match.
+		p.parseName()
+		compound.element = true
+		return nil
+	}
+
+	if !isIdentStart(p.source[p.pos:]) {
+		return p.fail("expected pseudo-class")
+	}
+
+	pseudo := pseudoSelector{name: strings.ToLower(p.parseName())}
+
+	switch pseudo.name {
+	case "before", "after", "first-line", "first-letter":
+		compound.element = true
+		return nil
+
+	case "first-child", "last-child", "only-child", "first-of-type", "last-of-type",
+		"only-of-type", "empty", "root", "scope", "checked", "disabled", "enabled":
+		compound.pseudos = append(compound.pseudos, pseudo)
+		return nil
+
+	case "nth-child", "nth-last-child", "nth-of-type", "nth-last-of-type",
+		"not", "is", "where", "matches", "has":
+	default:
+		return p.fail("unknown pseudo-class %q", pseudo.name)
+	}
+
+	start := p.pos + 1
+	argument, err := p.parseArgument()
+	if err != nil {
+		return err
+	}
+
+	sub := selectorParser{source: argument}
+	fail := func(err error) error {
+		if serr, ok := err.(*SelectorError); ok {
+			return &SelectorError{Selector: p.source, Pos: start + serr.Pos, Reason: serr.Reason}
+		}
+
+		return err
+	}
+
+	switch pseudo.name {
+	case "not", "is", "where", "matches", "has":
+		if pseudo.list, err = sub.parseList(pseudo.name == "has"); err != nil {
+			return fail(err)
+		}
+
+		if !sub.done() {
+			return fail(sub.fail("unexpected %q", sub.peek()))
+		}
+
+	default:
+		nth := argument
+		if pseudo.name == "nth-child" || pseudo.name == "nth-last-child" {
+			if index := ofIndex(argument); index != -1 {
+				nth = argument[:index]
+				sub.pos = index + 2
+
+				if pseudo.list, err = sub.parseList(false); err != nil {
+					return fail(err)
+				}
+
+				if !sub.done() {
+					return fail(sub.fail("unexpected %q", sub.peek()))
+				}
+			}
+		}
+
+		if pseudo.a, pseudo.b, err = parseNth(nth); err != nil {
+			return &SelectorError{Selector: p.source, Pos: start, Reason: err.Error()}
+		}
+	}
+
+	compound.pseudos = append(compound.pseudos, pseudo)
+	return nil
+}
+
+// parseArgument returns the content between the parenthesis at the current
+// position, allowing nested parenthesis and strings.
+func (p *selectorParser) parseArgument() (string, error) {
+	if p.peek() != '(' {
+		return "", p.fail("expected (")
+	}
+
+	p.pos++
+	start := p.pos
+	depth := 1
+
+	for p.pos < len(p.source) {
+		switch p.source[p.pos] {
+		case '\\':
+			p.pos++
+		case '"', '\'':
+			if _, err := p.parseString(); err != nil {
+				return "", err
+			}
+
+			continue
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				p.pos++
+				return p.source[start : p.pos-1], nil
+			}
+		}
+
+		p.pos++
+	}
+
+	return "", p.fail("expected )")
+}
+
+// parseName parses a sequence of name characters, resolving escapes.
+func (p *selectorParser) parseName() string {
+	var name []byte
+
+	for p.pos < len(p.source) {
+		char := p.source[p.pos]
+
+		switch {
+		case char == '\\':
+			name = append(name, p.parseEscape()...)
+		case isNameChar(char):
+			name = append(name, char)
+			p.pos++
+		default:
+			return string(name)
+		}
+	}
+
+	return string(name)
+}
+
+// parseEscape parses a escape sequence, returning the escaped character.
+func (p *selectorParser) parseEscape() string {
+	p.pos++
+
+	var hex int
+	for hex < 6 && p.pos+hex < len(p.source) && isHex(p.source[p.pos+hex]) {
+		hex++
+	}
+
+	if hex == 0 {
+		if p.pos >= len(p.source) {
+			return "�"
+		}
+
+		char, size := utf8.DecodeRuneInString(p.source[p.pos:])
+		p.pos += size
+		return string(char)
+	}
+
+	code, _ := strconv.ParseUint(p.source[p.pos:p.pos+hex], 16, 32)
+	p.pos += hex
+
+	if p.pos < len(p.source) && isSpace(p.source[p.pos]) {
+		p.pos++
+	}
+
+	if code == 0 || code > utf8.MaxRune || (code >= 0xD800 && code <= 0xDFFF) {
+		return "�"
+	}
+
+	return string(rune(code))
+}
+
+// parseString parses a quoted string, resolving escapes.
+func (p *selectorParser) parseString() (string, error) {
+	quote := p.source[p.pos]
+	p.pos++
+
+	var value []byte
+	for p.pos < len(p.source) {
+		char := p.source[p.pos]
+
+		switch {
+		case char == quote:
+			p.pos++
+			return string(value), nil
+		case char == '\\':
+			if p.pos+1 < len(p.source) && p.source[p.pos+1] == '\n' {
+				p.pos += 2
+				continue
+			}
+
+			value = append(value, p.parseEscape()...)
+		default:
+			value = append(value, char)
+			p.pos++
+		}
+	}
+
+	return "", p.fail("unterminated string")
+}
+
+// skipSpace skips whitespace, returning true if any was skipped.
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+	for p.pos < len(p.source) && isSpace(p.source[p.pos]) {
+		p.pos++
+	}
+
+	return p.pos != start
+}
+
+// peek returns the character at the current position or 0 at the end.
+func (p *selectorParser) peek() byte {
+	if p.pos >= len(p.source) {
+		return 0
+	}
+
+	return p.source[p.pos]
+}
+
+// done returns true/false if the source was fully parsed.
+func (p *selectorParser) done() bool {
+	p.skipSpace()
+	return p.pos >= len(p.source)
+}
+
+// fail returns a *SelectorError at the current position.
+func (p *selectorParser) fail(reason string, args ...interface{}) error {
+	return &SelectorError{Selector: p.source, Pos: p.pos, Reason: fmt.Sprintf(reason, args...)}
+}
+
+// ofIndex returns the index of the "of" keyword within the argument of a
+// nth-child pseudo-class or -1 if missing.
+func ofIndex(argument string) int {
+	lower := strings.ToLower(argument)
+
+	for index := 1; index+2 < len(lower); index++ {
+		if lower[index:index+2] == "of" && isSpace(lower[index-1]) && isSpace(lower[index+2]) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// parseNth parses the An+B argument of the nth pseudo-classes.
+func parseNth(argument string) (int, int, error) {
+	value := strings.ToLower(strings.TrimSpace(argument))
+
+	switch value {
+	case "odd":
+		return 2, 1, nil
+	case "even":
+		return 2, 0, nil
+	case "":
+		return 0, 0, fmt.Errorf("expected An+B")
+	}
+
+	index := strings.IndexByte(value, 'n')
+	if index == -1 {
+		b, err := parseNthInt(value, false)
+		return 0, b, err
+	}
+
+	var a int
+	switch coefficient := value[:index]; coefficient {
+	case "", "+":
+		a = 1
+	case "-":
+		a = -1
+	default:
+		var err error
+		if a, err = parseNthInt(coefficient, false); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	rest := strings.TrimSpace(value[index+1:])
+	if rest == "" {
+		return a, 0, nil
+	}
+
+	if rest[0] != '+' && rest[0] != '-' {
+		return 0, 0, fmt.Errorf("expected + or - in %q", argument)
+	}
+
+	b, err := parseNthInt(strings.TrimSpace(rest[1:]), true)
+	if rest[0] == '-' {
+		b = -b
+	}
+
+	return a, b, err
+}
+
+// parseNthInt parses a integer within a An+B argument, where unsigned
+// requires the integer to lack a sign.
+func parseNthInt(value string, unsigned bool) (int, error) {
+	if value == "" || strings.ContainsAny(value, " \t\n") {
+		return 0, fmt.Errorf("expected integer in %q", value)
+	}
+
+	if unsigned && (value[0] == '+' || value[0] == '-') {
+		return 0, fmt.Errorf("unexpected sign in %q", value)
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("expected integer in %q", value)
+	}
+
+	return n, nil
+}
+
+// isIdentStart returns true/false if a identifier starts the source.
+func isIdentStart(source string) bool {
+	if source == "" {
+		return false
+	}
+
+	if source[0] == '-' {
+		source = source[1:]
+		if source == "" {
+			return false
+		}
+
+		if source[0] == '-' {
+			return true
+		}
+	}
+
+	char := source[0]
+	return char == '_' || char == '\\' || char >= 0x80 || (char|0x20 >= 'a' && char|0x20 <= 'z')
+}
+
+// isNameChar returns true/false if the character is part of a name.
+func isNameChar(char byte) bool {
+	return char == '_' || char == '-' || char >= 0x80 || (char >= '0' && char <= '9') || (char|0x20 >= 'a' && char|0x20 <= 'z')
+}
+
+// isHex returns true/false if the character is a hexadecimal digit.
+func isHex(char byte) bool {
+	return (char >= '0' && char <= '9') || (char|0x20 >= 'a' && char|0x20 <= 'f')
+}
+
+// isSpace returns true/false if the character is whitespace.
+func isSpace(char byte) bool {
+	return char == ' ' || char == '\t' || char == '\n' || char == '\r' || char == '\f'
+}
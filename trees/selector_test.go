@@ -0,0 +1,304 @@
+package trees_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// conformanceDocument defines the document the selector conformance table
+// runs against, where every element carries an id naming it.
+const conformanceDocument = `
+<header id="head" class="site top" lang="en-US">
+  <h1 id="title" title="Gu Selectors">Title</h1>
+  <nav id="nav">
+    <a id="a1" href="/home" rel="nofollow external" class="link">Home</a>
+    <a id="a2" href="/docs" class="link active" data-x="One">Docs</a>
+    <a id="a3" href="https://gu.io/about.html" class="link">About</a>
+  </nav>
+</header>
+<main id="main">
+  <p id="p1" class="intro">One</p>
+  <p id="p2"></p>
+  <div id="d1" class="box">
+    <span id="s1">A</span>
+    <em id="e1">B</em>
+    <span id="s2">C</span>
+    <span id="s3"><!-- comment --></span>
+  </div>
+  <p id="p3" class="outro">Three</p>
+  <ul id="list">
+    <li id="l1" class="odd">1</li>
+    <li id="l2" class="even">2</li>
+    <li id="l3" class="odd">3</li>
+    <li id="l4" class="even">4</li>
+    <li id="l5" class="odd">5</li>
+  </ul>
+  <form id="form">
+    <input id="i1" type="checkbox" checked="checked"/>
+    <input id="i2" type="text" disabled="disabled"/>
+    <button id="b1">Go</button>
+  </form>
+</main>
+<footer id="foot"><p id="p4">Foot</p></footer>
+`
+
+// ids returns the ids of the provided markups.
+func ids(items []*trees.Markup) []string {
+	var found []string
+	for _, item := range items {
+		if value, ok := attrOf(item, "id"); ok {
+			found = append(found, value)
+		}
+	}
+
+	return found
+}
+
+// attrOf returns the value of the attribute of the markup.
+func attrOf(item *trees.Markup, name string) (string, bool) {
+	attr, err := trees.GetAttr(item, name)
+	if err != nil {
+		return "", false
+	}
+
+	_, value := attr.Render()
+	return value, true
+}
+
+// TestSelectorConformance validates the selector engine against a table of
+// selectors and the elements the browser matches for them.
+func TestSelectorConformance(t *testing.T) {
+	root := trees.ParseAsRoot("body#body", conformanceDocument)
+
+	cases := []struct {
+		selector string
+		expected string
+	}{
+		// Type, universal, id and class selectors.
+		{"p", "p1 p2 p3 p4"},
+		{"P", "p1 p2 p3 p4"},
+		{"#d1", "d1"},
+		{"span#s2", "s2"},
+		{"#s2#s2", "s2"},
+		{".link", "a1 a2 a3"},
+		{".link.active", "a2"},
+		{".site.top", "head"},
+		{".sit", ""},
+		{"nav > *", "a1 a2 a3"},
+		{"*#nav", "nav"},
+
+		// Attribute selectors.
+		{"[data-x]", "a2"},
+		{"[DATA-X]", "a2"},
+		{"[data-x=One]", "a2"},
+		{"[data-x='one']", ""},
+		{"[data-x='one' i]", "a2"},
+		{`[title="Gu Selectors"]`, "title"},
+		{"[rel~=external]", "a1"},
+		{"[rel~=ext]", ""},
+		{"[rel~='']", ""},
+		{"[lang|=en]", "head"},
+		{"[lang|=en-US]", "head"},
+		{"[lang|=e]", ""},
+		{"[href^='/']", "a1 a2"},
+		{"[href$='.html']", "a3"},
+		{"[href*=gu]", "a3"},
+		{"[href^='']", ""},
+		{"a[href][class~=active]", "a2"},
+
+		// Combinators.
+		{"main p", "p1 p2 p3"},
+		{"main > p", "p1 p2 p3"},
+		{"body p", "p1 p2 p3 p4"},
+		{"footer > p", "p4"},
+		{"header > a", ""},
+		{"header a", "a1 a2 a3"},
+		{"p + div", "d1"},
+		{"p + p", "p2"},
+		{"p ~ p", "p2 p3"},
+		{"h1 ~ nav > a + a", "a2 a3"},
+		{"span + span", "s3"},
+		{"span ~ span", "s2 s3"},
+		{"em+span", "s2"},
+		{"main>div>em", "e1"},
+		{"header   nav\n a", "a1 a2 a3"},
+		{"#main #s1", "s1"},
+
+		// Selector lists.
+		{"h1, nav", "title nav"},
+		{"nav, h1", "title nav"},
+		{"#p4, #p1, #p1", "p1 p4"},
+
+		// Structural pseudo-classes.
+		{"li:first-child", "l1"},
+		{"li:last-child", "l5"},
+		{"p:only-child", "p4"},
+		{"span:first-child", "s1"},
+		{"span:last-child", "s3"},
+		{"div > :first-of-type", "s1 e1"},
+		{"div > :last-of-type", "e1 s3"},
+		{"div > :only-of-type", "e1"},
+		{"main > p:first-of-type", "p1"},
+		{"main > p:last-of-type", "p3"},
+		{"li:nth-child(2)", "l2"},
+		{"li:nth-child(odd)", "l1 l3 l5"},
+		{"li:nth-child(even)", "l2 l4"},
+		{"li:nth-child(2n+1)", "l1 l3 l5"},
+		{"li:nth-child( 2n + 1 )", "l1 l3 l5"},
+		{"li:nth-child(n+3)", "l3 l4 l5"},
+		{"li:nth-child(-n+2)", "l1 l2"},
+		{"li:nth-child(3n)", "l3"},
+		{"li:nth-child(0n+4)", "l4"},
+		{"li:nth-child(-2n+5)", "l1 l3 l5"},
+		{"li:nth-last-child(1)", "l5"},
+		{"li:nth-last-child(2n)", "l2 l4"},
+		{"li:nth-child(2 of .odd)", "l3"},
+		{"li:nth-last-child(1 of .even)", "l4"},
+		{"div > span:nth-of-type(2)", "s2"},
+		{"div > span:nth-last-of-type(1)", "s3"},
+		{"main > :nth-of-type(2)", "p2"},
+		{"p:empty", "p2"},
+		{"span:empty", "s3"},
+		{"#form :empty", "i1 i2"},
+		{":root", ""},
+		{":scope > footer", "foot"},
+		{":scope > *", "head main foot"},
+
+		// Logical pseudo-classes.
+		{"p:not(.intro)", "p2 p3 p4"},
+		{"p:not(.intro, .outro)", "p2 p4"},
+		{"li:not(:nth-child(odd))", "l2 l4"},
+		{"div > :not(span)", "e1"},
+		{":is(h1, em)", "title e1"},
+		{":where(nav, footer) > :first-child", "a1 p4"},
+		{"main :is(p, span):last-child", "s3"},
+
+		// Relational pseudo-classes.
+		{"div:has(em)", "d1"},
+		{":has(> #a2)", "nav"},
+		{"nav:has(> a.active)", "nav"},
+		{"nav:has(> h1)", ""},
+		{"h1:has(+ nav)", "title"},
+		{"p:has(~ ul)", "p1 p2 p3"},
+		{"p:has(+ p)", "p1"},
+		{"main:has(ul > li.even)", "main"},
+		{":has(#p4)", "foot"},
+		{"li:has(li)", ""},
+
+		// Form pseudo-classes.
+		{":checked", "i1"},
+		{":disabled", "i2"},
+		{":enabled", "i1 b1"},
+
+		// Escapes and pseudo-elements.
+		{`#\64 1`, "d1"},
+		{`\70 `, "p1 p2 p3 p4"},
+		{"p::before", ""},
+		{"p:after", ""},
+	}
+
+	for _, item := range cases {
+		compiled, err := trees.Query.Compile(item.selector)
+		if err != nil {
+			t.Fatalf("\t%s\t  Should have compiled selector %q: %+q", failed, item.selector, err)
+		}
+
+		expected := strings.Fields(item.expected)
+		actual := ids(compiled.QueryAll(root))
+
+		if len(expected) == 0 && len(actual) == 0 {
+			continue
+		}
+
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("\t%s\t  Should have matched %q with %+q: %+q", failed, item.selector, expected, actual)
+		}
+
+		first := trees.Query.Query(root, item.selector)
+		if value, _ := attrOf(first, "id"); value != expected[0] {
+			t.Fatalf("\t%s\t  Should have matched %q first with %q: %q", failed, item.selector, expected[0], value)
+		}
+	}
+	t.Logf("\t%s\t  Should have matched %d selectors like the browser", success, len(cases))
+}
+
+// TestSelectorErrors validates that invalid selectors fail compiling.
+func TestSelectorErrors(t *testing.T) {
+	cases := []string{
+		"",
+		" ",
+		"a,",
+		",a",
+		"a >",
+		"> a",
+		"a + + b",
+		"#",
+		"#1a",
+		".",
+		".2col",
+		"[",
+		"[href",
+		"[href=]",
+		"[href=='a']",
+		"[href='a]",
+		"a:",
+		"a:unknown",
+		"a:nth-child",
+		"a:nth-child()",
+		"a:nth-child(n+)",
+		"a:nth-child(2n+ -1)",
+		"a:nth-child(x)",
+		"a:not(",
+		"a:not()",
+		"a:not(> b)",
+		"a:has()",
+		"a)",
+		"a!",
+	}
+
+	for _, selector := range cases {
+		_, err := trees.Query.Compile(selector)
+		if _, ok := err.(*trees.SelectorError); !ok {
+			t.Fatalf("\t%s\t  Should have failed compiling %q: %+q", failed, selector, err)
+		}
+
+		if trees.Query.QueryAll(trees.NewMarkup("div", false), selector) != nil {
+			t.Fatalf("\t%s\t  Should have returned no results for %q", failed, selector)
+		}
+	}
+	t.Logf("\t%s\t  Should have failed compiling %d invalid selectors", success, len(cases))
+}
+
+// TestSelectorMatch validates matching elements directly, where the whole
+// tree is considered.
+func TestSelectorMatch(t *testing.T) {
+	root := trees.ParseAsRoot("body#body", conformanceDocument)
+	item := trees.Query.Query(root, "#a2")
+
+	for _, selector := range []string{"a", "body nav > a.active", "a:nth-child(2)", "header :not(h1)", "#a1 + a"} {
+		if !trees.Query.Matches(item, selector) {
+			t.Fatalf("\t%s\t  Should have matched %q", failed, selector)
+		}
+	}
+	t.Logf("\t%s\t  Should have matched element against selectors", success)
+
+	for _, selector := range []string{"p", "main a", "a:first-child", "a:has(*)", "a:invalid("} {
+		if trees.Query.Matches(item, selector) {
+			t.Fatalf("\t%s\t  Should not have matched %q", failed, selector)
+		}
+	}
+	t.Logf("\t%s\t  Should not have matched element against other selectors", success)
+
+	if !trees.Query.Matches(root, ":root") {
+		t.Fatalf("\t%s\t  Should have matched root with :root", failed)
+	}
+	t.Logf("\t%s\t  Should have matched root with :root", success)
+
+	if trees.Query.Matches(item, "a[uid='"+item.UID()+"']") {
+		t.Fatalf("\t%s\t  Should not have matched the uid of the markup as an attribute", failed)
+	}
+	t.Logf("\t%s\t  Should not have matched the uid of the markup as an attribute", success)
+}
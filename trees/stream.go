@@ -0,0 +1,77 @@
+package trees
+
+import "io"
+
+// flushDepth defines the depth of the markups after which streamed output is
+// flushed, which for a app's html root are it's head and body and the views
+// within them.
+const flushDepth = 2
+
+// WriteTo writes the html representation of the markup into the writer using
+// the default SimpleElementWriter, without building the whole markup as a
+// string. It implements io.WriterTo.
+func (e *Markup) WriteTo(w io.Writer) (int64, error) {
+	return SimpleElementWriter.WriteTo(w, e)
+}
+
+// WriteTo writes the markup into the writer in the printer's format without
+// building the whole markup as a string, returning the total of bytes written.
+// If the writer can be flushed, it is flushed after each element within the
+// children of the markup.
+func (p Printer) WriteTo(w io.Writer, e *Markup) (int64, error) {
+	sw := newStreamWriter(w)
+	p.print(sw, e, printContext{namespace: parentNamespace(e), flush: sw.Flush})
+	return sw.n, sw.err
+}
+
+//==============================================================================
+
+// streamWriter defines a writer which counts the bytes written into the
+// underline writer, and stops writing after the first error.
+type streamWriter struct {
+	w     io.Writer
+	n     int64
+	err   error
+	flush func() error
+}
+
+// newStreamWriter returns a new streamWriter for the writer, which flushes
+// the writer if it provides a Flush method such as http.Flusher and
+// bufio.Writer do.
+func newStreamWriter(w io.Writer) *streamWriter {
+	sw := &streamWriter{w: w}
+
+	switch flusher := w.(type) {
+	case interface{ Flush() error }:
+		sw.flush = flusher.Flush
+	case interface{ Flush() }:
+		sw.flush = func() error {
+			flusher.Flush()
+			return nil
+		}
+	}
+
+	return sw
+}
+
+// WriteString writes the string into the underline writer.
+func (s *streamWriter) WriteString(content string) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	n, err := io.WriteString(s.w, content)
+	s.n += int64(n)
+	s.err = err
+
+	return n, err
+}
+
+// Flush flushes the underline writer if it can be flushed.
+func (s *streamWriter) Flush() {
+	if s.err != nil || s.flush == nil {
+		return
+	}
+
+	s.err = s.flush()
+}
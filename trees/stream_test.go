@@ -0,0 +1,128 @@
+package trees_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// flushRecorder records the content written between flushes.
+type flushRecorder struct {
+	out     bytes.Buffer
+	writes  int
+	flushed []string
+}
+
+func (f *flushRecorder) Write(data []byte) (int, error) {
+	f.writes++
+	return f.out.Write(data)
+}
+
+func (f *flushRecorder) Flush() {
+	f.flushed = append(f.flushed, f.out.String())
+}
+
+// failingWriter fails all writes after the provided total of writes.
+type failingWriter struct {
+	allowed int
+}
+
+func (f *failingWriter) Write(data []byte) (int, error) {
+	if f.allowed == 0 {
+		return 0, errors.New("write failed")
+	}
+
+	f.allowed--
+	return len(data), nil
+}
+
+// streamDocument returns a app like tree with views in the head and body.
+func streamDocument() *trees.Markup {
+	return trees.ParseAsRoot("html", `
+    <head><title>Stream</title></head>
+    <body>
+      <div class="view-one"><p>One</p></div>
+      <div class="view-two"><p>Two</p></div>
+    </body>
+  `)
+}
+
+// TestWriteTo validates streaming markup into writers.
+func TestWriteTo(t *testing.T) {
+	tree := streamDocument()
+
+	var recorder flushRecorder
+	n, err := tree.WriteTo(&recorder)
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have written markup: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have written markup", success)
+
+	if recorder.out.String() != tree.HTML() || n != int64(recorder.out.Len()) {
+		t.Fatalf("\t%s\t  Should have written the same markup as HTML: %d\n%s", failed, n, recorder.out.String())
+	}
+	t.Logf("\t%s\t  Should have written the same markup as HTML", success)
+
+	if recorder.writes < 10 {
+		t.Fatalf("\t%s\t  Should have streamed markup in many writes: %d", failed, recorder.writes)
+	}
+	t.Logf("\t%s\t  Should have streamed markup in many writes", success)
+
+	// Flushed after the title, the head, each view and the body.
+	if len(recorder.flushed) != 5 {
+		t.Fatalf("\t%s\t  Should have flushed after each view: %d", failed, len(recorder.flushed))
+	}
+
+	if flushed := recorder.flushed[2]; !strings.HasSuffix(flushed, "One</p></div>") || strings.Contains(flushed, "Two") {
+		t.Fatalf("\t%s\t  Should have flushed after first view: %s", failed, flushed)
+	}
+	t.Logf("\t%s\t  Should have flushed after each view", success)
+
+	var pretty bytes.Buffer
+	if _, err := trees.PrettyPrinter.WriteTo(&pretty, tree); err != nil || pretty.String() != trees.PrettyPrinter.Print(tree) {
+		t.Fatalf("\t%s\t  Should have streamed pretty markup: %+q\n%s", failed, err, pretty.String())
+	}
+	t.Logf("\t%s\t  Should have streamed pretty markup", success)
+
+	if _, err := tree.WriteTo(&failingWriter{allowed: 3}); err == nil || err.Error() != "write failed" {
+		t.Fatalf("\t%s\t  Should have returned write error: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have returned write error", success)
+}
+
+// BenchmarkHTML measures building large markup as a string.
+func BenchmarkHTML(b *testing.B) {
+	tree := benchmarkDocument()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var out bytes.Buffer
+		out.WriteString(tree.HTML())
+	}
+}
+
+// BenchmarkWriteTo measures streaming large markup into a writer.
+func BenchmarkWriteTo(b *testing.B) {
+	tree := benchmarkDocument()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var out bytes.Buffer
+		tree.WriteTo(&out)
+	}
+}
+
+// benchmarkDocument returns a large tree for the benchmarks.
+func benchmarkDocument() *trees.Markup {
+	var rows []string
+	for i := 0; i < 500; i++ {
+		rows = append(rows, `<tr class="row"><td>Name</td><td><a href="/item">Item</a></td></tr>`)
+	}
+
+	return trees.ParseAsRoot("table", strings.Join(rows, ""))
+}
@@ -0,0 +1,138 @@
+package trees
+
+import (
+	"regexp"
+	"strings"
+
+	bcss "github.com/aymerick/douceur/css"
+	"github.com/aymerick/douceur/parser"
+)
+
+var (
+	uidSelector     = regexp.MustCompile(`\[uid=['"]?([^'"\]]+)['"]?\]`)
+	dynamicSelector = regexp.MustCompile(`(?i)::?(hover|focus|focus-within|focus-visible|active|visited|link|target|before|after|first-line|first-letter|placeholder|selection|marker|backdrop|-webkit-[\w-]+|-moz-[\w-]+|-ms-[\w-]+)\b(\([^)]*\))?`)
+)
+
+// IsStylesheet returns true/false if the markup is a stylesheet created with
+// CSSStylesheet or ScopedCSSStylesheet.
+func (e *Markup) IsStylesheet() bool {
+	return e.stylesheet
+}
+
+// Stylesheets returns the content of the stylesheets within the markup in
+// document order, where stylesheets of equal content are returned once.
+func Stylesheets(root *Markup) []string {
+	var sheets []string
+
+	Walk(root, func(e *Markup) WalkAction {
+		if e.removed {
+			return WalkSkip
+		}
+
+		if e.stylesheet {
+			sheets = appendUnique(sheets, e.TextContent())
+			return WalkSkip
+		}
+
+		return WalkContinue
+	})
+
+	return sheets
+}
+
+// ExtractStylesheets removes the stylesheets from the markup, returning their
+// content as Stylesheets does. The markup is changed, hence trees which are
+// rendered again, like those of components, should be cloned first.
+func ExtractStylesheets(root *Markup) []string {
+	sheets := Stylesheets(root)
+
+	Walk(root, func(e *Markup) WalkAction {
+		var children []*Markup
+		for _, child := range e.children {
+			if !child.stylesheet {
+				children = append(children, child)
+			}
+		}
+
+		e.children = children
+		return WalkContinue
+	})
+
+	return sheets
+}
+
+// CriticalCSS returns the rules of the stylesheet whose selectors match
+// elements within the markup or the markup itself, checked with Query.
+// Dynamic pseudo-classes and pseudo-elements such as :hover and ::before are
+// ignored when matching, at-rules holding rules like @media keep their
+// matching rules and other at-rules such as @font-face are kept as is.
+// Selectors scoped by uid match when the markup holds the uid and rules
+// with selectors Query can not compile are kept.
+func CriticalCSS(stylesheet string, root *Markup) (string, error) {
+	sheet, err := parser.Parse(stylesheet)
+	if err != nil {
+		return "", err
+	}
+
+	uids := make(map[string]bool)
+	Walk(root, func(e *Markup) WalkAction {
+		uids[e.uid] = true
+		return WalkContinue
+	})
+
+	sheet.Rules = criticalRules(sheet.Rules, root, uids)
+	return sheet.String(), nil
+}
+
+// criticalRules returns the rules which match the markup.
+func criticalRules(rules []*bcss.Rule, root *Markup, uids map[string]bool) []*bcss.Rule {
+	var kept []*bcss.Rule
+
+	for _, rule := range rules {
+		if rule.Kind == bcss.AtRule {
+			if !rule.EmbedsRules() {
+				kept = append(kept, rule)
+				continue
+			}
+
+			if rule.Rules = criticalRules(rule.Rules, root, uids); len(rule.Rules) != 0 {
+				kept = append(kept, rule)
+			}
+
+			continue
+		}
+
+		for _, selector := range rule.Selectors {
+			if matchesCritical(selector, root, uids) {
+				kept = append(kept, rule)
+				break
+			}
+		}
+	}
+
+	return kept
+}
+
+// matchesCritical returns true/false if the selector matches the markup or
+// an element within it.
+func matchesCritical(selector string, root *Markup, uids map[string]bool) bool {
+	for _, match := range uidSelector.FindAllStringSubmatch(selector, -1) {
+		if !uids[match[1]] {
+			return false
+		}
+	}
+
+	selector = uidSelector.ReplaceAllString(selector, "")
+	selector = dynamicSelector.ReplaceAllString(selector, "")
+
+	if strings.TrimSpace(selector) == "" {
+		return true
+	}
+
+	compiled, err := Query.Compile(selector)
+	if err != nil {
+		return true
+	}
+
+	return compiled.Match(root) || compiled.Query(root) != nil
+}
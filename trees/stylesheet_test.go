@@ -0,0 +1,120 @@
+package trees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/css"
+	"github.com/gu-io/gu/trees/elems"
+)
+
+type card struct {
+	Color string
+}
+
+type banner struct {
+	Color string
+}
+
+var cardStyles = css.New(`
+	& {
+		color: {{ .Color }};
+	}
+
+	& h1 {
+		font-weight: bold;
+	}
+`)
+
+// TestScopedStylesheet validates scoped stylesheets share a class between
+// instances of the same component.
+func TestScopedStylesheet(t *testing.T) {
+	first := elems.Div(elems.ScopedCSS(cardStyles, card{Color: "red"}))
+	second := elems.Div(trees.NewAttr("class", "card"), elems.ScopedCSS(cardStyles, card{Color: "red"}))
+	other := elems.Div(elems.ScopedCSS(cardStyles, banner{Color: "red"}))
+
+	class := first.Children()[0].ScopeClass()
+	if class == "" {
+		t.Fatalf("\t%s\t  Should have scoped the stylesheet with a class: %s", failed, first.HTML())
+	}
+
+	if value, _ := attrOf(first, "class"); value != class {
+		t.Fatalf("\t%s\t  Should have added the class to the owner: %s", failed, first.HTML())
+	}
+
+	if value, _ := attrOf(second, "class"); value != "card "+class {
+		t.Fatalf("\t%s\t  Should have added the class to the existing classes: %s", failed, second.HTML())
+	}
+	t.Logf("\t%s\t  Should have added the class to the owner", success)
+
+	sheet := first.Children()[0].TextContent()
+	if sheet != second.Children()[0].TextContent() || strings.Contains(sheet, "uid") {
+		t.Fatalf("\t%s\t  Should have shared the stylesheet between instances: %q", failed, sheet)
+	}
+
+	if !strings.Contains(sheet, "."+class+" h1 {") || !strings.Contains(sheet, "color: red;") {
+		t.Fatalf("\t%s\t  Should have rewritten selectors with the class: %q", failed, sheet)
+	}
+	t.Logf("\t%s\t  Should have shared the stylesheet between instances", success)
+
+	if other.Children()[0].ScopeClass() == class {
+		t.Fatalf("\t%s\t  Should have used a different class for other components", failed)
+	}
+	t.Logf("\t%s\t  Should have used a different class for other components", success)
+
+	if clone := first.Clone(); clone.Children()[0].ScopeClass() != class {
+		t.Fatalf("\t%s\t  Should have kept the class when cloned", failed)
+	}
+
+	if value, _ := attrOf(first.Clone(), "class"); value != class {
+		t.Fatalf("\t%s\t  Should have kept a single class when cloned: %s", failed, first.Clone().HTML())
+	}
+	t.Logf("\t%s\t  Should have kept the class when cloned", success)
+}
+
+// TestExtractStylesheets validates stylesheets are collected once and
+// critical rules match the markup.
+func TestExtractStylesheets(t *testing.T) {
+	root := elems.Div(
+		elems.Div(elems.ScopedCSS(cardStyles, card{Color: "red"}), elems.Span(elems.Text("First"))),
+		elems.Div(elems.ScopedCSS(cardStyles, card{Color: "red"}), elems.Span(elems.Text("Second"))),
+		elems.CSS(`& { margin: 0; }`, nil),
+	)
+
+	if sheets := trees.Stylesheets(root); len(sheets) != 2 || len(trees.Query.QueryAll(root, "style")) != 3 {
+		t.Fatalf("\t%s\t  Should have collected each stylesheet once: %q", failed, sheets)
+	}
+	t.Logf("\t%s\t  Should have collected each stylesheet once", success)
+
+	sheets := trees.ExtractStylesheets(root)
+	if len(sheets) != 2 || trees.Query.Query(root, "style") != nil || len(trees.Query.QueryAll(root, "span")) != 2 {
+		t.Fatalf("\t%s\t  Should have removed the stylesheets: %s", failed, root.HTML())
+	}
+	t.Logf("\t%s\t  Should have removed the stylesheets", success)
+
+	critical, err := trees.CriticalCSS(`
+		span:hover, .missing { color: red; }
+		p::before { content: "x"; }
+		div > span + span { color: blue; }
+		@media (max-width: 400px) { span { color: green; } p { color: black; } }
+		@media print { p { color: black; } }
+		@font-face { font-family: "Gu"; src: url(gu.woff); }
+	`, root)
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have parsed the stylesheet: %+q", failed, err)
+	}
+
+	for _, expected := range []string{"span:hover, .missing", "color: green;", "@font-face"} {
+		if !strings.Contains(critical, expected) {
+			t.Fatalf("\t%s\t  Should have kept %q in the critical css: %q", failed, expected, critical)
+		}
+	}
+
+	for _, unexpected := range []string{"p::before", "span + span", "color: black;", "@media print"} {
+		if strings.Contains(critical, unexpected) {
+			t.Fatalf("\t%s\t  Should have removed %q from the critical css: %q", failed, unexpected, critical)
+		}
+	}
+	t.Logf("\t%s\t  Should have kept only rules matching the markup", success)
+}
@@ -0,0 +1,348 @@
+package trees
+
+import "strings"
+
+// WalkAction defines the action returned by a walk function to control the
+// continuation of a walk.
+type WalkAction int
+
+// contains the actions returned by walk functions.
+const (
+	// WalkContinue continues the walk into the children of the markup.
+	WalkContinue WalkAction = iota
+
+	// WalkSkip continues the walk past the children of the markup. Returned
+	// after the children were visited, it behaves as WalkContinue.
+	WalkSkip
+
+	// WalkStop ends the walk.
+	WalkStop
+)
+
+// Visitor defines a interface for types visiting a tree, where Enter is
+// called with a markup before it's children are visited and Leave after.
+type Visitor interface {
+	Enter(*Markup) WalkAction
+	Leave(*Markup) WalkAction
+}
+
+// VisitorFuncs defines a Visitor using the provided functions, where any nil
+// function continues the walk.
+type VisitorFuncs struct {
+	EnterFn func(*Markup) WalkAction
+	LeaveFn func(*Markup) WalkAction
+}
+
+// Enter calls the EnterFn with the markup.
+func (v VisitorFuncs) Enter(e *Markup) WalkAction {
+	if v.EnterFn == nil {
+		return WalkContinue
+	}
+
+	return v.EnterFn(e)
+}
+
+// Leave calls the LeaveFn with the markup.
+func (v VisitorFuncs) Leave(e *Markup) WalkAction {
+	if v.LeaveFn == nil {
+		return WalkContinue
+	}
+
+	return v.LeaveFn(e)
+}
+
+// Visit walks the root and it's children with the visitor in document order,
+// returning false if the walk was stopped. Children are read before they are
+// visited, hence the visitor may change the children of the markup it visits.
+func Visit(root *Markup, visitor Visitor) bool {
+	switch visitor.Enter(root) {
+	case WalkStop:
+		return false
+	case WalkSkip:
+		return visitor.Leave(root) != WalkStop
+	}
+
+	for _, child := range append([]*Markup(nil), root.children...) {
+		if !Visit(child, visitor) {
+			return false
+		}
+	}
+
+	return visitor.Leave(root) != WalkStop
+}
+
+// Walk calls the function with the root and it's children in pre-order, where
+// a markup is visited before it's children. It returns false if the walk was
+// stopped.
+func Walk(root *Markup, fn func(*Markup) WalkAction) bool {
+	return Visit(root, VisitorFuncs{EnterFn: fn})
+}
+
+// WalkPost calls the function with the root and it's children in post-order,
+// where a markup is visited after it's children. It returns false if the
+// walk was stopped.
+func WalkPost(root *Markup, fn func(*Markup) WalkAction) bool {
+	return Visit(root, VisitorFuncs{LeaveFn: fn})
+}
+
+//==============================================================================
+
+// Predicate defines a function which reports if a markup meets a condition.
+type Predicate func(*Markup) bool
+
+// Find returns the first markup within the root, including the root, which
+// meets the predicate in document order.
+func Find(root *Markup, predicate Predicate) *Markup {
+	var found *Markup
+
+	Walk(root, func(e *Markup) WalkAction {
+		if predicate(e) {
+			found = e
+			return WalkStop
+		}
+
+		return WalkContinue
+	})
+
+	return found
+}
+
+// Filter returns all markups within the root, including the root, which meet
+// the predicate in document order.
+func Filter(root *Markup, predicate Predicate) []*Markup {
+	var found []*Markup
+
+	Walk(root, func(e *Markup) WalkAction {
+		if predicate(e) {
+			found = append(found, e)
+		}
+
+		return WalkContinue
+	})
+
+	return found
+}
+
+// WithTag returns a Predicate met by markups of the provided tag.
+func WithTag(tag string) Predicate {
+	tag = strings.TrimSpace(strings.ToLower(tag))
+
+	return func(e *Markup) bool {
+		return strings.ToLower(e.tagname) == tag
+	}
+}
+
+// WithAttr returns a Predicate met by markups having the attribute, with the
+// provided value if not empty.
+func WithAttr(name string, value string) Predicate {
+	return func(e *Markup) bool {
+		val, ok := attrValue(e, name)
+		return ok && (value == "" || val == value)
+	}
+}
+
+// WithClass returns a Predicate met by markups having the class.
+func WithClass(class string) Predicate {
+	return func(e *Markup) bool {
+		value, _ := attrValue(e, "class")
+		return containsString(strings.Fields(value), class)
+	}
+}
+
+// Matching returns a Predicate met by elements matching the selector. It
+// panics if the selector is invalid.
+func Matching(sel string) Predicate {
+	compiled, err := Query.Compile(sel)
+	if err != nil {
+		panic(err)
+	}
+
+	return compiled.Match
+}
+
+// Not returns a Predicate met by markups which do not meet the predicate.
+func Not(predicate Predicate) Predicate {
+	return func(e *Markup) bool {
+		return !predicate(e)
+	}
+}
+
+// And returns a Predicate met by markups which meet all the predicates.
+func And(predicates ...Predicate) Predicate {
+	return func(e *Markup) bool {
+		for _, predicate := range predicates {
+			if !predicate(e) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Or returns a Predicate met by markups which meet any of the predicates.
+func Or(predicates ...Predicate) Predicate {
+	return func(e *Markup) bool {
+		for _, predicate := range predicates {
+			if predicate(e) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+//==============================================================================
+
+// Transform calls the function with the children of the root in post-order,
+// replacing each child with the markup returned. Returning the child keeps it,
+// while returning nil detaches it from the tree. It returns the root.
+func Transform(root *Markup, fn func(*Markup) *Markup) *Markup {
+	var children []*Markup
+
+	for _, child := range root.children {
+		Transform(child, fn)
+
+		replacement := fn(child)
+		if replacement == nil {
+			child.parent = nil
+			continue
+		}
+
+		if replacement != child {
+			child.parent = nil
+		}
+
+		replacement.parent = root
+		children = append(children, replacement)
+	}
+
+	root.children = children
+	return root
+}
+
+// ReplaceChild replaces the child of the markup with the provided markup,
+// returning false if the child was not found.
+func (e *Markup) ReplaceChild(child *Markup, replacement *Markup) bool {
+	for index, item := range e.children {
+		if item != child {
+			continue
+		}
+
+		child.parent = nil
+		replacement.parent = e
+		e.children[index] = replacement
+		return true
+	}
+
+	return false
+}
+
+// RemoveChild detaches the child from the markup, unlike Remove which only
+// marks it as removed, returning false if the child was not found.
+func (e *Markup) RemoveChild(child *Markup) bool {
+	for index, item := range e.children {
+		if item != child {
+			continue
+		}
+
+		child.parent = nil
+		e.children = append(e.children[:index], e.children[index+1:]...)
+		return true
+	}
+
+	return false
+}
+
+//==============================================================================
+
+// Parent returns the parent of the markup or nil if it has none.
+func (e *Markup) Parent() *Markup {
+	return e.parent
+}
+
+// Root returns the top most ancestor of the markup or the markup itself if it
+// has no parent.
+func (e *Markup) Root() *Markup {
+	root := e
+	for root.parent != nil {
+		root = root.parent
+	}
+
+	return root
+}
+
+// Ancestors returns the ancestors of the markup, from it's parent to the root.
+func (e *Markup) Ancestors() []*Markup {
+	var ancestors []*Markup
+	for parent := e.parent; parent != nil; parent = parent.parent {
+		ancestors = append(ancestors, parent)
+	}
+
+	return ancestors
+}
+
+// Closest returns the markup or the nearest ancestor of it which meets the
+// predicate, or nil if none does.
+func (e *Markup) Closest(predicate Predicate) *Markup {
+	for item := e; item != nil; item = item.parent {
+		if predicate(item) {
+			return item
+		}
+	}
+
+	return nil
+}
+
+// Index returns the index of the markup within it's parent's children or -1
+// if it has no parent.
+func (e *Markup) Index() int {
+	if e.parent == nil {
+		return -1
+	}
+
+	for index, child := range e.parent.children {
+		if child == e {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// Siblings returns the other children of the markup's parent.
+func (e *Markup) Siblings() []*Markup {
+	if e.parent == nil {
+		return nil
+	}
+
+	var siblings []*Markup
+	for _, child := range e.parent.children {
+		if child != e {
+			siblings = append(siblings, child)
+		}
+	}
+
+	return siblings
+}
+
+// PreviousSibling returns the child before the markup within it's parent or
+// nil if it is the first.
+func (e *Markup) PreviousSibling() *Markup {
+	if index := e.Index(); index > 0 {
+		return e.parent.children[index-1]
+	}
+
+	return nil
+}
+
+// NextSibling returns the child after the markup within it's parent or nil if
+// it is the last.
+func (e *Markup) NextSibling() *Markup {
+	if index := e.Index(); index != -1 && index < len(e.parent.children)-1 {
+		return e.parent.children[index+1]
+	}
+
+	return nil
+}
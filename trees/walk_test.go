@@ -0,0 +1,237 @@
+package trees_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// walkDocument returns the tree the traversal tests run against.
+func walkDocument() *trees.Markup {
+	return trees.ParseAsRoot("div#root", `
+    <nav id="nav">
+      <a id="home" href="/home">Home</a>
+      <a id="docs" href="/docs" class="external">Docs</a>
+    </nav>
+    <section id="content">
+      <p id="intro">Intro <a id="more" href="/more">More</a></p>
+    </section>
+  `)
+}
+
+// tagsOf returns the ids of the markups or their tags if they lack one.
+func tagsOf(items []*trees.Markup) string {
+	var names []string
+	for _, item := range items {
+		if id, ok := attrOf(item, "id"); ok {
+			names = append(names, id)
+			continue
+		}
+
+		names = append(names, item.Name())
+	}
+
+	return strings.Join(names, " ")
+}
+
+// TestWalk validates the pre and post-order walks and their actions.
+func TestWalk(t *testing.T) {
+	root := walkDocument()
+
+	var pre []*trees.Markup
+	trees.Walk(root, func(e *trees.Markup) trees.WalkAction {
+		pre = append(pre, e)
+		return trees.WalkContinue
+	})
+
+	if order := tagsOf(pre); order != "root nav home text docs text content intro text more text" {
+		t.Fatalf("\t%s\t  Should have walked in pre-order: %q", failed, order)
+	}
+	t.Logf("\t%s\t  Should have walked in pre-order", success)
+
+	var post []*trees.Markup
+	trees.WalkPost(root, func(e *trees.Markup) trees.WalkAction {
+		if e.Name() != "text" {
+			post = append(post, e)
+		}
+
+		return trees.WalkContinue
+	})
+
+	if order := tagsOf(post); order != "home docs nav more intro content root" {
+		t.Fatalf("\t%s\t  Should have walked in post-order: %q", failed, order)
+	}
+	t.Logf("\t%s\t  Should have walked in post-order", success)
+
+	var skipped []*trees.Markup
+	trees.Walk(root, func(e *trees.Markup) trees.WalkAction {
+		if e.Name() == "text" {
+			return trees.WalkContinue
+		}
+
+		skipped = append(skipped, e)
+		if e.Name() == "nav" {
+			return trees.WalkSkip
+		}
+
+		return trees.WalkContinue
+	})
+
+	if order := tagsOf(skipped); order != "root nav content intro more" {
+		t.Fatalf("\t%s\t  Should have skipped children of nav: %q", failed, order)
+	}
+	t.Logf("\t%s\t  Should have skipped children of nav", success)
+
+	var stopped []*trees.Markup
+	completed := trees.Walk(root, func(e *trees.Markup) trees.WalkAction {
+		stopped = append(stopped, e)
+		if e.Name() == "a" {
+			return trees.WalkStop
+		}
+
+		return trees.WalkContinue
+	})
+
+	if completed || tagsOf(stopped) != "root nav home" {
+		t.Fatalf("\t%s\t  Should have stopped at first link: %q", failed, tagsOf(stopped))
+	}
+	t.Logf("\t%s\t  Should have stopped at first link", success)
+
+	var depth, deepest int
+	trees.Visit(root, trees.VisitorFuncs{
+		EnterFn: func(e *trees.Markup) trees.WalkAction {
+			depth++
+			if depth > deepest {
+				deepest = depth
+			}
+
+			return trees.WalkContinue
+		},
+		LeaveFn: func(e *trees.Markup) trees.WalkAction {
+			depth--
+			return trees.WalkContinue
+		},
+	})
+
+	if depth != 0 || deepest != 5 {
+		t.Fatalf("\t%s\t  Should have entered and left each markup: %d %d", failed, depth, deepest)
+	}
+	t.Logf("\t%s\t  Should have entered and left each markup", success)
+}
+
+// TestFindAndFilter validates predicate based searches.
+func TestFindAndFilter(t *testing.T) {
+	root := walkDocument()
+
+	if links := trees.Filter(root, trees.WithTag("a")); tagsOf(links) != "home docs more" {
+		t.Fatalf("\t%s\t  Should have filtered links: %q", failed, tagsOf(links))
+	}
+	t.Logf("\t%s\t  Should have filtered links", success)
+
+	internal := trees.And(trees.WithAttr("href", ""), trees.Not(trees.WithClass("external")))
+	if links := trees.Filter(root, internal); tagsOf(links) != "home more" {
+		t.Fatalf("\t%s\t  Should have filtered internal links: %q", failed, tagsOf(links))
+	}
+	t.Logf("\t%s\t  Should have filtered internal links", success)
+
+	if found := trees.Find(root, trees.Or(trees.WithTag("p"), trees.WithClass("external"))); tagsOf([]*trees.Markup{found}) != "docs" {
+		t.Fatalf("\t%s\t  Should have found first match in document order", failed)
+	}
+	t.Logf("\t%s\t  Should have found first match in document order", success)
+
+	if found := trees.Find(root, trees.Matching("section a")); tagsOf([]*trees.Markup{found}) != "more" {
+		t.Fatalf("\t%s\t  Should have found link by selector", failed)
+	}
+	t.Logf("\t%s\t  Should have found link by selector", success)
+
+	if found := trees.Find(root, trees.WithTag("table")); found != nil {
+		t.Fatalf("\t%s\t  Should have found no table", failed)
+	}
+	t.Logf("\t%s\t  Should have found no table", success)
+}
+
+// TestAncestorsAndSiblings validates parent, ancestor and sibling access.
+func TestAncestorsAndSiblings(t *testing.T) {
+	root := walkDocument()
+	more := trees.Query.Query(root, "#more")
+	docs := trees.Query.Query(root, "#docs")
+	home := trees.Query.Query(root, "#home")
+
+	if tagsOf(more.Ancestors()) != "intro content root" || more.Root() != root || root.Parent() != nil {
+		t.Fatalf("\t%s\t  Should have returned ancestors: %q", failed, tagsOf(more.Ancestors()))
+	}
+	t.Logf("\t%s\t  Should have returned ancestors", success)
+
+	if closest := more.Closest(trees.WithTag("section")); tagsOf([]*trees.Markup{closest}) != "content" {
+		t.Fatalf("\t%s\t  Should have returned closest section", failed)
+	}
+	t.Logf("\t%s\t  Should have returned closest section", success)
+
+	if home.NextSibling() != docs || docs.PreviousSibling() != home || home.PreviousSibling() != nil || docs.NextSibling() != nil {
+		t.Fatalf("\t%s\t  Should have returned adjacent siblings", failed)
+	}
+	t.Logf("\t%s\t  Should have returned adjacent siblings", success)
+
+	if !reflect.DeepEqual(home.Siblings(), []*trees.Markup{docs}) || docs.Index() != 1 || root.Index() != -1 {
+		t.Fatalf("\t%s\t  Should have returned siblings and index", failed)
+	}
+	t.Logf("\t%s\t  Should have returned siblings and index", success)
+}
+
+// TestTransform validates in-place rewrites of trees.
+func TestTransform(t *testing.T) {
+	root := walkDocument()
+
+	trees.Transform(root, func(e *trees.Markup) *trees.Markup {
+		switch {
+		case e.Name() == "a" && trees.WithClass("external")(e):
+			return nil
+
+		case e.Name() == "a":
+			href, _ := attrOf(e, "href")
+			trees.ReplaceAttribute(e, "href", "/en"+href)
+
+		case e.Name() == "p":
+			span := trees.NewMarkup("span", false)
+			for _, child := range e.Children() {
+				span.AddChild(child)
+			}
+
+			return span
+		}
+
+		return e
+	})
+
+	if links := trees.Filter(root, trees.WithAttr("href", "")); len(links) != 2 {
+		t.Fatalf("\t%s\t  Should have removed external link: %d", failed, len(links))
+	}
+	t.Logf("\t%s\t  Should have removed external link", success)
+
+	more := trees.Query.Query(root, "span > #more")
+	if more == nil || more.Parent().Name() != "span" || more.Parent().Parent() != trees.Query.Query(root, "#content") {
+		t.Fatalf("\t%s\t  Should have replaced paragraph with span: %s", failed, root.HTML())
+	}
+	t.Logf("\t%s\t  Should have replaced paragraph with span", success)
+
+	if href, _ := attrOf(more, "href"); href != "/en/more" {
+		t.Fatalf("\t%s\t  Should have rewritten link: %q", failed, href)
+	}
+	t.Logf("\t%s\t  Should have rewritten link", success)
+
+	nav := trees.Query.Query(root, "nav")
+	home := trees.Query.Query(root, "#home")
+	replacement := trees.NewMarkup("b", false)
+
+	if !nav.ReplaceChild(home, replacement) || replacement.Parent() != nav || home.Parent() != nil {
+		t.Fatalf("\t%s\t  Should have replaced child", failed)
+	}
+	t.Logf("\t%s\t  Should have replaced child", success)
+
+	if !nav.RemoveChild(replacement) || len(nav.Children()) != 0 || nav.RemoveChild(replacement) {
+		t.Fatalf("\t%s\t  Should have removed child", failed)
+	}
+	t.Logf("\t%s\t  Should have removed child", success)
+}